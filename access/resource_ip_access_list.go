@@ -73,5 +73,6 @@ func ResourceIPAccessList() common.Resource {
 			}
 			return w.IpAccessLists.DeleteByIpAccessListId(ctx, d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
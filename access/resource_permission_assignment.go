@@ -113,5 +113,6 @@ func ResourcePermissionAssignment() common.Resource {
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewPermissionAssignmentAPI(ctx, c).Remove(d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -376,5 +376,6 @@ func ResourceSqlPermissions() common.Resource {
 			}
 			return ta.revoke()
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -79,11 +79,12 @@ func (a InstanceProfilesAPI) Delete(instanceProfileARN string) error {
 	}, nil)
 }
 
-// Update updates the IAM role ARN of an existing instance profile
+// Update updates the IAM role ARN and the meta instance profile flag of an existing instance profile
 func (a InstanceProfilesAPI) Update(ipi InstanceProfileInfo) error {
 	data := map[string]any{
-		"instance_profile_arn": ipi.InstanceProfileArn,
-		"iam_role_arn":         ipi.InstanceProfileArn,
+		"instance_profile_arn":     ipi.InstanceProfileArn,
+		"iam_role_arn":             ipi.InstanceProfileArn,
+		"is_meta_instance_profile": ipi.IsMetaInstanceProfile,
 	}
 	if ipi.IamRoleArn != "" {
 		data["iam_role_arn"] = ipi.IamRoleArn
@@ -102,7 +103,7 @@ func (a InstanceProfilesAPI) IsRegistered(arn string) bool {
 // Synchronized test helper for working with only single instance profile
 func (a InstanceProfilesAPI) Synchronized(arn string, testCallback func() bool) {
 	timeout := 30 * time.Minute
-	err := resource.RetryContext(a.context, timeout,
+	err := common.RetryContext(a.context, timeout,
 		func() *resource.RetryError {
 			list, err := a.List()
 			if err != nil {
@@ -116,7 +117,7 @@ func (a InstanceProfilesAPI) Synchronized(arn string, testCallback func() bool)
 						"%s is registered, waiting to release", arn))
 				}
 			}
-			cbError := resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+			cbError := common.RetryContext(a.context, timeout, func() *resource.RetryError {
 				if a.IsRegistered(arn) {
 					log.Printf("[INFO] %s: Waiting to acquire instance profile", currentTest)
 					return resource.RetryableError(fmt.Errorf("%s: Waiting to acquire", currentTest))
@@ -181,6 +182,7 @@ func ResourceInstanceProfile() common.Resource {
 			common.DataToStructPointer(d, instanceProfileSchema, &profile)
 			return NewInstanceProfilesAPI(ctx, c).Update(profile)
 		},
+		WorkspaceLevel: true,
 	}
 }
 
@@ -321,9 +321,10 @@ func TestResourceInstanceProfileUpdate(t *testing.T) {
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/instance-profiles/edit",
-				ExpectedRequest: InstanceProfileInfo{
-					InstanceProfileArn: "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
-					IamRoleArn:         "arn:aws:iam::999999999999:role/my-fake-instance-profile-role",
+				ExpectedRequest: map[string]any{
+					"instance_profile_arn":     "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+					"iam_role_arn":             "arn:aws:iam::999999999999:role/my-fake-instance-profile-role",
+					"is_meta_instance_profile": false,
 				},
 			},
 		},
@@ -339,6 +340,47 @@ func TestResourceInstanceProfileUpdate(t *testing.T) {
 	assert.Equal(t, "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile", d.Id())
 }
 
+func TestResourceInstanceProfileUpdate_MetaInstanceProfile(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/edit",
+				ExpectedRequest: map[string]any{
+					"instance_profile_arn":     "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+					"iam_role_arn":             "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+					"is_meta_instance_profile": true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-profiles/list",
+				Response: InstanceProfileList{
+					InstanceProfiles: []InstanceProfileInfo{
+						{
+							InstanceProfileArn:    "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+							IsMetaInstanceProfile: true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceInstanceProfile(),
+		InstanceState: map[string]string{
+			"instance_profile_arn":     "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+			"is_meta_instance_profile": "false",
+		},
+		HCL: `
+		instance_profile_arn = "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile"
+		is_meta_instance_profile = true
+		`,
+		Update: true,
+		ID:     "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+	}.ApplyAndExpectData(t, map[string]any{
+		"is_meta_instance_profile": true,
+	})
+}
+
 func TestResourceInstanceProfileUpdate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
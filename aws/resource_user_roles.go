@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/scim"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type userRolesGrant struct {
+	UserID string   `json:"user_id"`
+	Roles  []string `json:"roles" tf:"slice_set"`
+}
+
+type userRolesEntity struct {
+	Grants []userRolesGrant `json:"grant" tf:"slice_set"`
+}
+
+// userRoleAssignments maps ids of users to their roles, merging grants for the same user
+func userRoleAssignments(grants []userRolesGrant) map[string][]string {
+	assignments := map[string][]string{}
+	for _, grant := range grants {
+		for _, role := range grant.Roles {
+			// removed set elements may be read back as empty strings
+			if grant.UserID == "" || role == "" || slices.Contains(assignments[grant.UserID], role) {
+				continue
+			}
+			assignments[grant.UserID] = append(assignments[grant.UserID], role)
+		}
+	}
+	return assignments
+}
+
+// userRolePatches returns patches for roles, that are assigned to users in assignments, but not in except
+func userRolePatches(op string, assignments, except map[string][]string) (patches []scim.UserPatch) {
+	userIDs := []string{}
+	for userID := range assignments {
+		userIDs = append(userIDs, userID)
+	}
+	slices.Sort(userIDs)
+	for _, userID := range userIDs {
+		roles := slices.Clone(assignments[userID])
+		slices.Sort(roles)
+		for _, role := range roles {
+			if slices.Contains(except[userID], role) {
+				continue
+			}
+			request := scim.PatchRequest("add", "roles", role)
+			if op == "remove" {
+				request = scim.PatchRequest("remove", fmt.Sprintf(`roles[value eq "%s"]`, role), "")
+			}
+			patches = append(patches, scim.UserPatch{
+				UserID:  userID,
+				Request: request,
+			})
+		}
+	}
+	return
+}
+
+// userRolesFromSet reads assignments from the raw value of grant, e.g. from its state before the update
+func userRolesFromSet(v any) map[string][]string {
+	var grants []userRolesGrant
+	for _, raw := range v.(*schema.Set).List() {
+		m := raw.(map[string]any)
+		grant := userRolesGrant{UserID: m["user_id"].(string)}
+		for _, role := range m["roles"].(*schema.Set).List() {
+			grant.Roles = append(grant.Roles, role.(string))
+		}
+		grants = append(grants, grant)
+	}
+	return userRoleAssignments(grants)
+}
+
+// ResourceUserRoles assigns roles to multiple users with SCIM bulk requests
+func ResourceUserRoles() common.Resource {
+	s := common.StructToSchema(userRolesEntity{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["grant"].MinItems = 1
+		common.MustSchemaPath(m, "grant", "roles").MinItems = 1
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ur userRolesEntity
+			common.DataToStructPointer(d, s, &ur)
+			err := scim.NewUsersAPI(ctx, c).BulkPatch(userRolePatches("add", userRoleAssignments(ur.Grants), nil))
+			if err != nil {
+				return err
+			}
+			// the resource is identified by its grants, as there's no single role or user to refer to
+			d.SetId("_")
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ur userRolesEntity
+			common.DataToStructPointer(d, s, &ur)
+			configured := userRoleAssignments(ur.Grants)
+			roles := []string{}
+			for _, userRoles := range configured {
+				for _, role := range userRoles {
+					if !slices.Contains(roles, role) {
+						roles = append(roles, role)
+					}
+				}
+			}
+			slices.Sort(roles)
+			// one request per role, instead of one per user, as there are usually far less roles than users
+			assigned := map[string][]string{}
+			for _, role := range roles {
+				users, err := scim.NewUsersAPI(ctx, c).Filter(fmt.Sprintf(`roles.value eq "%s"`, role), false)
+				if err != nil {
+					return err
+				}
+				for _, user := range users {
+					// only the assignments made by this resource are tracked
+					if !scim.ComplexValues(user.Roles).HasValue(role) || !slices.Contains(configured[user.ID], role) {
+						continue
+					}
+					assigned[user.ID] = append(assigned[user.ID], role)
+				}
+			}
+			// grants keep their configured shape, so that splitting roles of a user across blocks doesn't show a diff
+			grants := []userRolesGrant{}
+			for _, grant := range ur.Grants {
+				roles := []string{}
+				for _, role := range grant.Roles {
+					if slices.Contains(assigned[grant.UserID], role) {
+						roles = append(roles, role)
+					}
+				}
+				if len(roles) > 0 {
+					grants = append(grants, userRolesGrant{
+						UserID: grant.UserID,
+						Roles:  roles,
+					})
+				}
+			}
+			if len(grants) == 0 {
+				d.SetId("")
+				return nil
+			}
+			ur.Grants = grants
+			return common.StructToData(ur, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			before, after := d.GetChange("grant")
+			oldRoles := userRolesFromSet(before)
+			newRoles := userRolesFromSet(after)
+			patches := userRolePatches("remove", oldRoles, newRoles)
+			patches = append(patches, userRolePatches("add", newRoles, oldRoles)...)
+			return scim.NewUsersAPI(ctx, c).BulkPatch(patches)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ur userRolesEntity
+			common.DataToStructPointer(d, s, &ur)
+			return scim.NewUsersAPI(ctx, c).BulkPatch(userRolePatches("remove", userRoleAssignments(ur.Grants), nil))
+		},
+	}
+}
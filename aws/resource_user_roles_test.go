@@ -0,0 +1,311 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const testRoleArn = "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile"
+
+const testRoleArn2 = "arn:aws:iam::999999999999:instance-profile/other-instance-profile"
+
+var usersWithRole = scim.UserList{
+	Resources: []scim.User{
+		{
+			ID:    "a",
+			Roles: []scim.ComplexValue{{Value: testRoleArn}},
+		},
+		{
+			ID:    "b",
+			Roles: []scim.ComplexValue{{Value: testRoleArn}, {Value: testRoleArn2}},
+		},
+		{
+			ID:    "c",
+			Roles: []scim.ComplexValue{{Value: testRoleArn}},
+		},
+	},
+}
+
+var usersWithRole2 = scim.UserList{
+	Resources: []scim.User{
+		{
+			ID:    "b",
+			Roles: []scim.ComplexValue{{Value: testRoleArn}, {Value: testRoleArn2}},
+		},
+	},
+}
+
+const usersWithRoleFilter = "/api/2.0/preview/scim/v2/Users?filter=roles.value%20eq%20%22arn%3Aaws%3Aiam%3A%3A999999999999%3Ainstance-profile%2Fmy-fake-instance-profile%22"
+
+const usersWithRole2Filter = "/api/2.0/preview/scim/v2/Users?filter=roles.value%20eq%20%22arn%3Aaws%3Aiam%3A%3A999999999999%3Ainstance-profile%2Fother-instance-profile%22"
+
+func TestUserRolesCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Bulk",
+				ExpectedRequest: map[string]any{
+					"schemas": []string{"urn:ietf:params:scim:api:messages:2.0:BulkRequest"},
+					"Operations": []map[string]any{
+						{
+							"method": "PATCH",
+							"bulkId": "0",
+							"path":   "/Users/a",
+							"data":   scim.PatchRequest("add", "roles", testRoleArn),
+						},
+						{
+							"method": "PATCH",
+							"bulkId": "1",
+							"path":   "/Users/b",
+							"data":   scim.PatchRequest("add", "roles", testRoleArn),
+						},
+						{
+							"method": "PATCH",
+							"bulkId": "2",
+							"path":   "/Users/b",
+							"data":   scim.PatchRequest("add", "roles", testRoleArn2),
+						},
+					},
+				},
+				Response: map[string]any{
+					"Operations": []map[string]any{
+						{"method": "PATCH", "bulkId": "0", "status": "200"},
+						{"method": "PATCH", "bulkId": "1", "status": "200"},
+						{"method": "PATCH", "bulkId": "2", "status": "200"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: usersWithRoleFilter,
+				Response: usersWithRole,
+			},
+			{
+				Method:   "GET",
+				Resource: usersWithRole2Filter,
+				Response: usersWithRole2,
+			},
+		},
+		Resource: ResourceUserRoles(),
+		Create:   true,
+		HCL: `
+		grant {
+			user_id = "a"
+			roles = ["` + testRoleArn + `"]
+		}
+		grant {
+			user_id = "b"
+			roles = ["` + testRoleArn + `", "` + testRoleArn2 + `"]
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "_", d.Id())
+	// user "c" has the role assigned outside of this resource
+	assert.Equal(t, 2, d.Get("grant.#"))
+}
+
+func TestUserRolesCreate_PartialFailure(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Bulk",
+				Response: map[string]any{
+					"Operations": []map[string]any{
+						{"method": "PATCH", "bulkId": "0", "status": "200"},
+						{"method": "PATCH", "bulkId": "1", "status": "404", "response": map[string]any{
+							"detail": "User not found",
+						}},
+					},
+				},
+			},
+		},
+		Resource: ResourceUserRoles(),
+		Create:   true,
+		HCL: `
+		grant {
+			user_id = "a"
+			roles = ["` + testRoleArn + `"]
+		}
+		grant {
+			user_id = "b"
+			roles = ["` + testRoleArn + `"]
+		}
+		`,
+	}.ExpectError(t, "bulk operations failed: user b: status 404 map[detail:User not found]")
+}
+
+func TestUserRolesRead_RemovedAssignment(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: usersWithRoleFilter,
+				Response: usersWithRole,
+			},
+			{
+				Method:   "GET",
+				Resource: usersWithRole2Filter,
+				Response: scim.UserList{},
+			},
+		},
+		Resource: ResourceUserRoles(),
+		Read:     true,
+		New:      true,
+		ID:       "_",
+		HCL: `
+		grant {
+			user_id = "a"
+			roles = ["` + testRoleArn2 + `"]
+		}
+		grant {
+			user_id = "b"
+			roles = ["` + testRoleArn + `", "` + testRoleArn2 + `"]
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	grants := d.Get("grant").(*schema.Set).List()
+	assert.Len(t, grants, 1)
+	grant := grants[0].(map[string]any)
+	assert.Equal(t, "b", grant["user_id"])
+	assert.Equal(t, []any{testRoleArn}, grant["roles"].(*schema.Set).List())
+}
+
+func TestUserRolesRead_NoAssignments(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: usersWithRoleFilter,
+				Response: scim.UserList{},
+			},
+		},
+		Resource: ResourceUserRoles(),
+		Read:     true,
+		Removed:  true,
+		ID:       "_",
+		HCL: `
+		grant {
+			user_id = "a"
+			roles = ["` + testRoleArn + `"]
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestUserRolesUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Bulk",
+				ExpectedRequest: map[string]any{
+					"schemas": []string{"urn:ietf:params:scim:api:messages:2.0:BulkRequest"},
+					"Operations": []map[string]any{
+						{
+							"method": "PATCH",
+							"bulkId": "0",
+							"path":   "/Users/a",
+							"data": scim.PatchRequest("remove",
+								`roles[value eq "`+testRoleArn+`"]`, ""),
+						},
+						{
+							"method": "PATCH",
+							"bulkId": "1",
+							"path":   "/Users/b",
+							"data":   scim.PatchRequest("add", "roles", testRoleArn2),
+						},
+						{
+							"method": "PATCH",
+							"bulkId": "2",
+							"path":   "/Users/c",
+							"data":   scim.PatchRequest("add", "roles", testRoleArn),
+						},
+					},
+				},
+				Response: map[string]any{},
+			},
+			{
+				Method:   "GET",
+				Resource: usersWithRoleFilter,
+				Response: usersWithRole,
+			},
+			{
+				Method:   "GET",
+				Resource: usersWithRole2Filter,
+				Response: usersWithRole2,
+			},
+		},
+		Resource: ResourceUserRoles(),
+		Update:   true,
+		ID:       "_",
+		InstanceState: map[string]string{
+			"grant.#":                  "2",
+			"grant.1167468232.user_id": "a",
+			"grant.1167468232.roles.#": "1",
+			"grant.1167468232.roles.0": testRoleArn,
+			"grant.2986466420.user_id": "b",
+			"grant.2986466420.roles.#": "1",
+			"grant.2986466420.roles.0": testRoleArn,
+		},
+		HCL: `
+		grant {
+			user_id = "b"
+			roles = ["` + testRoleArn + `", "` + testRoleArn2 + `"]
+		}
+		grant {
+			user_id = "c"
+			roles = ["` + testRoleArn + `"]
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestUserRolesDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Bulk",
+				ExpectedRequest: map[string]any{
+					"schemas": []string{"urn:ietf:params:scim:api:messages:2.0:BulkRequest"},
+					"Operations": []map[string]any{
+						{
+							"method": "PATCH",
+							"bulkId": "0",
+							"path":   "/Users/a",
+							"data": scim.PatchRequest("remove",
+								`roles[value eq "`+testRoleArn+`"]`, ""),
+						},
+					},
+				},
+				Response: map[string]any{},
+			},
+		},
+		Resource: ResourceUserRoles(),
+		Delete:   true,
+		ID:       "_",
+		HCL: `
+		grant {
+			user_id = "a"
+			roles = ["` + testRoleArn + `"]
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestUserRolesCornerCases(t *testing.T) {
+	// create, update, read and delete don't make any requests without grants
+	qa.ResourceCornerCases(t, ResourceUserRoles(), qa.CornerCaseID("_"),
+		qa.CornerCaseSkipCRUD("create"),
+		qa.CornerCaseSkipCRUD("read"),
+		qa.CornerCaseSkipCRUD("update"),
+		qa.CornerCaseSkipCRUD("delete"))
+}
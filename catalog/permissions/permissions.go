@@ -50,7 +50,7 @@ func (a UnityCatalogPermissionsAPI) UpdatePermissions(securable catalog.Securabl
 }
 
 func (a UnityCatalogPermissionsAPI) WaitForUpdate(timeout time.Duration, securable catalog.SecurableType, name string, desired catalog.PermissionsList, diff func(*catalog.PermissionsList, catalog.PermissionsList) []catalog.PermissionsChange) error {
-	return retry.RetryContext(a.context, timeout, func() *retry.RetryError {
+	return common.RetryContext(a.context, timeout, func() *retry.RetryError {
 		current, err := a.GetPermissions(securable, name)
 		if err != nil {
 			return retry.NonRetryableError(err)
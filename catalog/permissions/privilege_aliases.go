@@ -0,0 +1,123 @@
+package permissions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// privilegeAliases maps privileges renamed by the platform to their current names. Renames
+// depend on securable, e.g. `USAGE` became `USE_CATALOG` on catalogs and `USE_SCHEMA` on schemas.
+var privilegeAliases = map[string]map[string]string{
+	"catalog": {
+		"USAGE":  "USE_CATALOG",
+		"CREATE": "CREATE_SCHEMA",
+	},
+	"schema": {
+		"USAGE":  "USE_SCHEMA",
+		"CREATE": "CREATE_TABLE",
+	},
+	"external_location": {
+		"CREATE_TABLE": "CREATE_EXTERNAL_TABLE",
+	},
+	"storage_credential": {
+		"CREATE_TABLE": "CREATE_EXTERNAL_TABLE",
+	},
+}
+
+// CanonicalPrivilege returns the current name of the privilege on the given securable.
+// Privileges spelled with spaces, like `ALL PRIVILEGES`, are also normalized.
+func CanonicalPrivilege(securable string, privilege string) string {
+	normalized := strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(privilege)), " ", "_")
+	if canonical, ok := privilegeAliases[securable][normalized]; ok {
+		return canonical
+	}
+	return normalized
+}
+
+// CanonicalPermissionsList returns the copy of the list with all privileges renamed to their current names
+func CanonicalPermissionsList(securable string, list catalog.PermissionsList) (out catalog.PermissionsList) {
+	for _, v := range list.PrivilegeAssignments {
+		privileges := []catalog.Privilege{}
+		for _, p := range v.Privileges {
+			privileges = append(privileges, catalog.Privilege(CanonicalPrivilege(securable, p.String())))
+		}
+		out.PrivilegeAssignments = append(out.PrivilegeAssignments, catalog.PrivilegeAssignment{
+			Principal:  v.Principal,
+			Privileges: privileges,
+		})
+	}
+	return
+}
+
+// ValidateDeprecatedPrivilege warns during the plan about privileges, that were renamed on all securables
+// they apply to. Privileges, that are current on some securables, like `CREATE_TABLE`, aren't reported,
+// because the validator doesn't know the securable.
+func ValidateDeprecatedPrivilege(i any, path cty.Path) diag.Diagnostics {
+	privilege, ok := i.(string)
+	if !ok {
+		return nil
+	}
+	normalized := CanonicalPrivilege("", privilege)
+	renames := []string{}
+	for _, securable := range sortedAliasSecurables() {
+		aliases := privilegeAliases[securable]
+		for _, current := range aliases {
+			if current == normalized {
+				return nil
+			}
+		}
+		if current, ok := aliases[normalized]; ok {
+			renames = append(renames, fmt.Sprintf("%s on %s", current, securable))
+		}
+	}
+	if len(renames) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s privilege is deprecated", privilege),
+			Detail: fmt.Sprintf("%s privilege was renamed, please use %s instead",
+				privilege, strings.Join(renames, ", ")),
+			AttributePath: path,
+		},
+	}
+}
+
+func sortedAliasSecurables() []string {
+	securables := []string{}
+	for k := range privilegeAliases {
+		securables = append(securables, k)
+	}
+	sort.Strings(securables)
+	return securables
+}
+
+// KeepConfiguredAliases renames privileges in the list to the spelling used in the configuration,
+// if both refer to the same privilege, so that renames on the platform don't cause a diff.
+// The `configured` map contains privileges of every principal.
+func KeepConfiguredAliases(securable string, list catalog.PermissionsList, configured map[string][]string) (out catalog.PermissionsList) {
+	for _, v := range list.PrivilegeAssignments {
+		spelling := map[string]string{}
+		for _, p := range configured[v.Principal] {
+			spelling[CanonicalPrivilege(securable, p)] = p
+		}
+		privileges := []catalog.Privilege{}
+		for _, p := range v.Privileges {
+			if configuredName, ok := spelling[CanonicalPrivilege(securable, p.String())]; ok {
+				p = catalog.Privilege(configuredName)
+			}
+			privileges = append(privileges, p)
+		}
+		out.PrivilegeAssignments = append(out.PrivilegeAssignments, catalog.PrivilegeAssignment{
+			Principal:  v.Principal,
+			Privileges: privileges,
+		})
+	}
+	return
+}
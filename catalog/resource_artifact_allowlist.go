@@ -30,7 +30,11 @@ func ResourceArtifactAllowlist() common.Resource {
 		if err != nil {
 			return err
 		}
-		err = validateMetastoreId(ctx, w, d.Get("metastore_id").(string))
+		if d.Id() == "" {
+			err = validateMetastoreIdOnCreate(ctx, w, d.Get("metastore_id").(string), d.Timeout(schema.TimeoutCreate))
+		} else {
+			err = validateMetastoreId(ctx, w, d.Get("metastore_id").(string))
+		}
 		if err != nil {
 			return err
 		}
@@ -53,6 +57,9 @@ func ResourceArtifactAllowlist() common.Resource {
 	}
 	return common.Resource{
 		Schema: allowlistSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(metastoreAssignmentPropagationTimeout),
+		},
 		Create: createOrUpdate,
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -107,5 +114,6 @@ func ResourceArtifactAllowlist() common.Resource {
 
 			return nil
 		},
+		WorkspaceLevel: true,
 	}
 }
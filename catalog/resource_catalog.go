@@ -53,13 +53,16 @@ func ResourceCatalog() common.Resource {
 		})
 	return common.Resource{
 		Schema: catalogSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(metastoreAssignmentPropagationTimeout),
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
 
-			err = validateMetastoreId(ctx, w, d.Get("metastore_id").(string))
+			err = validateMetastoreIdOnCreate(ctx, w, d.Get("metastore_id").(string), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
@@ -223,5 +226,6 @@ func ResourceCatalog() common.Resource {
 			}
 			return w.Catalogs.Delete(ctx, catalog.DeleteCatalogRequest{Force: force, Name: d.Id()})
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -0,0 +1,350 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/catalog/permissions"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// catalogOnlyPrivileges can't be granted on schemas, so they are applied only to the catalog
+var catalogOnlyPrivileges = map[string]bool{
+	"USE_CATALOG":   true,
+	"CREATE_SCHEMA": true,
+}
+
+type catalogDefaultGrants struct {
+	Catalog        string                                        `json:"catalog" tf:"force_new"`
+	Grants         []permissions.UnityCatalogPrivilegeAssignment `json:"grant" tf:"slice_set"`
+	ExcludeSchemas []string                                      `json:"exclude_schemas,omitempty" tf:"slice_set"`
+	Schemas        []string                                      `json:"schemas,omitempty" tf:"computed"`
+	// OutOfSync has full names of the catalog and schemas, where principals miss privileges of the template
+	OutOfSync []string `json:"out_of_sync,omitempty" tf:"computed"`
+}
+
+// grantTemplate maps principals to canonical names of their privileges
+type grantTemplate map[string][]string
+
+func newGrantTemplate(grants []permissions.UnityCatalogPrivilegeAssignment) grantTemplate {
+	template := grantTemplate{}
+	for _, v := range grants {
+		for _, p := range v.Privileges {
+			template[v.Principal] = append(template[v.Principal], permissions.CanonicalPrivilege("catalog", p))
+		}
+	}
+	for _, privileges := range template {
+		sort.Strings(privileges)
+	}
+	return template
+}
+
+// templateFromSet returns the template from the `grant` blocks
+func templateFromSet(set *schema.Set) grantTemplate {
+	grants := []permissions.UnityCatalogPrivilegeAssignment{}
+	for _, v := range set.List() {
+		grant := v.(map[string]any)
+		privileges := []string{}
+		for _, p := range grant["privileges"].(*schema.Set).List() {
+			privileges = append(privileges, p.(string))
+		}
+		grants = append(grants, permissions.UnityCatalogPrivilegeAssignment{
+			Principal:  grant["principal"].(string),
+			Privileges: privileges,
+		})
+	}
+	return newGrantTemplate(grants)
+}
+
+// forSecurable returns privileges of the template that could be granted on the given securable
+func (t grantTemplate) forSecurable(securable string) grantTemplate {
+	out := grantTemplate{}
+	for principal, privileges := range t {
+		for _, p := range privileges {
+			if securable != "catalog" && catalogOnlyPrivileges[p] {
+				continue
+			}
+			out[principal] = append(out[principal], p)
+		}
+	}
+	return out
+}
+
+// without returns privileges of this template, that aren't in the other one
+func (t grantTemplate) without(other grantTemplate) grantTemplate {
+	out := grantTemplate{}
+	for principal, privileges := range t {
+		kept := map[string]bool{}
+		for _, p := range other[principal] {
+			kept[p] = true
+		}
+		for _, p := range privileges {
+			if !kept[p] {
+				out[principal] = append(out[principal], p)
+			}
+		}
+	}
+	return out
+}
+
+func (t grantTemplate) principals() []string {
+	principals := []string{}
+	for principal := range t {
+		principals = append(principals, principal)
+	}
+	sort.Strings(principals)
+	return principals
+}
+
+// missingPrivileges returns changes that grant privileges of the template, which principals don't have yet
+func (t grantTemplate) missingPrivileges(securable string, current catalog.PermissionsList) (changes []catalog.PermissionsChange) {
+	granted := map[string]map[string]bool{}
+	for _, v := range permissions.CanonicalPermissionsList(securable, current).PrivilegeAssignments {
+		if granted[v.Principal] == nil {
+			granted[v.Principal] = map[string]bool{}
+		}
+		for _, p := range v.Privileges {
+			granted[v.Principal][p.String()] = true
+		}
+	}
+	for _, principal := range t.principals() {
+		add := []catalog.Privilege{}
+		for _, p := range t[principal] {
+			if !granted[principal][p] && !granted[principal]["ALL_PRIVILEGES"] {
+				add = append(add, catalog.Privilege(p))
+			}
+		}
+		if len(add) > 0 {
+			changes = append(changes, catalog.PermissionsChange{
+				Principal: principal,
+				Add:       add,
+			})
+		}
+	}
+	return changes
+}
+
+func (t grantTemplate) removals() (changes []catalog.PermissionsChange) {
+	for _, principal := range t.principals() {
+		remove := []catalog.Privilege{}
+		for _, p := range t[principal] {
+			remove = append(remove, catalog.Privilege(p))
+		}
+		changes = append(changes, catalog.PermissionsChange{
+			Principal: principal,
+			Remove:    remove,
+		})
+	}
+	return changes
+}
+
+// defaultGrantsSecurables returns the catalog and all its schemas, except the excluded ones and information_schema
+func defaultGrantsSecurables(ctx context.Context, w *databricks.WorkspaceClient,
+	dg catalogDefaultGrants) (map[string]string, error) {
+	excluded := map[string]bool{"information_schema": true}
+	for _, v := range dg.ExcludeSchemas {
+		excluded[v] = true
+	}
+	schemas, err := w.Schemas.ListAll(ctx, catalog.ListSchemasRequest{CatalogName: dg.Catalog})
+	if err != nil {
+		return nil, err
+	}
+	securables := map[string]string{dg.Catalog: "catalog"}
+	for _, v := range schemas {
+		if excluded[v.Name] {
+			continue
+		}
+		securables[fmt.Sprintf("%s.%s", dg.Catalog, v.Name)] = "schema"
+	}
+	return securables, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// applyGrantTemplate grants missing privileges of the template on the catalog and its schemas
+func applyGrantTemplate(ctx context.Context, c *common.DatabricksClient, dg catalogDefaultGrants) error {
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	securables, err := defaultGrantsSecurables(ctx, w, dg)
+	if err != nil {
+		return err
+	}
+	api := permissions.NewUnityCatalogPermissionsAPI(ctx, c)
+	template := newGrantTemplate(dg.Grants)
+	for _, name := range sortedKeys(securables) {
+		securable := securables[name]
+		securableType := permissions.Mappings.GetSecurableType(securable)
+		current, err := api.GetPermissions(securableType, name)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", securable, name, err)
+		}
+		changes := template.forSecurable(securable).missingPrivileges(securable, *current)
+		if len(changes) == 0 {
+			continue
+		}
+		if err = api.UpdatePermissions(securableType, name, changes); err != nil {
+			return fmt.Errorf("%s %s: %w", securable, name, err)
+		}
+	}
+	return nil
+}
+
+// revokeGrantTemplate revokes privileges of the template from the catalog and its schemas
+func revokeGrantTemplate(ctx context.Context, c *common.DatabricksClient, dg catalogDefaultGrants, template grantTemplate) error {
+	if len(template) == 0 {
+		return nil
+	}
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	securables, err := defaultGrantsSecurables(ctx, w, dg)
+	if err != nil {
+		return err
+	}
+	return revokeFromSecurables(ctx, c, securables, template)
+}
+
+// revokeFromExcludedSchemas revokes privileges of the previous template from schemas, that were added
+// to `exclude_schemas`, as the template isn't applied to them anymore
+func revokeFromExcludedSchemas(ctx context.Context, c *common.DatabricksClient, d *schema.ResourceData,
+	dg catalogDefaultGrants) error {
+	oldExcluded, newExcluded := d.GetChange("exclude_schemas")
+	added := newExcluded.(*schema.Set).Difference(oldExcluded.(*schema.Set))
+	oldGrants, _ := d.GetChange("grant")
+	template := templateFromSet(oldGrants.(*schema.Set))
+	if added.Len() == 0 || len(template) == 0 {
+		return nil
+	}
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	previous := dg
+	previous.ExcludeSchemas = []string{}
+	for _, v := range oldExcluded.(*schema.Set).List() {
+		previous.ExcludeSchemas = append(previous.ExcludeSchemas, v.(string))
+	}
+	securables, err := defaultGrantsSecurables(ctx, w, previous)
+	if err != nil {
+		return err
+	}
+	excluded := map[string]string{}
+	for _, v := range added.List() {
+		name := fmt.Sprintf("%s.%s", dg.Catalog, v.(string))
+		// schemas, that don't exist, have nothing to revoke
+		if _, ok := securables[name]; ok {
+			excluded[name] = "schema"
+		}
+	}
+	return revokeFromSecurables(ctx, c, excluded, template)
+}
+
+func revokeFromSecurables(ctx context.Context, c *common.DatabricksClient, securables map[string]string,
+	template grantTemplate) error {
+	api := permissions.NewUnityCatalogPermissionsAPI(ctx, c)
+	for _, name := range sortedKeys(securables) {
+		securable := securables[name]
+		changes := template.forSecurable(securable).removals()
+		if len(changes) == 0 {
+			continue
+		}
+		err := api.UpdatePermissions(permissions.Mappings.GetSecurableType(securable), name, changes)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", securable, name, err)
+		}
+	}
+	return nil
+}
+
+// ResourceCatalogDefaultGrants applies the same grants to a catalog and all of its schemas
+func ResourceCatalogDefaultGrants() common.Resource {
+	s := common.StructToSchema(catalogDefaultGrants{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			common.MustSchemaPath(m, "grant").MinItems = 1
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var dg catalogDefaultGrants
+			common.DataToStructPointer(d, s, &dg)
+			if err := applyGrantTemplate(ctx, c, dg); err != nil {
+				return err
+			}
+			d.SetId(dg.Catalog)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var dg catalogDefaultGrants
+			common.DataToStructPointer(d, s, &dg)
+			dg.Catalog = d.Id()
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			securables, err := defaultGrantsSecurables(ctx, w, dg)
+			if err != nil {
+				return err
+			}
+			api := permissions.NewUnityCatalogPermissionsAPI(ctx, c)
+			template := newGrantTemplate(dg.Grants)
+			dg.Schemas = []string{}
+			dg.OutOfSync = []string{}
+			for _, name := range sortedKeys(securables) {
+				securable := securables[name]
+				if securable == "schema" {
+					dg.Schemas = append(dg.Schemas, name)
+				}
+				current, err := api.GetPermissions(permissions.Mappings.GetSecurableType(securable), name)
+				if err != nil {
+					return err
+				}
+				// privileges missing on new schemas or revoked outside of Terraform are applied by the next apply
+				if len(template.forSecurable(securable).missingPrivileges(securable, *current)) > 0 {
+					dg.OutOfSync = append(dg.OutOfSync, name)
+				}
+			}
+			return common.StructToData(dg, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var dg catalogDefaultGrants
+			common.DataToStructPointer(d, s, &dg)
+			old, _ := d.GetChange("grant")
+			removed := templateFromSet(old.(*schema.Set)).without(newGrantTemplate(dg.Grants))
+			if err := revokeGrantTemplate(ctx, c, dg, removed); err != nil {
+				return err
+			}
+			if err := revokeFromExcludedSchemas(ctx, c, d, dg); err != nil {
+				return err
+			}
+			return applyGrantTemplate(ctx, c, dg)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var dg catalogDefaultGrants
+			common.DataToStructPointer(d, s, &dg)
+			return revokeGrantTemplate(ctx, c, dg, newGrantTemplate(dg.Grants))
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if len(d.Get("out_of_sync").([]any)) == 0 {
+				return nil
+			}
+			// the plan shows, where the template is going to be applied again
+			return d.SetNew("out_of_sync", []string{})
+		},
+		WorkspaceLevel: true,
+	}
+}
@@ -0,0 +1,280 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func expectSchemas(w *mocks.MockWorkspaceClient, names ...string) {
+	schemas := []catalog.SchemaInfo{{Name: "information_schema", CatalogName: "main"}}
+	for _, name := range names {
+		schemas = append(schemas, catalog.SchemaInfo{Name: name, CatalogName: "main"})
+	}
+	w.GetMockSchemasAPI().EXPECT().ListAll(mock.Anything, catalog.ListSchemasRequest{
+		CatalogName: "main",
+	}).Return(schemas, nil)
+}
+
+func expectPermissions(w *mocks.MockWorkspaceClient, securable, name string, assignments ...catalog.PrivilegeAssignment) {
+	w.GetMockGrantsAPI().EXPECT().GetBySecurableTypeAndFullName(mock.Anything,
+		catalog.SecurableType(securable), name).Return(&catalog.PermissionsList{
+		PrivilegeAssignments: assignments,
+	}, nil)
+}
+
+func expectPermissionsUpdate(w *mocks.MockWorkspaceClient, securable, name string, changes ...catalog.PermissionsChange) {
+	w.GetMockGrantsAPI().EXPECT().Update(mock.Anything, catalog.UpdatePermissions{
+		SecurableType: catalog.SecurableType(securable),
+		FullName:      name,
+		Changes:       changes,
+	}).Return(nil, nil)
+}
+
+// defaultGrantsState returns flatmap state of the `main` catalog, so that set hashes don't have to be computed by hand
+func defaultGrantsState(t *testing.T, grants ...any) map[string]string {
+	d := ResourceCatalogDefaultGrants().ToResource().TestResourceData()
+	d.SetId("main")
+	assert.NoError(t, d.Set("catalog", "main"))
+	assert.NoError(t, d.Set("grant", grants))
+	return d.State().Attributes
+}
+
+func TestResourceCatalogDefaultGrantsUpdate_ExcludedSchema(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			expectSchemas(w, "sales", "raw")
+			// raw is excluded now, so the template is revoked from it
+			expectPermissionsUpdate(w, "schema", "main.raw", catalog.PermissionsChange{
+				Principal: "analysts",
+				Remove:    []catalog.Privilege{"SELECT", "USE_SCHEMA"},
+			})
+			expectPermissions(w, "catalog", "main", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_CATALOG", "USE_SCHEMA", "SELECT"},
+			})
+			expectPermissions(w, "schema", "main.sales", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_SCHEMA", "SELECT"},
+			})
+		},
+		Resource: ResourceCatalogDefaultGrants(),
+		Update:   true,
+		ID:       "main",
+		InstanceState: defaultGrantsState(t, map[string]any{
+			"principal":  "analysts",
+			"privileges": []any{"USE_CATALOG", "USE_SCHEMA", "SELECT"},
+		}),
+		HCL: `
+		catalog = "main"
+		exclude_schemas = ["raw", "gone"]
+		grant {
+			principal = "analysts"
+			privileges = ["USE_CATALOG", "USE_SCHEMA", "SELECT"]
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"schemas.#":     1,
+		"out_of_sync.#": 0,
+	})
+}
+
+const defaultGrantsHCL = `
+catalog = "main"
+grant {
+	principal = "data_engineers"
+	privileges = ["ALL_PRIVILEGES"]
+}
+grant {
+	principal = "analysts"
+	privileges = ["USE_CATALOG", "USE_SCHEMA", "SELECT"]
+}
+`
+
+func TestResourceCatalogDefaultGrantsCreate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			expectSchemas(w, "sales", "staging")
+			expectPermissions(w, "catalog", "main", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_CATALOG"},
+			})
+			expectPermissionsUpdate(w, "catalog", "main", catalog.PermissionsChange{
+				Principal: "analysts",
+				Add:       []catalog.Privilege{"SELECT", "USE_SCHEMA"},
+			}, catalog.PermissionsChange{
+				Principal: "data_engineers",
+				Add:       []catalog.Privilege{"ALL_PRIVILEGES"},
+			})
+			// USE_CATALOG is granted only on the catalog
+			expectPermissions(w, "schema", "main.sales", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_SCHEMA", "SELECT"},
+			}, catalog.PrivilegeAssignment{
+				Principal:  "data_engineers",
+				Privileges: []catalog.Privilege{"ALL_PRIVILEGES"},
+			})
+			expectPermissions(w, "schema", "main.staging")
+			expectPermissionsUpdate(w, "schema", "main.staging", catalog.PermissionsChange{
+				Principal: "analysts",
+				Add:       []catalog.Privilege{"SELECT", "USE_SCHEMA"},
+			}, catalog.PermissionsChange{
+				Principal: "data_engineers",
+				Add:       []catalog.Privilege{"ALL_PRIVILEGES"},
+			})
+			// read after create sees everything granted
+			expectPermissions(w, "catalog", "main", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_CATALOG", "USE_SCHEMA", "SELECT"},
+			}, catalog.PrivilegeAssignment{
+				Principal:  "data_engineers",
+				Privileges: []catalog.Privilege{"ALL_PRIVILEGES"},
+			})
+		},
+		Resource: ResourceCatalogDefaultGrants(),
+		Create:   true,
+		HCL:      defaultGrantsHCL,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "main",
+		"grant.#":   2,
+		"schemas.#": 2,
+	})
+}
+
+func TestResourceCatalogDefaultGrantsRead_NewSchema(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			expectSchemas(w, "sales", "new_one")
+			expectPermissions(w, "catalog", "main", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_CATALOG", "USE_SCHEMA", "SELECT"},
+			}, catalog.PrivilegeAssignment{
+				Principal:  "data_engineers",
+				Privileges: []catalog.Privilege{"ALL_PRIVILEGES"},
+			})
+			expectPermissions(w, "schema", "main.sales", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_SCHEMA", "SELECT"},
+			}, catalog.PrivilegeAssignment{
+				Principal:  "data_engineers",
+				Privileges: []catalog.Privilege{"ALL_PRIVILEGES"},
+			})
+			// data engineers own the schema they've just created, but analysts can't see it yet
+			expectPermissions(w, "schema", "main.new_one", catalog.PrivilegeAssignment{
+				Principal:  "data_engineers",
+				Privileges: []catalog.Privilege{"ALL_PRIVILEGES"},
+			})
+		},
+		Resource: ResourceCatalogDefaultGrants(),
+		Read:     true,
+		New:      true,
+		ID:       "main",
+		HCL:      defaultGrantsHCL,
+	}.ApplyAndExpectData(t, map[string]any{
+		"catalog":       "main",
+		"grant.#":       2,
+		"schemas.#":     2,
+		"out_of_sync.#": 1,
+		"out_of_sync.0": "main.new_one",
+	})
+}
+
+func TestResourceCatalogDefaultGrantsUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			expectSchemas(w, "sales")
+			// MODIFY is no longer in the template
+			expectPermissionsUpdate(w, "catalog", "main", catalog.PermissionsChange{
+				Principal: "analysts",
+				Remove:    []catalog.Privilege{"MODIFY"},
+			})
+			expectPermissionsUpdate(w, "schema", "main.sales", catalog.PermissionsChange{
+				Principal: "analysts",
+				Remove:    []catalog.Privilege{"MODIFY"},
+			})
+			expectPermissions(w, "catalog", "main", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_CATALOG", "USE_SCHEMA", "SELECT"},
+			})
+			expectPermissions(w, "schema", "main.sales", catalog.PrivilegeAssignment{
+				Principal:  "analysts",
+				Privileges: []catalog.Privilege{"USE_SCHEMA", "SELECT"},
+			})
+		},
+		Resource: ResourceCatalogDefaultGrants(),
+		Update:   true,
+		ID:       "main",
+		InstanceState: defaultGrantsState(t, map[string]any{
+			"principal":  "analysts",
+			"privileges": []any{"USE_CATALOG", "USE_SCHEMA", "SELECT", "MODIFY"},
+		}),
+		HCL: `
+		catalog = "main"
+		grant {
+			principal = "analysts"
+			privileges = ["USE_CATALOG", "USE_SCHEMA", "SELECT"]
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"grant.#":   1,
+		"schemas.#": 1,
+	})
+}
+
+func TestResourceCatalogDefaultGrantsDelete(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			expectSchemas(w, "sales", "raw")
+			expectPermissionsUpdate(w, "catalog", "main", catalog.PermissionsChange{
+				Principal: "analysts",
+				Remove:    []catalog.Privilege{"SELECT", "USE_CATALOG"},
+			})
+			expectPermissionsUpdate(w, "schema", "main.sales", catalog.PermissionsChange{
+				Principal: "analysts",
+				Remove:    []catalog.Privilege{"SELECT"},
+			})
+		},
+		Resource: ResourceCatalogDefaultGrants(),
+		Delete:   true,
+		ID:       "main",
+		HCL: `
+		catalog = "main"
+		exclude_schemas = ["raw"]
+		grant {
+			principal = "analysts"
+			privileges = ["USE_CATALOG", "SELECT"]
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceCatalogDefaultGrantsDiff_OutOfSync(t *testing.T) {
+	state := defaultGrantsState(t, map[string]any{
+		"principal":  "analysts",
+		"privileges": []any{"SELECT"},
+	})
+	state["out_of_sync.#"] = "1"
+	state["out_of_sync.0"] = "main.new_one"
+	qa.ResourceFixture{
+		Resource:      ResourceCatalogDefaultGrants(),
+		ID:            "main",
+		InstanceState: state,
+		HCL: `
+		catalog = "main"
+		grant {
+			principal = "analysts"
+			privileges = ["SELECT"]
+		}
+		`,
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"out_of_sync.#": {Old: "1", New: "0"},
+			"out_of_sync.0": {Old: "main.new_one", New: "", NewRemoved: true},
+			"schemas.#":     {Old: "", New: "", NewComputed: true},
+		},
+	}.ApplyNoError(t)
+}
@@ -379,6 +379,62 @@ func TestFailIfMetastoreIdIsWrong(t *testing.T) {
 		"If the metastore assigned to the workspace has changed, the new metastore id must be explicitly set")
 }
 
+func TestCreateCatalogWaitsForMetastoreAssignment(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/current-metastore-assignment",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "METASTORE_DOES_NOT_EXIST",
+					Message:   "No metastore assigned for the current workspace.",
+				},
+				Status: 404,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/current-metastore-assignment",
+				Response: catalog.MetastoreAssignment{
+					MetastoreId: "abc",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/catalogs",
+				ExpectedRequest: catalog.CreateCatalog{
+					Name:    "a",
+					Comment: "b",
+				},
+				Response: catalog.CatalogInfo{
+					Name:        "a",
+					Comment:     "b",
+					MetastoreId: "abc",
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/schemas/a.default?",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/catalogs/a?",
+				Response: catalog.CatalogInfo{
+					Name:        "a",
+					Comment:     "b",
+					MetastoreId: "abc",
+				},
+			},
+		},
+		Resource: ResourceCatalog(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		comment = "b"
+		metastore_id = "abc"
+		`,
+	}.ApplyNoError(t)
+}
+
 func TestUpdateCatalogIfMetastoreIdChanges(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -45,12 +45,15 @@ func ResourceConnection() common.Resource {
 		})
 	return common.Resource{
 		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(metastoreAssignmentPropagationTimeout),
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
-			err = validateMetastoreId(ctx, w, d.Get("metastore_id").(string))
+			err = validateMetastoreIdOnCreate(ctx, w, d.Get("metastore_id").(string), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
@@ -157,5 +160,6 @@ func ResourceConnection() common.Resource {
 			}
 			return w.Connections.DeleteByName(ctx, connName)
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -0,0 +1,176 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maxReportedManagedTables limits the number of tables in the error message
+const maxReportedManagedTables = 5
+
+type defaultStorage struct {
+	MetastoreID             string `json:"metastore_id,omitempty" tf:"computed"`
+	StorageRoot             string `json:"storage_root"`
+	StorageRootCredentialID string `json:"storage_root_credential_id,omitempty" tf:"computed"`
+	ForceUpdate             bool   `json:"force_update,omitempty"`
+}
+
+// sameStorageRoot checks if the storage root of the metastore is the one from the configuration. The API
+// may return it with the ID of the metastore appended.
+func sameStorageRoot(current, desired, metastoreID string) bool {
+	current = strings.TrimSuffix(current, "/")
+	desired = strings.TrimSuffix(desired, "/")
+	return current == desired || (metastoreID != "" && current == desired+"/"+metastoreID)
+}
+
+// managedTablesInStorageRoot returns full names of managed tables, that are stored in the storage root of
+// the metastore, because neither their catalog nor their schema has its own storage root
+func managedTablesInStorageRoot(ctx context.Context, w *databricks.WorkspaceClient) ([]string, error) {
+	catalogs, err := w.Catalogs.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables := []string{}
+	for _, ci := range catalogs {
+		if ci.CatalogType != catalog.CatalogTypeManagedCatalog || ci.StorageRoot != "" {
+			continue
+		}
+		schemas, err := w.Schemas.ListAll(ctx, catalog.ListSchemasRequest{
+			CatalogName: ci.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, si := range schemas {
+			if si.Name == "information_schema" || si.StorageRoot != "" {
+				continue
+			}
+			all, err := w.Tables.ListAll(ctx, catalog.ListTablesRequest{
+				CatalogName: ci.Name,
+				SchemaName:  si.Name,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, ti := range all {
+				if ti.TableType == catalog.TableTypeManaged {
+					tables = append(tables, ti.FullName)
+				}
+			}
+		}
+	}
+	return tables, nil
+}
+
+// checkStorageRootSwitch fails if managed tables would be left behind in the current storage root
+func checkStorageRootSwitch(ctx context.Context, w *databricks.WorkspaceClient, metastoreID, from, to string) error {
+	if from == "" || sameStorageRoot(from, to, metastoreID) {
+		return nil
+	}
+	tables, err := managedTablesInStorageRoot(ctx, w)
+	if err != nil {
+		return err
+	}
+	if len(tables) == 0 {
+		return nil
+	}
+	examples := tables
+	if len(examples) > maxReportedManagedTables {
+		examples = examples[:maxReportedManagedTables]
+	}
+	return fmt.Errorf("cannot change storage_root of metastore %s from %s to %s, because %d managed tables "+
+		"are stored in it, like %s. Move the tables or set force_update = true",
+		metastoreID, from, to, len(tables), strings.Join(examples, ", "))
+}
+
+func ResourceDefaultStorage() common.Resource {
+	s := common.StructToSchema(defaultStorage{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["storage_root"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+				if new != "" && sameStorageRoot(old, new, d.Id()) {
+					log.Printf("[DEBUG] Ignoring configuration drift from %s to %s", old, new)
+					return true
+				}
+				return false
+			}
+			return m
+		})
+	update := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient, metastoreID string) error {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		mi, err := w.Metastores.GetById(ctx, metastoreID)
+		if err != nil {
+			return err
+		}
+		var ds defaultStorage
+		common.DataToStructPointer(d, s, &ds)
+		patch := map[string]any{}
+		if !sameStorageRoot(mi.StorageRoot, ds.StorageRoot, metastoreID) {
+			if !ds.ForceUpdate {
+				err = checkStorageRootSwitch(ctx, w, metastoreID, mi.StorageRoot, ds.StorageRoot)
+				if err != nil {
+					return err
+				}
+			}
+			patch["storage_root"] = ds.StorageRoot
+		}
+		if ds.StorageRootCredentialID != "" && ds.StorageRootCredentialID != mi.StorageRootCredentialId {
+			patch["storage_root_credential_id"] = ds.StorageRootCredentialID
+		}
+		if len(patch) == 0 {
+			return nil
+		}
+		return c.Patch(context.WithValue(ctx, common.Api, common.API_2_1),
+			"/unity-catalog/metastores/"+metastoreID, patch)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			current, err := w.Metastores.Current(ctx)
+			if err != nil {
+				return err
+			}
+			err = update(ctx, d, c, current.MetastoreId)
+			if err != nil {
+				return err
+			}
+			d.SetId(current.MetastoreId)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			mi, err := w.Metastores.GetById(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			d.Set("metastore_id", mi.MetastoreId)
+			d.Set("storage_root", mi.StorageRoot)
+			d.Set("storage_root_credential_id", mi.StorageRootCredentialId)
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return update(ctx, d, c, d.Id())
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			log.Printf("[INFO] Storage root of metastore %s can't be removed, leaving it as is", d.Id())
+			return nil
+		},
+		WorkspaceLevel: true,
+	}
+}
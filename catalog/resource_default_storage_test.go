@@ -0,0 +1,206 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+var currentMetastoreFixture = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.1/unity-catalog/current-metastore-assignment",
+	Response: catalog.MetastoreAssignment{
+		MetastoreId: "abc",
+		WorkspaceId: 123,
+	},
+}
+
+func metastoreFixture(storageRoot string) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   "GET",
+		Resource: "/api/2.1/unity-catalog/metastores/abc?",
+		Response: catalog.MetastoreInfo{
+			MetastoreId:             "abc",
+			StorageRoot:             storageRoot,
+			StorageRootCredentialId: "cred",
+		},
+	}
+}
+
+var managedTablesFixtures = []qa.HTTPFixture{
+	{
+		Method:   "GET",
+		Resource: "/api/2.1/unity-catalog/catalogs",
+		Response: catalog.ListCatalogsResponse{
+			Catalogs: []catalog.CatalogInfo{
+				{Name: "main", CatalogType: catalog.CatalogTypeManagedCatalog},
+				{Name: "sales", CatalogType: catalog.CatalogTypeManagedCatalog, StorageRoot: "s3://sales"},
+				{Name: "system", CatalogType: catalog.CatalogTypeSystemCatalog},
+			},
+		},
+	},
+	{
+		Method:   "GET",
+		Resource: "/api/2.1/unity-catalog/schemas?catalog_name=main",
+		Response: catalog.ListSchemasResponse{
+			Schemas: []catalog.SchemaInfo{
+				{Name: "default", CatalogName: "main"},
+				{Name: "raw", CatalogName: "main", StorageRoot: "s3://raw"},
+				{Name: "information_schema", CatalogName: "main"},
+			},
+		},
+	},
+}
+
+func TestResourceDefaultStorageCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			currentMetastoreFixture,
+			metastoreFixture(""),
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				ExpectedRequest: map[string]any{
+					"storage_root": "s3://new-root",
+				},
+			},
+			metastoreFixture("s3://new-root/abc"),
+		},
+		Resource: ResourceDefaultStorage(),
+		Create:   true,
+		HCL:      `storage_root = "s3://new-root"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "abc",
+		"metastore_id":               "abc",
+		"storage_root":               "s3://new-root/abc",
+		"storage_root_credential_id": "cred",
+	})
+}
+
+func TestResourceDefaultStorageUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: append(append([]qa.HTTPFixture{
+			metastoreFixture("s3://old-root/abc"),
+		}, managedTablesFixtures...),
+			qa.HTTPFixture{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables?catalog_name=main&schema_name=default",
+				Response: catalog.ListTablesResponse{
+					Tables: []catalog.TableInfo{
+						{FullName: "main.default.events", TableType: catalog.TableTypeExternal},
+						{FullName: "main.default.v", TableType: catalog.TableTypeView},
+					},
+				},
+			},
+			qa.HTTPFixture{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				ExpectedRequest: map[string]any{
+					"storage_root":               "s3://new-root",
+					"storage_root_credential_id": "new-cred",
+				},
+			},
+			metastoreFixture("s3://new-root/abc"),
+		),
+		Resource: ResourceDefaultStorage(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"metastore_id":               "abc",
+			"storage_root":               "s3://old-root/abc",
+			"storage_root_credential_id": "cred",
+		},
+		HCL: `
+		storage_root = "s3://new-root"
+		storage_root_credential_id = "new-cred"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceDefaultStorageUpdate_ManagedTables(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: append(append([]qa.HTTPFixture{
+			metastoreFixture("s3://old-root/abc"),
+		}, managedTablesFixtures...),
+			qa.HTTPFixture{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables?catalog_name=main&schema_name=default",
+				Response: catalog.ListTablesResponse{
+					Tables: []catalog.TableInfo{
+						{FullName: "main.default.events", TableType: catalog.TableTypeManaged},
+					},
+				},
+			},
+		),
+		Resource: ResourceDefaultStorage(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"metastore_id": "abc",
+			"storage_root": "s3://old-root/abc",
+		},
+		HCL: `storage_root = "s3://new-root"`,
+	}.ExpectError(t, "cannot change storage_root of metastore abc from s3://old-root/abc to s3://new-root, "+
+		"because 1 managed tables are stored in it, like main.default.events. Move the tables or set force_update = true")
+}
+
+func TestResourceDefaultStorageUpdate_Force(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			metastoreFixture("s3://old-root/abc"),
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				ExpectedRequest: map[string]any{
+					"storage_root": "s3://new-root",
+				},
+			},
+			metastoreFixture("s3://new-root/abc"),
+		},
+		Resource: ResourceDefaultStorage(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"metastore_id": "abc",
+			"storage_root": "s3://old-root/abc",
+		},
+		HCL: `
+		storage_root = "s3://new-root"
+		force_update = true
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceDefaultStorageRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			metastoreFixture("s3://root/abc"),
+		},
+		Resource: ResourceDefaultStorage(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"storage_root": "s3://root/abc",
+	})
+}
+
+func TestResourceDefaultStorageDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceDefaultStorage(),
+		Delete:   true,
+		ID:       "abc",
+		HCL:      `storage_root = "s3://root"`,
+	}.ApplyNoError(t)
+}
+
+func TestSameStorageRoot(t *testing.T) {
+	assert.True(t, sameStorageRoot("s3://root", "s3://root/", "abc"))
+	assert.True(t, sameStorageRoot("s3://root/abc", "s3://root", "abc"))
+	assert.True(t, sameStorageRoot("s3://root/abc/", "s3://root/", "abc"))
+	assert.False(t, sameStorageRoot("s3://root/data", "s3://root", "abc"))
+	assert.False(t, sameStorageRoot("s3://root/data/abc", "s3://root", "abc"))
+	assert.False(t, sameStorageRoot("s3://root/abc", "s3://root", ""))
+}
@@ -41,18 +41,25 @@ func ResourceExternalLocation() common.Resource {
 		})
 	return common.Resource{
 		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(credentialValidationTimeout),
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
-			err = validateMetastoreId(ctx, w, d.Get("metastore_id").(string))
+			err = validateMetastoreIdOnCreate(ctx, w, d.Get("metastore_id").(string), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
 			var createExternalLocationRequest catalog.CreateExternalLocation
 			common.DataToStructPointer(d, s, &createExternalLocationRequest)
-			el, err := w.ExternalLocations.Create(ctx, createExternalLocationRequest)
+			var el *catalog.ExternalLocationInfo
+			err = retryCredentialValidation(ctx, d.Timeout(schema.TimeoutCreate), func() (err error) {
+				el, err = w.ExternalLocations.Create(ctx, createExternalLocationRequest)
+				return err
+			})
 			if err != nil {
 				return err
 			}
@@ -145,5 +152,6 @@ func ResourceExternalLocation() common.Resource {
 				Force: force,
 			})
 		},
+		WorkspaceLevel: true,
 	}
 }
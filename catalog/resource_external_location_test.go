@@ -52,6 +52,53 @@ func TestCreateExternalLocation(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestCreateExternalLocationRetriesValidation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/external-locations",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "PERMISSION_DENIED",
+					Message: "User: arn:aws:sts::123:assumed-role/uc/databricks is not authorized to perform: " +
+						"sts:AssumeRole on resource: arn:aws:iam::123:role/uc",
+				},
+				Status: 403,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/external-locations",
+				ExpectedRequest: catalog.CreateExternalLocation{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+				Response: catalog.ExternalLocationInfo{
+					Name: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc?",
+				Response: catalog.ExternalLocationInfo{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Create:   true,
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "abc",
+	})
+}
+
 func TestCreateExternalLocationWithOwner(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
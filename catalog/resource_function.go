@@ -0,0 +1,185 @@
+package catalog
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type FunctionParameter struct {
+	Name string `json:"name"`
+	// The full data type specification, like `DECIMAL(10,2)` or `ARRAY<STRING>`
+	TypeText string `json:"type_text"`
+	// The name of the type, like `DECIMAL` or `ARRAY`
+	TypeName         string `json:"type_name"`
+	Comment          string `json:"comment,omitempty"`
+	ParameterDefault string `json:"parameter_default,omitempty"`
+}
+
+// FunctionInfo is the user-facing subset of catalog.CreateFunction. Unity Catalog doesn't allow
+// changing anything but the owner of a function, so all other fields require re-creation.
+type FunctionInfo struct {
+	CatalogName string              `json:"catalog_name" tf:"force_new"`
+	SchemaName  string              `json:"schema_name" tf:"force_new"`
+	Name        string              `json:"name" tf:"force_new"`
+	Comment     string              `json:"comment,omitempty" tf:"force_new"`
+	InputParams []FunctionParameter `json:"input_params,omitempty" tf:"force_new"`
+	// The name of the return type, like `DECIMAL` or `TABLE`
+	DataType string `json:"data_type" tf:"force_new"`
+	// The full return type specification, like `DECIMAL(10,2)`
+	FullDataType string `json:"full_data_type" tf:"force_new"`
+	// `SQL` for SQL functions or `EXTERNAL` for functions in an external language
+	RoutineBody       string `json:"routine_body,omitempty" tf:"force_new,default:SQL"`
+	ExternalLanguage  string `json:"external_language,omitempty" tf:"force_new"`
+	RoutineDefinition string `json:"routine_definition" tf:"force_new"`
+	IsDeterministic   bool   `json:"is_deterministic,omitempty" tf:"force_new,default:true"`
+	SecurityType      string `json:"security_type,omitempty" tf:"force_new,default:DEFINER"`
+	SqlDataAccess     string `json:"sql_data_access,omitempty" tf:"force_new,default:CONTAINS_SQL"`
+	Owner             string `json:"owner,omitempty" tf:"computed"`
+	FullName          string `json:"full_name,omitempty" tf:"computed"`
+}
+
+func (fi FunctionInfo) createRequest() catalog.CreateFunctionRequest {
+	params := []catalog.FunctionParameterInfo{}
+	for i, p := range fi.InputParams {
+		params = append(params, catalog.FunctionParameterInfo{
+			Name:             p.Name,
+			TypeText:         p.TypeText,
+			TypeName:         catalog.ColumnTypeName(p.TypeName),
+			Comment:          p.Comment,
+			ParameterDefault: p.ParameterDefault,
+			Position:         i,
+		})
+	}
+	return catalog.CreateFunctionRequest{
+		FunctionInfo: catalog.CreateFunction{
+			CatalogName:       fi.CatalogName,
+			SchemaName:        fi.SchemaName,
+			Name:              fi.Name,
+			SpecificName:      fi.Name,
+			Comment:           fi.Comment,
+			InputParams:       catalog.FunctionParameterInfos{Parameters: params},
+			DataType:          catalog.ColumnTypeName(fi.DataType),
+			FullDataType:      fi.FullDataType,
+			ParameterStyle:    catalog.CreateFunctionParameterStyleS,
+			RoutineBody:       catalog.CreateFunctionRoutineBody(fi.RoutineBody),
+			ExternalLanguage:  fi.ExternalLanguage,
+			RoutineDefinition: fi.RoutineDefinition,
+			IsDeterministic:   fi.IsDeterministic,
+			SecurityType:      catalog.CreateFunctionSecurityType(fi.SecurityType),
+			SqlDataAccess:     catalog.CreateFunctionSqlDataAccess(fi.SqlDataAccess),
+		},
+	}
+}
+
+func functionInfoFromAPI(f *catalog.FunctionInfo) FunctionInfo {
+	fi := FunctionInfo{
+		CatalogName:       f.CatalogName,
+		SchemaName:        f.SchemaName,
+		Name:              f.Name,
+		Comment:           f.Comment,
+		DataType:          string(f.DataType),
+		FullDataType:      f.FullDataType,
+		RoutineBody:       string(f.RoutineBody),
+		ExternalLanguage:  f.ExternalLanguage,
+		RoutineDefinition: f.RoutineDefinition,
+		IsDeterministic:   f.IsDeterministic,
+		SecurityType:      string(f.SecurityType),
+		SqlDataAccess:     string(f.SqlDataAccess),
+		Owner:             f.Owner,
+		FullName:          f.FullName,
+	}
+	if f.InputParams == nil {
+		return fi
+	}
+	params := f.InputParams.Parameters
+	sort.SliceStable(params, func(i, j int) bool {
+		return params[i].Position < params[j].Position
+	})
+	for _, p := range params {
+		fi.InputParams = append(fi.InputParams, FunctionParameter{
+			Name:             p.Name,
+			TypeText:         p.TypeText,
+			TypeName:         string(p.TypeName),
+			Comment:          p.Comment,
+			ParameterDefault: p.ParameterDefault,
+		})
+	}
+	return fi
+}
+
+// routineDefinitionSuppressDiff ignores leading and trailing whitespace of the function body,
+// which is commonly added by heredoc strings
+func routineDefinitionSuppressDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func ResourceFunction() common.Resource {
+	s := common.StructToSchema(FunctionInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["routine_definition"].DiffSuppressFunc = routineDefinitionSuppressDiff
+		m["routine_body"].ValidateFunc = validation.StringInSlice([]string{"SQL", "EXTERNAL"}, false)
+		m["security_type"].ValidateFunc = validation.StringInSlice([]string{"DEFINER"}, false)
+		m["sql_data_access"].ValidateFunc = validation.StringInSlice(
+			[]string{"CONTAINS_SQL", "NO_SQL", "READS_SQL_DATA"}, false)
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var fi FunctionInfo
+			common.DataToStructPointer(d, s, &fi)
+			f, err := w.Functions.Create(ctx, fi.createRequest())
+			if err != nil {
+				return err
+			}
+			d.SetId(f.FullName)
+			if fi.Owner == "" {
+				return nil
+			}
+			_, err = w.Functions.Update(ctx, catalog.UpdateFunction{
+				Name:  f.FullName,
+				Owner: fi.Owner,
+			})
+			return err
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			f, err := w.Functions.GetByName(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(functionInfoFromAPI(f), s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			_, err = w.Functions.Update(ctx, catalog.UpdateFunction{
+				Name:  d.Id(),
+				Owner: d.Get("owner").(string),
+			})
+			return err
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.Functions.DeleteByName(ctx, d.Id())
+		},
+		WorkspaceLevel: true,
+	}
+}
@@ -0,0 +1,306 @@
+package catalog
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestFunctionCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceFunction())
+}
+
+var testFunctionInfo = catalog.FunctionInfo{
+	CatalogName: "main",
+	SchemaName:  "default",
+	Name:        "add_tax",
+	FullName:    "main.default.add_tax",
+	InputParams: &catalog.FunctionParameterInfos{
+		Parameters: []catalog.FunctionParameterInfo{
+			{
+				Name:     "rate",
+				TypeText: "double",
+				TypeName: "DOUBLE",
+				Position: 1,
+			},
+			{
+				Name:     "amount",
+				TypeText: "decimal(10,2)",
+				TypeName: "DECIMAL",
+				Position: 0,
+			},
+		},
+	},
+	DataType:          "DOUBLE",
+	FullDataType:      "double",
+	RoutineBody:       "SQL",
+	RoutineDefinition: "amount * (1 + rate)",
+	IsDeterministic:   true,
+	SecurityType:      "DEFINER",
+	SqlDataAccess:     "CONTAINS_SQL",
+	Owner:             "me",
+}
+
+func TestFunctionCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/functions",
+				ExpectedRequest: catalog.CreateFunctionRequest{
+					FunctionInfo: catalog.CreateFunction{
+						CatalogName:  "main",
+						SchemaName:   "default",
+						Name:         "add_tax",
+						SpecificName: "add_tax",
+						InputParams: catalog.FunctionParameterInfos{
+							Parameters: []catalog.FunctionParameterInfo{
+								{
+									Name:     "amount",
+									TypeText: "decimal(10,2)",
+									TypeName: "DECIMAL",
+									Position: 0,
+								},
+								{
+									Name:     "rate",
+									TypeText: "double",
+									TypeName: "DOUBLE",
+									Position: 1,
+								},
+							},
+						},
+						DataType:          "DOUBLE",
+						FullDataType:      "double",
+						ParameterStyle:    "S",
+						RoutineBody:       "SQL",
+						RoutineDefinition: "amount * (1 + rate)\n",
+						IsDeterministic:   true,
+						SecurityType:      "DEFINER",
+						SqlDataAccess:     "CONTAINS_SQL",
+					},
+				},
+				Response: testFunctionInfo,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/functions/main.default.add_tax?",
+				Response: testFunctionInfo,
+			},
+		},
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		catalog_name = "main"
+		schema_name = "default"
+		name = "add_tax"
+		input_params {
+			name = "amount"
+			type_text = "decimal(10,2)"
+			type_name = "DECIMAL"
+		}
+		input_params {
+			name = "rate"
+			type_text = "double"
+			type_name = "DOUBLE"
+		}
+		data_type = "DOUBLE"
+		full_data_type = "double"
+		routine_definition = <<EOT
+amount * (1 + rate)
+EOT
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                  "main.default.add_tax",
+		"owner":               "me",
+		"input_params.0.name": "amount",
+		"input_params.1.name": "rate",
+	})
+}
+
+func TestFunctionCreatePythonWithOwner(t *testing.T) {
+	pythonFunction := catalog.FunctionInfo{
+		CatalogName:       "main",
+		SchemaName:        "default",
+		Name:              "greet",
+		FullName:          "main.default.greet",
+		InputParams:       &catalog.FunctionParameterInfos{},
+		DataType:          "STRING",
+		FullDataType:      "string",
+		RoutineBody:       "EXTERNAL",
+		ExternalLanguage:  "Python",
+		RoutineDefinition: "return 'Hello'",
+		SecurityType:      "DEFINER",
+		SqlDataAccess:     "NO_SQL",
+		Owner:             "data-engineers",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/functions",
+				ExpectedRequest: catalog.CreateFunctionRequest{
+					FunctionInfo: catalog.CreateFunction{
+						CatalogName:  "main",
+						SchemaName:   "default",
+						Name:         "greet",
+						SpecificName: "greet",
+						InputParams: catalog.FunctionParameterInfos{
+							Parameters: []catalog.FunctionParameterInfo{},
+						},
+						DataType:          "STRING",
+						FullDataType:      "string",
+						ParameterStyle:    "S",
+						RoutineBody:       "EXTERNAL",
+						ExternalLanguage:  "Python",
+						RoutineDefinition: "return 'Hello'",
+						SecurityType:      "DEFINER",
+						SqlDataAccess:     "NO_SQL",
+					},
+				},
+				Response: pythonFunction,
+			},
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.1/unity-catalog/functions/main.default.greet",
+				ExpectedRequest: catalog.UpdateFunction{
+					Owner: "data-engineers",
+				},
+				Response: pythonFunction,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/functions/main.default.greet?",
+				Response: pythonFunction,
+			},
+		},
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		catalog_name = "main"
+		schema_name = "default"
+		name = "greet"
+		data_type = "STRING"
+		full_data_type = "string"
+		routine_body = "EXTERNAL"
+		external_language = "Python"
+		routine_definition = "return 'Hello'"
+		is_deterministic = false
+		sql_data_access = "NO_SQL"
+		owner = "data-engineers"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "main.default.greet",
+		"is_deterministic": false,
+	})
+}
+
+func TestFunctionRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/functions/main.default.add_tax?",
+				Response: testFunctionInfo,
+			},
+		},
+		Resource: ResourceFunction(),
+		Read:     true,
+		New:      true,
+		ID:       "main.default.add_tax",
+	}.ApplyAndExpectData(t, map[string]any{
+		"routine_definition":       "amount * (1 + rate)",
+		"input_params.#":           2,
+		"input_params.0.type_text": "decimal(10,2)",
+		"input_params.1.type_text": "double",
+		"full_name":                "main.default.add_tax",
+	})
+}
+
+func TestFunctionBodyChangeRequiresNew(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFunction(),
+		ID:       "main.default.f",
+		Update:   true,
+		InstanceState: map[string]string{
+			"catalog_name":       "main",
+			"schema_name":        "default",
+			"name":               "f",
+			"data_type":          "INT",
+			"full_data_type":     "int",
+			"routine_body":       "SQL",
+			"routine_definition": "1",
+			"is_deterministic":   "true",
+			"security_type":      "DEFINER",
+			"sql_data_access":    "CONTAINS_SQL",
+		},
+		HCL: `
+		catalog_name = "main"
+		schema_name = "default"
+		name = "f"
+		data_type = "INT"
+		full_data_type = "int"
+		routine_definition = "2"
+		`,
+	}.ExpectError(t, "changes require new: routine_definition")
+}
+
+func TestFunctionUpdateOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.1/unity-catalog/functions/main.default.add_tax",
+				ExpectedRequest: catalog.UpdateFunction{
+					Owner: "me",
+				},
+				Response: testFunctionInfo,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/functions/main.default.add_tax?",
+				Response: testFunctionInfo,
+			},
+		},
+		Resource: ResourceFunction(),
+		Update:   true,
+		ID:       "main.default.add_tax",
+		InstanceState: map[string]string{
+			"catalog_name":       "main",
+			"schema_name":        "default",
+			"name":               "add_tax",
+			"data_type":          "DOUBLE",
+			"full_data_type":     "double",
+			"routine_body":       "SQL",
+			"routine_definition": "amount * (1 + rate)",
+			"is_deterministic":   "true",
+			"security_type":      "DEFINER",
+			"sql_data_access":    "CONTAINS_SQL",
+			"owner":              "someone",
+		},
+		HCL: `
+		catalog_name = "main"
+		schema_name = "default"
+		name = "add_tax"
+		data_type = "DOUBLE"
+		full_data_type = "double"
+		routine_definition = "amount * (1 + rate)"
+		owner = "me"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestFunctionDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.1/unity-catalog/functions/main.default.add_tax?",
+			},
+		},
+		Resource: ResourceFunction(),
+		Delete:   true,
+		ID:       "main.default.add_tax",
+	}.ApplyNoError(t)
+}
@@ -69,16 +69,17 @@ func diffPermissionsForPrincipal(principal string, desired catalog.PermissionsLi
 // replacePermissionsForPrincipal merges removal diff of existing permissions on the platform
 func replacePermissionsForPrincipal(a permissions.UnityCatalogPermissionsAPI, securable string, name string, principal string, list catalog.PermissionsList) error {
 	securableType := permissions.Mappings.GetSecurableType(securable)
+	list = permissions.CanonicalPermissionsList(securable, list)
 	existing, err := a.GetPermissions(securableType, name)
 	if err != nil {
 		return err
 	}
-	err = a.UpdatePermissions(securableType, name, diffPermissionsForPrincipal(principal, list, *existing))
+	err = a.UpdatePermissions(securableType, name, diffPermissionsForPrincipal(principal, list, permissions.CanonicalPermissionsList(securable, *existing)))
 	if err != nil {
 		return err
 	}
 	return a.WaitForUpdate(1*time.Minute, securableType, name, list, func(current *catalog.PermissionsList, desired catalog.PermissionsList) []catalog.PermissionsChange {
-		return diffPermissionsForPrincipal(principal, desired, *current)
+		return diffPermissionsForPrincipal(principal, desired, permissions.CanonicalPermissionsList(securable, *current))
 	})
 }
 
@@ -124,6 +125,7 @@ func ResourceGrant() common.Resource {
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
 
 			m["principal"].ForceNew = true
+			m["privileges"].Elem.(*schema.Schema).ValidateDiagFunc = permissions.ValidateDeprecatedPrivilege
 
 			allFields := []string{}
 			for field := range permissions.Mappings {
@@ -143,12 +145,15 @@ func ResourceGrant() common.Resource {
 
 	return common.Resource{
 		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(metastoreAssignmentPropagationTimeout),
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
-			err = validateMetastoreId(ctx, w, d.Get("metastore").(string))
+			err = validateMetastoreIdOnCreate(ctx, w, d.Get("metastore").(string), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
@@ -180,6 +185,12 @@ func ResourceGrant() common.Resource {
 			if err != nil {
 				return err
 			}
+			configured := map[string][]string{}
+			for _, p := range d.Get("privileges").(*schema.Set).List() {
+				configured[principal] = append(configured[principal], p.(string))
+			}
+			// privileges renamed on the platform are reported with the names used in the configuration
+			*grants = permissions.KeepConfiguredAliases(securable, *grants, configured)
 			grantsForPrincipal, err := filterPermissionsForPrincipal(*grants, principal)
 			if err != nil {
 				return err
@@ -231,5 +242,6 @@ func ResourceGrant() common.Resource {
 			unityCatalogPermissionsAPI := permissions.NewUnityCatalogPermissionsAPI(ctx, c)
 			return replacePermissionsForPrincipal(unityCatalogPermissionsAPI, securable, name, principal, catalog.PermissionsList{})
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -76,16 +76,17 @@ func diffPermissions(pl catalog.PermissionsList, existing catalog.PermissionsLis
 // replaceAllPermissions merges removal diff of existing permissions on the platform
 func replaceAllPermissions(a permissions.UnityCatalogPermissionsAPI, securable string, name string, list catalog.PermissionsList) error {
 	securableType := permissions.Mappings.GetSecurableType(securable)
+	list = permissions.CanonicalPermissionsList(securable, list)
 	existing, err := a.GetPermissions(securableType, name)
 	if err != nil {
 		return err
 	}
-	err = a.UpdatePermissions(securableType, name, diffPermissions(list, *existing))
+	err = a.UpdatePermissions(securableType, name, diffPermissions(list, permissions.CanonicalPermissionsList(securable, *existing)))
 	if err != nil {
 		return err
 	}
 	return a.WaitForUpdate(1*time.Minute, securableType, name, list, func(current *catalog.PermissionsList, desired catalog.PermissionsList) []catalog.PermissionsChange {
-		return diffPermissions(desired, *current)
+		return diffPermissions(desired, permissions.CanonicalPermissionsList(securable, *current))
 	})
 }
 
@@ -117,6 +118,19 @@ func sdkPermissionsListToPermissionsList(sdkPermissionsList catalog.PermissionsL
 	return
 }
 
+// configuredPrivileges returns privileges of every principal in the `grant` blocks
+func configuredPrivileges(d *schema.ResourceData) map[string][]string {
+	configured := map[string][]string{}
+	for _, v := range d.Get("grant").(*schema.Set).List() {
+		grant := v.(map[string]any)
+		principal := grant["principal"].(string)
+		for _, p := range grant["privileges"].(*schema.Set).List() {
+			configured[principal] = append(configured[principal], p.(string))
+		}
+	}
+	return configured
+}
+
 func parseId(d *schema.ResourceData) (string, string, error) {
 	split := strings.SplitN(d.Id(), "/", 2)
 	if len(split) != 2 {
@@ -140,16 +154,21 @@ func ResourceGrants() common.Resource {
 			for field := range permissions.Mappings {
 				s[field].AtLeastOneOf = alof
 			}
+			common.MustSchemaPath(s, "grant", "privileges").Elem.(*schema.Schema).ValidateDiagFunc =
+				permissions.ValidateDeprecatedPrivilege
 			return s
 		})
 	return common.Resource{
 		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(metastoreAssignmentPropagationTimeout),
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
-			err = validateMetastoreId(ctx, w, d.Get("metastore").(string))
+			err = validateMetastoreIdOnCreate(ctx, w, d.Get("metastore").(string), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
@@ -178,6 +197,8 @@ func ResourceGrants() common.Resource {
 				return apierr.NotFound("got empty permissions list")
 			}
 
+			// privileges renamed on the platform are reported with the names used in the configuration
+			*grants = permissions.KeepConfiguredAliases(securable, *grants, configuredPrivileges(d))
 			err = common.StructToData(sdkPermissionsListToPermissionsList(*grants), s, d)
 			if err != nil {
 				return err
@@ -218,5 +239,6 @@ func ResourceGrants() common.Resource {
 			unityCatalogPermissionsAPI := permissions.NewUnityCatalogPermissionsAPI(ctx, c)
 			return replaceAllPermissions(unityCatalogPermissionsAPI, securable, name, catalog.PermissionsList{})
 		},
+		WorkspaceLevel: true,
 	}
 }
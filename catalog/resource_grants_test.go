@@ -4,7 +4,12 @@ import (
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/catalog/permissions"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -85,6 +90,98 @@ func TestGrantCreate(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestGrantCreateWithRenamedPrivileges(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/catalog/main?",
+				Response: catalog.PermissionsList{
+					PrivilegeAssignments: []catalog.PrivilegeAssignment{
+						{
+							Principal:  "me",
+							Privileges: []catalog.Privilege{"USE_CATALOG"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/permissions/catalog/main",
+				ExpectedRequest: catalog.UpdatePermissions{
+					Changes: []catalog.PermissionsChange{
+						{
+							Principal: "me",
+							Add:       []catalog.Privilege{"CREATE_SCHEMA"},
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/permissions/catalog/main?",
+				ReuseRequest: true,
+				Response: catalog.PermissionsList{
+					PrivilegeAssignments: []catalog.PrivilegeAssignment{
+						{
+							Principal:  "me",
+							Privileges: []catalog.Privilege{"CREATE_SCHEMA", "USE_CATALOG"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGrants(),
+		Create:   true,
+		HCL: `
+		catalog = "main"
+
+		grant {
+			principal = "me"
+			privileges = ["USAGE", "CREATE"]
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	grants := d.Get("grant").(*schema.Set).List()
+	assert.Len(t, grants, 1)
+	privileges := grants[0].(map[string]any)["privileges"].(*schema.Set)
+	assert.ElementsMatch(t, []any{"CREATE", "USAGE"}, privileges.List())
+}
+
+func TestCanonicalPrivilege(t *testing.T) {
+	assert.Equal(t, "USE_CATALOG", permissions.CanonicalPrivilege("catalog", "USAGE"))
+	assert.Equal(t, "USE_SCHEMA", permissions.CanonicalPrivilege("schema", "USAGE"))
+	assert.Equal(t, "CREATE_TABLE", permissions.CanonicalPrivilege("schema", "CREATE"))
+	assert.Equal(t, "CREATE_EXTERNAL_TABLE", permissions.CanonicalPrivilege("external_location", "CREATE_TABLE"))
+	assert.Equal(t, "ALL_PRIVILEGES", permissions.CanonicalPrivilege("table", "all privileges"))
+	assert.Equal(t, "SELECT", permissions.CanonicalPrivilege("table", "SELECT"))
+}
+
+func TestValidateDeprecatedPrivilege(t *testing.T) {
+	path := cty.GetAttrPath("privileges")
+	diags := permissions.ValidateDeprecatedPrivilege("USAGE", path)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "USAGE privilege is deprecated", diags[0].Summary)
+	assert.Equal(t, "USAGE privilege was renamed, please use USE_CATALOG on catalog, USE_SCHEMA on schema instead",
+		diags[0].Detail)
+	assert.Len(t, permissions.ValidateDeprecatedPrivilege("create", path), 1)
+	assert.Nil(t, permissions.ValidateDeprecatedPrivilege("CREATE_TABLE", path))
+	assert.Nil(t, permissions.ValidateDeprecatedPrivilege("SELECT", path))
+
+	diags = ResourceGrants().ToResource().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"catalog": "main",
+		"grant": []any{
+			map[string]any{
+				"principal":  "me",
+				"privileges": []any{"USAGE"},
+			},
+		},
+	}))
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+}
+
 func TestGrantCreateMetastoreId(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
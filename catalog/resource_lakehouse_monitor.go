@@ -15,7 +15,7 @@ import (
 const DefaultProvisionTimeout = 15 * time.Minute
 
 func WaitForMonitor(w *databricks.WorkspaceClient, ctx context.Context, monitorName string) error {
-	return retry.RetryContext(ctx, DefaultProvisionTimeout, func() *retry.RetryError {
+	return common.RetryContext(ctx, DefaultProvisionTimeout, func() *retry.RetryError {
 		endpoint, err := w.LakehouseMonitors.GetByFullName(ctx, monitorName)
 		if err != nil {
 			return retry.NonRetryableError(err)
@@ -116,5 +116,6 @@ func ResourceLakehouseMonitor() common.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -2,7 +2,9 @@ package catalog
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -32,6 +34,37 @@ type MetastoreInfo struct {
 	DeltaSharingOrganizationName                string `json:"delta_sharing_organization_name,omitempty"`
 }
 
+// metastoreRegions describes regions of clouds, that are identified by the scheme of storage_root
+var metastoreRegions = []struct {
+	scheme  string
+	cloud   string
+	region  *regexp.Regexp
+	example string
+}{
+	{"s3://", "AWS", regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-\d+$`), "us-east-1"},
+	{"abfss://", "Azure", regexp.MustCompile(`^[a-z]+\d*$`), "eastus2"},
+	{"gs://", "GCP", regexp.MustCompile(`^[a-z]+-[a-z]+\d+$`), "us-east1"},
+}
+
+// validateMetastoreRegion checks that the region looks like a region of the cloud implied by the scheme of
+// the storage root. The actual location of the bucket or container isn't checked.
+func validateMetastoreRegion(storageRoot, region string) error {
+	if storageRoot == "" || region == "" {
+		return nil
+	}
+	for _, v := range metastoreRegions {
+		if !strings.HasPrefix(strings.ToLower(storageRoot), v.scheme) {
+			continue
+		}
+		if v.region.MatchString(strings.ToLower(region)) {
+			return nil
+		}
+		return fmt.Errorf("region %s doesn't look like a region of %s, which storage_root %s belongs to. "+
+			"Use a %s region, like %s", region, v.cloud, storageRoot, v.cloud, v.example)
+	}
+	return nil
+}
+
 func updateForceSendFields(req *catalog.UpdateMetastore) {
 	if req.DeltaSharingScope != "" && !slices.Contains(req.ForceSendFields, "DeltaSharingRecipientTokenLifetimeInSeconds") {
 		req.ForceSendFields = append(req.ForceSendFields, "DeltaSharingRecipientTokenLifetimeInSeconds")
@@ -48,6 +81,7 @@ func ResourceMetastore() common.Resource {
 			m["delta_sharing_scope"].RequiredWith = []string{"delta_sharing_recipient_token_lifetime_in_seconds"}
 			m["delta_sharing_scope"].ValidateFunc = validation.StringInSlice([]string{"INTERNAL", "INTERNAL_AND_EXTERNAL"}, false)
 			m["delta_sharing_recipient_token_lifetime_in_seconds"].RequiredWith = []string{"delta_sharing_scope"}
+			m["delta_sharing_recipient_token_lifetime_in_seconds"].ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(0))
 			m["storage_root"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
 				if strings.HasPrefix(old, new) {
 					log.Printf("[DEBUG] Ignoring configuration drift from %s to %s", old, new)
@@ -60,6 +94,19 @@ func ResourceMetastore() common.Resource {
 
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if d.HasChange("region") {
+				old, new := d.GetChange("region")
+				if old.(string) != "" && new.(string) != "" && !strings.EqualFold(old.(string), new.(string)) {
+					return fmt.Errorf("region of metastore can't be changed from %s to %s", old, new)
+				}
+			}
+			if !d.HasChanges("storage_root", "region") ||
+				!d.NewValueKnown("storage_root") || !d.NewValueKnown("region") {
+				return nil
+			}
+			return validateMetastoreRegion(d.Get("storage_root").(string), d.Get("region").(string))
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var create catalog.CreateMetastore
 			var update catalog.UpdateMetastore
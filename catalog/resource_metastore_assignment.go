@@ -2,14 +2,83 @@ package catalog
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"strconv"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const metastoreAssignmentPropagationTimeout = 5 * time.Minute
+
+// currentAssignedMetastoreId returns the id of the metastore assigned to the workspace
+// or an empty string, if there's no assignment yet.
+func currentAssignedMetastoreId(ctx context.Context, c *common.DatabricksClient, workspaceId int64) (string, error) {
+	var metastoreId string
+	err := c.AccountOrWorkspaceRequest(func(acc *databricks.AccountClient) error {
+		ma, err := acc.MetastoreAssignments.GetByWorkspaceId(ctx, workspaceId)
+		if err != nil {
+			return err
+		}
+		if ma.MetastoreAssignment != nil {
+			metastoreId = ma.MetastoreAssignment.MetastoreId
+		}
+		return nil
+	}, func(w *databricks.WorkspaceClient) error {
+		ma, err := w.Metastores.Current(ctx)
+		if err != nil {
+			return err
+		}
+		metastoreId = ma.MetastoreId
+		return nil
+	})
+	if apierr.IsMissing(err) {
+		return "", nil
+	}
+	return metastoreId, err
+}
+
+// Metastore assignment takes some time to propagate, so resources that require a metastore,
+// like catalogs or grants, may fail when created in the same apply. We wait for the assignment
+// to become visible, so that dependent resources don't need an artificial delay.
+//
+// At workspace level, only the assignment of the current workspace can be read, so assignments
+// to other workspaces aren't awaited.
+func waitForMetastoreAssignment(ctx context.Context, c *common.DatabricksClient,
+	timeout time.Duration, workspaceId int64, metastoreId string) error {
+	if !c.Config.IsAccountClient() {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		currentWorkspaceId, err := w.CurrentWorkspaceID(ctx)
+		if err != nil {
+			return err
+		}
+		if currentWorkspaceId != workspaceId {
+			log.Printf("[INFO] Not waiting for the assignment of metastore %s to workspace %d, "+
+				"because the provider is configured for workspace %d", metastoreId, workspaceId, currentWorkspaceId)
+			return nil
+		}
+	}
+	return common.RetryContext(ctx, timeout, func() *retry.RetryError {
+		current, err := currentAssignedMetastoreId(ctx, c, workspaceId)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if current == metastoreId {
+			return nil
+		}
+		return retry.RetryableError(fmt.Errorf("metastore %s is not yet assigned to workspace %d", metastoreId, workspaceId))
+	})
+}
+
 func ResourceMetastoreAssignment() common.Resource {
 	s := common.StructToSchema(catalog.MetastoreAssignment{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -24,6 +93,10 @@ func ResourceMetastoreAssignment() common.Resource {
 		})
 	return common.Resource{
 		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(metastoreAssignmentPropagationTimeout),
+			Update: schema.DefaultTimeout(metastoreAssignmentPropagationTimeout),
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			workspaceId := int64(d.Get("workspace_id").(int))
 			metastoreId := d.Get("metastore_id").(string)
@@ -31,26 +104,21 @@ func ResourceMetastoreAssignment() common.Resource {
 			common.DataToStructPointer(d, s, &create)
 			create.WorkspaceId = workspaceId
 
-			return c.AccountOrWorkspaceRequest(func(acc *databricks.AccountClient) error {
-				err := acc.MetastoreAssignments.Create(ctx,
+			err := c.AccountOrWorkspaceRequest(func(acc *databricks.AccountClient) error {
+				return acc.MetastoreAssignments.Create(ctx,
 					catalog.AccountsCreateMetastoreAssignment{
 						WorkspaceId:         workspaceId,
 						MetastoreId:         metastoreId,
 						MetastoreAssignment: &create,
 					})
-				if err != nil {
-					return err
-				}
-				pi.Pack(d)
-				return nil
 			}, func(w *databricks.WorkspaceClient) error {
-				err := w.Metastores.Assign(ctx, create)
-				if err != nil {
-					return err
-				}
-				pi.Pack(d)
-				return nil
+				return w.Metastores.Assign(ctx, create)
 			})
+			if err != nil {
+				return err
+			}
+			pi.Pack(d)
+			return waitForMetastoreAssignment(ctx, c, d.Timeout(schema.TimeoutCreate), workspaceId, metastoreId)
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			first, _, err := pi.Unpack(d)
@@ -86,7 +154,7 @@ func ResourceMetastoreAssignment() common.Resource {
 			common.DataToStructPointer(d, s, &update)
 			update.WorkspaceId = workspaceId
 
-			return c.AccountOrWorkspaceRequest(func(acc *databricks.AccountClient) error {
+			err := c.AccountOrWorkspaceRequest(func(acc *databricks.AccountClient) error {
 				return acc.MetastoreAssignments.Update(ctx,
 					catalog.AccountsUpdateMetastoreAssignment{
 						WorkspaceId:         workspaceId,
@@ -96,6 +164,10 @@ func ResourceMetastoreAssignment() common.Resource {
 			}, func(w *databricks.WorkspaceClient) error {
 				return w.Metastores.UpdateAssignment(ctx, update)
 			})
+			if err != nil {
+				return err
+			}
+			return waitForMetastoreAssignment(ctx, c, d.Timeout(schema.TimeoutUpdate), workspaceId, metastoreId)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			first, metastoreId, err := pi.Unpack(d)
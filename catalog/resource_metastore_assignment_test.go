@@ -3,7 +3,9 @@ package catalog
 import (
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/databricks/terraform-provider-databricks/qa"
 )
 
@@ -26,7 +28,16 @@ func TestMetastoreAssignment_Create(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.1/unity-catalog/current-metastore-assignment",
+				Resource: "/api/2.0/preview/scim/v2/Me",
+				ResponseHeaders: map[string]string{
+					"X-Databricks-Org-Id": "123",
+				},
+				Response: iam.User{},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.1/unity-catalog/current-metastore-assignment",
 				Response: catalog.MetastoreAssignment{
 					MetastoreId:        "a",
 					WorkspaceId:        123,
@@ -43,7 +54,82 @@ func TestMetastoreAssignment_Create(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestMetastoreAssignment_CreateForOtherWorkspace(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.1/unity-catalog/workspaces/124/metastore",
+				ExpectedRequest: catalog.CreateMetastoreAssignment{
+					DefaultCatalogName: "hive_metastore",
+					MetastoreId:        "a",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Me",
+				ResponseHeaders: map[string]string{
+					"X-Databricks-Org-Id": "123",
+				},
+				Response: iam.User{},
+			},
+			{
+				// only read after create, because the assignment of the current workspace can't tell
+				// anything about the assignment of the other workspace
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/current-metastore-assignment",
+				Response: catalog.MetastoreAssignment{
+					MetastoreId: "b",
+					WorkspaceId: 123,
+				},
+			},
+		},
+		Resource: ResourceMetastoreAssignment(),
+		Create:   true,
+		HCL: `
+		workspace_id = 124
+		metastore_id = "a"
+		`,
+	}.ApplyNoError(t)
+}
+
 func TestMetastoreAssignmentAccount_Create(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/accounts/100/workspaces/123/metastores/a",
+				ExpectedRequest: catalog.AccountsCreateMetastoreAssignment{
+					MetastoreAssignment: &catalog.CreateMetastoreAssignment{
+						DefaultCatalogName: "hive_metastore",
+						MetastoreId:        "a",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/accounts/100/workspaces/123/metastore?",
+				Response: catalog.AccountsMetastoreAssignment{
+					MetastoreAssignment: &catalog.MetastoreAssignment{
+						MetastoreId:        "a",
+						WorkspaceId:        123,
+						DefaultCatalogName: "hive_metastore",
+					},
+				},
+			},
+		},
+		Resource:  ResourceMetastoreAssignment(),
+		AccountID: "100",
+		Create:    true,
+		HCL: `
+		workspace_id = 123
+		metastore_id = "a"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestMetastoreAssignmentAccount_CreateWaitsForPropagation(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			{
@@ -59,6 +145,16 @@ func TestMetastoreAssignmentAccount_Create(t *testing.T) {
 			{
 				Method:   "GET",
 				Resource: "/api/2.0/accounts/100/workspaces/123/metastore?",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "NOT_FOUND",
+					Message:   "Workspace 123 has no metastore assignment",
+				},
+				Status: 404,
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/accounts/100/workspaces/123/metastore?",
 				Response: catalog.AccountsMetastoreAssignment{
 					MetastoreAssignment: &catalog.MetastoreAssignment{
 						MetastoreId:        "a",
@@ -75,7 +171,9 @@ func TestMetastoreAssignmentAccount_Create(t *testing.T) {
 		workspace_id = 123
 		metastore_id = "a"
 		`,
-	}.ApplyNoError(t)
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "123|a",
+	})
 }
 
 func TestMetastoreAssignmentAccount_Update(t *testing.T) {
@@ -92,8 +190,9 @@ func TestMetastoreAssignmentAccount_Update(t *testing.T) {
 				},
 			},
 			{
-				Method:   "GET",
-				Resource: "/api/2.0/accounts/100/workspaces/123/metastore?",
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/accounts/100/workspaces/123/metastore?",
 				Response: catalog.AccountsMetastoreAssignment{
 					MetastoreAssignment: &catalog.MetastoreAssignment{
 						MetastoreId:        "b",
@@ -133,8 +232,9 @@ func TestMetastoreAssignmentWorskpace_Update(t *testing.T) {
 				},
 			},
 			{
-				Method:   "GET",
-				Resource: "/api/2.0/accounts/100/workspaces/123/metastore?",
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/accounts/100/workspaces/123/metastore?",
 				Response: catalog.AccountsMetastoreAssignment{
 					MetastoreAssignment: &catalog.MetastoreAssignment{
 						MetastoreId:        "a",
@@ -143,6 +243,17 @@ func TestMetastoreAssignmentWorskpace_Update(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/100/workspaces/124/metastore?",
+				Response: catalog.AccountsMetastoreAssignment{
+					MetastoreAssignment: &catalog.MetastoreAssignment{
+						MetastoreId:        "a",
+						WorkspaceId:        124,
+						DefaultCatalogName: "hive_metastore",
+					},
+				},
+			},
 		},
 		Resource:    ResourceMetastoreAssignment(),
 		AccountID:   "100",
@@ -9,6 +9,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -778,3 +779,48 @@ func TestReadMetastore_Error(t *testing.T) {
 		New:      true,
 	}.ExpectError(t, "resource is not expected to be removed")
 }
+
+func TestCreateAccountMetastore_RegionOfAnotherCloud(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:  ResourceMetastore(),
+		AccountID: "100",
+		Create:    true,
+		HCL: `
+		name = "a"
+		storage_root = "gs://b"
+		region = "us-east-1"
+		`,
+	}.ExpectError(t, "region us-east-1 doesn't look like a region of GCP, which storage_root gs://b belongs to. "+
+		"Use a GCP region, like us-east1")
+}
+
+func TestCreateAccountMetastore_Regions(t *testing.T) {
+	for storageRoot, region := range map[string]string{
+		"s3://b/prefix":                     "us-gov-west-1",
+		"abfss://c@a.dfs.core.windows.net/": "eastus2",
+		"gs://b":                            "europe-west3",
+		"r2://b":                            "anything",
+	} {
+		assert.NoError(t, validateMetastoreRegion(storageRoot, region), storageRoot)
+	}
+	assert.Error(t, validateMetastoreRegion("abfss://c@a.dfs.core.windows.net/", "us-east-1"))
+	assert.Error(t, validateMetastoreRegion("s3://b", "eastus"))
+}
+
+func TestUpdateMetastore_RegionChange(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMetastore(),
+		ID:       "abc",
+		Update:   true,
+		InstanceState: map[string]string{
+			"name":         "abc",
+			"storage_root": "s3://b",
+			"region":       "us-east-1",
+		},
+		HCL: `
+		name = "abc"
+		storage_root = "s3://b"
+		region = "us-west-2"
+		`,
+	}.ExpectError(t, "region of metastore can't be changed from us-east-1 to us-west-2")
+}
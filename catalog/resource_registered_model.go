@@ -68,5 +68,6 @@ func ResourceRegisteredModel() common.Resource {
 		StateUpgraders: []schema.StateUpgrader{},
 		Schema:         s,
 		SchemaVersion:  0,
+		WorkspaceLevel: true,
 	}
 }
@@ -34,12 +34,15 @@ func ResourceSchema() common.Resource {
 		})
 	return common.Resource{
 		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(metastoreAssignmentPropagationTimeout),
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
-			err = validateMetastoreId(ctx, w, d.Get("metastore_id").(string))
+			err = validateMetastoreIdOnCreate(ctx, w, d.Get("metastore_id").(string), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
@@ -183,5 +186,6 @@ func ResourceSchema() common.Resource {
 			}
 			return w.Schemas.DeleteByFullName(ctx, name)
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -267,5 +267,6 @@ func ResourceShare() common.Resource {
 			}
 			return w.Shares.DeleteByName(ctx, d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
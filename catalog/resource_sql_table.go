@@ -32,7 +32,7 @@ type SqlTableInfo struct {
 	SchemaName            string            `json:"schema_name" tf:"force_new"`
 	TableType             string            `json:"table_type" tf:"force_new"`
 	DataSourceFormat      string            `json:"data_source_format,omitempty" tf:"force_new"`
-	ColumnInfos           []SqlColumnInfo   `json:"columns,omitempty" tf:"alias:column,computed,force_new"`
+	ColumnInfos           []SqlColumnInfo   `json:"columns,omitempty" tf:"alias:column,computed"`
 	Partitions            []string          `json:"partitions,omitempty" tf:"force_new"`
 	ClusterKeys           []string          `json:"cluster_keys,omitempty" tf:"force_new"`
 	StorageLocation       string            `json:"storage_location,omitempty" tf:"suppress_diff"`
@@ -293,6 +293,86 @@ func (ti *SqlTableInfo) buildTableCreateStatement() string {
 	return strings.Join(statements, "")
 }
 
+// sqlColumnInfosFromList converts the list of `column` blocks from the diff into column infos
+func sqlColumnInfosFromList(v any) []SqlColumnInfo {
+	l, _ := v.([]any)
+	cols := make([]SqlColumnInfo, 0, len(l))
+	for _, item := range l {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		col := SqlColumnInfo{Nullable: true}
+		col.Name, _ = m["name"].(string)
+		col.Type, _ = m["type"].(string)
+		col.Comment, _ = m["comment"].(string)
+		if nullable, ok := m["nullable"].(bool); ok {
+			col.Nullable = nullable
+		}
+		cols = append(cols, col)
+	}
+	return cols
+}
+
+// columnsChangeableInPlace returns true if the change of columns can be applied with ALTER TABLE
+// without recreating the table: existing columns may only change their comment or nullability,
+// and new nullable columns may only be appended after the existing ones.
+func columnsChangeableInPlace(oldCols, newCols []SqlColumnInfo) bool {
+	if len(newCols) < len(oldCols) {
+		return false
+	}
+	for i, col := range newCols {
+		if i >= len(oldCols) {
+			if !col.Nullable {
+				return false
+			}
+			continue
+		}
+		old := oldCols[i]
+		if !strings.EqualFold(col.Name, old.Name) {
+			return false
+		}
+		if col.Type != "" && !strings.EqualFold(col.Type, old.Type) {
+			return false
+		}
+	}
+	return true
+}
+
+func (ti *SqlTableInfo) diffColumns(oldti *SqlTableInfo) ([]string, error) {
+	if len(ti.ColumnInfos) == 0 || reflect.DeepEqual(ti.ColumnInfos, oldti.ColumnInfos) {
+		return nil, nil
+	}
+	if !columnsChangeableInPlace(oldti.ColumnInfos, ti.ColumnInfos) {
+		return nil, fmt.Errorf("columns of %s cannot be changed in place", ti.FullName())
+	}
+	statements := make([]string, 0)
+	for i, old := range oldti.ColumnInfos {
+		col := ti.ColumnInfos[i]
+		if col.Comment != old.Comment {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s COMMENT '%s'",
+				ti.SQLFullName(), col.Name, parseComment(col.Comment)))
+		}
+		if col.Nullable != old.Nullable {
+			action := "SET NOT NULL"
+			if col.Nullable {
+				action = "DROP NOT NULL"
+			}
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s",
+				ti.SQLFullName(), col.Name, action))
+		}
+	}
+	if len(ti.ColumnInfos) > len(oldti.ColumnInfos) {
+		added := make([]string, 0, len(ti.ColumnInfos)-len(oldti.ColumnInfos))
+		for _, col := range ti.ColumnInfos[len(oldti.ColumnInfos):] {
+			added = append(added, ti.serializeColumnInfo(col))
+		}
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD COLUMNS (%s)",
+			ti.SQLFullName(), strings.Join(added, ", "))) // ALTER TABLE x ADD COLUMNS (age INT, city STRING)
+	}
+	return statements, nil
+}
+
 func (ti *SqlTableInfo) diff(oldti *SqlTableInfo) ([]string, error) {
 	statements := make([]string, 0)
 	typestring := ti.getTableTypeString()
@@ -310,6 +390,11 @@ func (ti *SqlTableInfo) diff(oldti *SqlTableInfo) ([]string, error) {
 		if !reflect.DeepEqual(ti.ClusterKeys, oldti.ClusterKeys) {
 			statements = append(statements, fmt.Sprintf("ALTER TABLE %s CLUSTER BY (%s)", ti.SQLFullName(), strings.Join(ti.ClusterKeys, ", ")))
 		}
+		columnStatements, err := ti.diffColumns(oldti)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, columnStatements...)
 	}
 
 	// Attributes common to both views and tables
@@ -431,6 +516,26 @@ func ResourceSqlTable() common.Resource {
 			if d.HasChange("comment") && d.Get("table_type") == "VIEW" {
 				d.ForceNew("comment")
 			}
+			// Columns of views are changed only by recreating them. For tables, comments and nullability
+			// of existing columns could be changed and new columns could be appended, everything else
+			// (renaming, reordering, removing or changing the type of columns) requires recreation.
+			if d.HasChange("column") {
+				old, new := d.GetChange("column")
+				oldCols, newCols := sqlColumnInfosFromList(old), sqlColumnInfosFromList(new)
+				if d.Get("table_type") == "VIEW" || !columnsChangeableInPlace(oldCols, newCols) {
+					// ForceNew has to be marked on the changed nested fields, not on the list itself
+					for i := 0; i < len(oldCols) || i < len(newCols); i++ {
+						for _, field := range []string{"name", "type", "comment", "nullable"} {
+							key := fmt.Sprintf("column.%d.%s", i, field)
+							if d.HasChange(key) {
+								if err := d.ForceNew(key); err != nil {
+									return err
+								}
+							}
+						}
+					}
+				}
+			}
 			return nil
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -472,5 +577,6 @@ func ResourceSqlTable() common.Resource {
 			}
 			return ti.deleteTable()
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -333,6 +333,190 @@ func TestResourceSqlTableUpdateTable(t *testing.T) {
 	assert.Equal(t, "bar", d.Get("name"))
 }
 
+func TestResourceSqlTableUpdateTable_Columns(t *testing.T) {
+	var statements []string
+	_, err := qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			statements = append(statements, commandStr)
+			return common.CommandResults{
+				ResultType: "",
+				Data:       nil,
+			}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		cluster_id         = "existingcluster"
+		column {
+			name      = "one"
+			type      = "string"
+			comment   = "new comment"
+		}
+		column {
+			name      = "two"
+			type      = "string"
+			nullable  = false
+		}
+		column {
+			name      = "three"
+			type      = "int"
+			comment   = "added"
+		}
+		`,
+		InstanceState: map[string]string{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "MANAGED",
+			"data_source_format": "DELTA",
+			"cluster_id":         "existingcluster",
+			"column.#":           "2",
+			"column.0.name":      "one",
+			"column.0.type":      "string",
+			"column.0.comment":   "old comment",
+			"column.0.nullable":  "false",
+			"column.1.name":      "two",
+			"column.1.type":      "string",
+			"column.1.nullable":  "true",
+		},
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:             "bar",
+					CatalogName:      "main",
+					SchemaName:       "foo",
+					TableType:        "MANAGED",
+					DataSourceFormat: "DELTA",
+					ColumnInfos: []SqlColumnInfo{
+						{
+							Name:    "one",
+							Type:    "string",
+							Comment: "old comment",
+						},
+						{
+							Name:     "two",
+							Type:     "string",
+							Nullable: true,
+						},
+					},
+				},
+			},
+		}, baseClusterFixture...),
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Update:   true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN one COMMENT 'new comment'",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN one DROP NOT NULL",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN two SET NOT NULL",
+		"ALTER TABLE `main`.`foo`.`bar` ADD COLUMNS (three int COMMENT 'added')",
+	}, statements)
+}
+
+func TestResourceSqlTableUpdateTable_ColumnTypeChangeRequiresNew(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		cluster_id         = "existingcluster"
+		column {
+			name      = "one"
+			type      = "bigint"
+		}
+		`,
+		InstanceState: map[string]string{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "MANAGED",
+			"data_source_format": "DELTA",
+			"cluster_id":         "existingcluster",
+			"column.#":           "1",
+			"column.0.name":      "one",
+			"column.0.type":      "int",
+			"column.0.nullable":  "true",
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Update:   true,
+	}.ExpectError(t, "changes require new: column.0.type")
+}
+
+func TestResourceSqlTableUpdateTable_ColumnRemovalRequiresNew(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		cluster_id         = "existingcluster"
+		column {
+			name      = "one"
+			type      = "int"
+		}
+		`,
+		InstanceState: map[string]string{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "MANAGED",
+			"data_source_format": "DELTA",
+			"cluster_id":         "existingcluster",
+			"column.#":           "2",
+			"column.0.name":      "one",
+			"column.0.type":      "int",
+			"column.0.nullable":  "true",
+			"column.1.name":      "two",
+			"column.1.type":      "int",
+			"column.1.nullable":  "true",
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Update:   true,
+	}.ExpectError(t, "changes require new: column.1.name")
+}
+
+func TestSqlTableColumnsChangeableInPlace(t *testing.T) {
+	cols := []SqlColumnInfo{
+		{Name: "one", Type: "int", Nullable: true},
+		{Name: "two", Type: "string", Nullable: true},
+	}
+	assert.True(t, columnsChangeableInPlace(cols, []SqlColumnInfo{
+		{Name: "one", Type: "INT", Comment: "changed"},
+		{Name: "two", Nullable: true},
+		{Name: "three", Type: "string", Nullable: true},
+	}))
+	// removed column
+	assert.False(t, columnsChangeableInPlace(cols, cols[:1]))
+	// renamed column
+	assert.False(t, columnsChangeableInPlace(cols, []SqlColumnInfo{
+		{Name: "one", Type: "int", Nullable: true},
+		{Name: "second", Type: "string", Nullable: true},
+	}))
+	// reordered columns
+	assert.False(t, columnsChangeableInPlace(cols, []SqlColumnInfo{cols[1], cols[0]}))
+	// changed type
+	assert.False(t, columnsChangeableInPlace(cols, []SqlColumnInfo{
+		{Name: "one", Type: "bigint", Nullable: true},
+		{Name: "two", Type: "string", Nullable: true},
+	}))
+	// added column is not nullable
+	assert.False(t, columnsChangeableInPlace(cols, append(cols,
+		SqlColumnInfo{Name: "three", Type: "string"})))
+}
+
 func TestResourceSqlTableUpdateView(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		CommandMock: func(commandStr string) common.CommandResults {
@@ -374,6 +558,7 @@ func TestResourceSqlTableUpdateView(t *testing.T) {
 			"column.0.nullable": "false",
 			"column.1.name":     "two",
 			"column.1.type":     "string",
+			"column.1.nullable": "true",
 		},
 		Fixtures: append([]qa.HTTPFixture{
 			{
@@ -399,8 +584,9 @@ func TestResourceSqlTableUpdateView(t *testing.T) {
 							Nullable: false,
 						},
 						{
-							Name: "two",
-							Type: "string",
+							Name:     "two",
+							Type:     "string",
+							Nullable: true,
 						},
 					},
 				},
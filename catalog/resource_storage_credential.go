@@ -41,6 +41,9 @@ var storageCredentialSchema = common.StructToSchema(StorageCredentialInfo{},
 func ResourceStorageCredential() common.Resource {
 	return common.Resource{
 		Schema: storageCredentialSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(credentialValidationTimeout),
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			metastoreId := d.Get("metastore_id").(string)
 			tmpSchema := removeGcpSaField(storageCredentialSchema)
@@ -56,12 +59,17 @@ func ResourceStorageCredential() common.Resource {
 				create.DatabricksGcpServiceAccount = &catalog.DatabricksGcpServiceAccountRequest{}
 			}
 
+			timeout := d.Timeout(schema.TimeoutCreate)
 			return c.AccountOrWorkspaceRequest(func(acc *databricks.AccountClient) error {
-				storageCredential, err := acc.StorageCredentials.Create(ctx,
-					catalog.AccountsCreateStorageCredential{
-						MetastoreId:    metastoreId,
-						CredentialInfo: &create,
-					})
+				var storageCredential *catalog.AccountsStorageCredentialInfo
+				err := retryCredentialValidation(ctx, timeout, func() (err error) {
+					storageCredential, err = acc.StorageCredentials.Create(ctx,
+						catalog.AccountsCreateStorageCredential{
+							MetastoreId:    metastoreId,
+							CredentialInfo: &create,
+						})
+					return err
+				})
 				if err != nil {
 					return err
 				}
@@ -82,11 +90,15 @@ func ResourceStorageCredential() common.Resource {
 				}
 				return nil
 			}, func(w *databricks.WorkspaceClient) error {
-				err := validateMetastoreId(ctx, w, d.Get("metastore_id").(string))
+				err := validateMetastoreIdOnCreate(ctx, w, d.Get("metastore_id").(string), d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					return err
 				}
-				storageCredential, err := w.StorageCredentials.Create(ctx, create)
+				var storageCredential *catalog.StorageCredentialInfo
+				err = retryCredentialValidation(ctx, timeout, func() (err error) {
+					storageCredential, err = w.StorageCredentials.Create(ctx, create)
+					return err
+				})
 				if err != nil {
 					return err
 				}
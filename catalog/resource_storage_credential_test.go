@@ -58,6 +58,80 @@ func TestCreateStorageCredentials(t *testing.T) {
 	})
 }
 
+func TestCreateStorageCredentialsRetriesValidation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/storage-credentials",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message: "Failed to get credentials: AWS IAM role in the storage credential " +
+						"is not configured correctly.",
+				},
+				Status: 400,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/storage-credentials",
+				ExpectedRequest: catalog.CreateStorageCredential{
+					Name: "a",
+					AwsIamRole: &catalog.AwsIamRole{
+						RoleArn: "def",
+					},
+				},
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a?",
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+					AwsIamRole: &catalog.AwsIamRole{
+						RoleArn: "def",
+					},
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "def"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "a",
+	})
+}
+
+func TestCreateStorageCredentialsDoesNotRetryOtherErrors(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/storage-credentials",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "RESOURCE_ALREADY_EXISTS",
+					Message:   "Storage Credential 'a' already exists",
+				},
+				Status: 400,
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "def"
+		}
+		`,
+	}.ExpectError(t, "Storage Credential 'a' already exists")
+}
+
 func TestCreateStorageCredentialWithOwner(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
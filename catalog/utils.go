@@ -2,11 +2,64 @@ package catalog
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
+// Cloud IAM changes become visible with a delay, so a storage credential or an external location, that is created
+// in the same apply as its IAM role, may fail the validation at first. It's retried for this long by default.
+const credentialValidationTimeout = 5 * time.Minute
+
+// credentialNotPropagatedMessages are parts of validation errors, that are expected while IAM roles, service
+// principals or their permissions are still propagating in the cloud
+var credentialNotPropagatedMessages = []string{
+	"sts:assumerole",
+	"self-assuming",
+	"is not configured correctly",
+	"is not authorized to perform:",
+	"aadsts700016",
+	"authorizationpermissionmismatch",
+}
+
+func isCredentialNotPropagated(err error) bool {
+	var apiErr *apierr.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	message := strings.ToLower(apiErr.Message)
+	for _, v := range credentialNotPropagatedMessages {
+		if strings.Contains(message, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryCredentialValidation calls create until Unity Catalog accepts the cloud credential, so that it doesn't
+// require an artificial delay after the creation of the IAM role. Other errors are returned right away.
+func retryCredentialValidation(ctx context.Context, timeout time.Duration, create func() error) error {
+	return common.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := create()
+		if isCredentialNotPropagated(err) {
+			log.Printf("[INFO] Credential isn't valid yet, retrying: %s", err)
+			return retry.RetryableError(err)
+		}
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
 // UC catalog resources accept an optional metastore_id parameter. This is required for account-level operations, but it is not used
 // for workspace-level. However, to avoid confusion for customers when they specify an id at workspace-level, we validate that the
 // id matches the metastore assigned to the workspace.
@@ -20,6 +73,35 @@ func validateMetastoreId(ctx context.Context, w *databricks.WorkspaceClient, met
 	if err != nil {
 		return err
 	}
+	return checkAssignedMetastoreId(cat, metastoreId)
+}
+
+// validateMetastoreIdOnCreate is validateMetastoreId for resources, that are being created. They may be created in the same
+// apply as the metastore assignment, so when there's no metastore assigned to the workspace yet, the assignment is awaited
+// for up to the given timeout instead of failing right away.
+func validateMetastoreIdOnCreate(ctx context.Context, w *databricks.WorkspaceClient, metastoreId string, timeout time.Duration) error {
+	if metastoreId == "" {
+		return nil
+	}
+	var cat *catalog.MetastoreAssignment
+	err := common.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		cat, err = w.Metastores.Current(ctx)
+		if apierr.IsMissing(err) {
+			return retry.RetryableError(fmt.Errorf("waiting for metastore %s to be assigned: %w", metastoreId, err))
+		}
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return checkAssignedMetastoreId(cat, metastoreId)
+}
+
+func checkAssignedMetastoreId(cat *catalog.MetastoreAssignment, metastoreId string) error {
 	if cat.MetastoreId != metastoreId {
 		return fmt.Errorf("metastore_id must be empty or equal to the metastore id assigned to the workspace: %s. "+
 			"If the metastore assigned to the workspace has changed, the new metastore id must be explicitly set", cat.MetastoreId)
@@ -212,10 +212,11 @@ type WorkspaceFileInfo struct {
 	Destination string `json:"destination"`
 }
 
-// StorageInfo contains the struct for either DBFS or S3 storage depending on which one is relevant.
+// StorageInfo contains the struct for either DBFS, S3 or Unity Catalog volume storage depending on which one is relevant.
 type StorageInfo struct {
-	Dbfs *DbfsStorageInfo `json:"dbfs,omitempty" tf:"group:storage"`
-	S3   *S3StorageInfo   `json:"s3,omitempty" tf:"group:storage"`
+	Dbfs    *DbfsStorageInfo            `json:"dbfs,omitempty" tf:"group:storage"`
+	S3      *S3StorageInfo              `json:"s3,omitempty" tf:"group:storage"`
+	Volumes *compute.VolumesStorageInfo `json:"volumes,omitempty" tf:"group:storage"`
 }
 
 // InitScriptStorageInfo captures the allowed sources of init scripts.
@@ -538,6 +539,7 @@ type ClusterInfo struct {
 	TerminationReason         *TerminationReason      `json:"termination_reason,omitempty"`
 	DataSecurityMode          string                  `json:"data_security_mode,omitempty"`
 	RuntimeEngine             string                  `json:"runtime_engine,omitempty"`
+	WorkloadType              *WorkloadType           `json:"workload_type,omitempty"`
 }
 
 // IsRunningOrResizing returns true if cluster is running or resizing
@@ -588,6 +590,17 @@ func (a ClustersAPI) Create(cluster Cluster) (info ClusterInfo, err error) {
 	return
 }
 
+// CreateNoWait creates a new Spark cluster and returns right after the cluster is requested,
+// without waiting for it to start
+func (a ClustersAPI) CreateNoWait(cluster Cluster) (info ClusterInfo, err error) {
+	var ci ClusterID
+	err = a.client.Post(a.context, "/clusters/create", cluster, &ci)
+	if err != nil {
+		return
+	}
+	return a.Get(ci.ClusterID)
+}
+
 // Resize api can only be used when the cluster is in Running State
 func (a ClustersAPI) Resize(resizeRequest ResizeRequest) (info ClusterInfo, err error) {
 	info, err = a.Get(resizeRequest.ClusterID)
@@ -647,9 +660,10 @@ func (a ClustersAPI) Edit(cluster Cluster) (info ClusterInfo, err error) {
 
 // ListZones returns the zones info sent by the cloud service provider
 func (a ClustersAPI) ListZones() (ZonesInfo, error) {
-	var zonesInfo ZonesInfo
-	err := a.client.Get(a.context, "/clusters/list-zones", nil, &zonesInfo)
-	return zonesInfo, err
+	return common.CachedLookup(a.client, "zones", func() (zonesInfo ZonesInfo, err error) {
+		err = a.client.Get(a.context, "/clusters/list-zones", nil, &zonesInfo)
+		return
+	})
 }
 
 // Start a terminated Spark cluster given its ID and wait till it's running
@@ -725,7 +739,7 @@ func wrapMissingClusterError(err error, id string) error {
 func (a ClustersAPI) waitForClusterStatus(clusterID string, desired ClusterState) (result ClusterInfo, err error) {
 	// this tangles client with terraform more, which is inevitable
 	// nolint should be a bigger context-aware refactor
-	return result, resource.RetryContext(a.context, a.defaultTimeout(), func() *resource.RetryError {
+	return result, common.RetryContext(a.context, a.defaultTimeout(), func() *resource.RetryError {
 		clusterInfo, err := a.Get(clusterID)
 		if apierr.IsMissing(err) {
 			log.Printf("[INFO] Cluster %s not found. Retrying", clusterID)
@@ -797,9 +811,31 @@ func (a ClustersAPI) Get(clusterID string) (ci ClusterInfo, err error) {
 	return
 }
 
+// maxPinnedClusters is the number of clusters that could be pinned in a workspace
+const maxPinnedClusters = 100
+
+// isPinnedClustersLimitError returns true if the cluster can't be pinned, because too many clusters are pinned already
+func isPinnedClustersLimitError(err error) bool {
+	var apiErr *apierr.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.ErrorCode == "QUOTA_EXCEEDED" {
+		return true
+	}
+	message := strings.ToLower(apiErr.Message)
+	return strings.Contains(message, "pin") && (strings.Contains(message, "limit") ||
+		strings.Contains(message, "maximum") || strings.Contains(message, "more than"))
+}
+
 // Pin ensure that an interactive cluster configuration is retained even after a cluster has been terminated for more than 30 days
 func (a ClustersAPI) Pin(clusterID string) error {
-	return a.client.Post(a.context, "/clusters/pin", ClusterID{ClusterID: clusterID}, nil)
+	err := a.client.Post(a.context, "/clusters/pin", ClusterID{ClusterID: clusterID}, nil)
+	if isPinnedClustersLimitError(err) {
+		return fmt.Errorf("cannot pin cluster %s, because at most %d clusters can be pinned in a workspace. "+
+			"Unpin clusters that are no longer needed or set is_pinned = false: %w", clusterID, maxPinnedClusters, err)
+	}
+	return err
 }
 
 // Unpin allows the cluster to eventually be removed from the list returned by the List API
@@ -3,6 +3,7 @@ package clusters
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 )
@@ -14,26 +15,35 @@ func DataSourceCluster() common.Resource {
 		Name        string       `json:"cluster_name,omitempty" tf:"computed"`
 		ClusterInfo *ClusterInfo `json:"cluster_info,omitempty" tf:"computed"`
 	}
-	return common.DataResource(clusterData{}, func(ctx context.Context, e interface{}, c *common.DatabricksClient) error {
+	r := common.DataResource(clusterData{}, func(ctx context.Context, e interface{}, c *common.DatabricksClient) error {
 		data := e.(*clusterData)
 		clusterAPI := NewClustersAPI(ctx, c)
 		if data.Name != "" {
+			// clusters are created with the name prefix and suffix of the provider
+			name := c.AffixName(data.Name)
 			clusters, err := clusterAPI.List()
 			if err != nil {
 				return err
 			}
 			namedClusters := []ClusterInfo{}
+			ids := []string{}
 			for _, clst := range clusters {
 				cluster := clst
-				if cluster.ClusterName == data.Name {
+				// clusters of job runs are short-lived and can't be shared, so they are never looked up by name
+				if cluster.ClusterSource == "JOB" {
+					continue
+				}
+				if cluster.ClusterName == name {
 					namedClusters = append(namedClusters, cluster)
+					ids = append(ids, cluster.ClusterID)
 				}
 			}
 			if len(namedClusters) == 0 {
-				return fmt.Errorf("there is no cluster with name '%s'", data.Name)
+				return fmt.Errorf("there is no cluster with name '%s'", name)
 			}
 			if len(namedClusters) > 1 {
-				return fmt.Errorf("there is more than one cluster with name '%s'", data.Name)
+				return fmt.Errorf("there is more than one cluster with name '%s': %s. Please specify `cluster_id` instead",
+					name, strings.Join(ids, ", "))
 			}
 			data.ClusterInfo = &namedClusters[0]
 		} else if data.ClusterId != "" {
@@ -50,4 +60,6 @@ func DataSourceCluster() common.Resource {
 
 		return nil
 	})
+	r.WorkspaceLevel = true
+	return r
 }
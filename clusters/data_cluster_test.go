@@ -88,6 +88,39 @@ func TestClusterDataByName(t *testing.T) {
 	}
 }
 
+func TestClusterDataByName_Affixed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: ClusterList{
+					Clusters: []ClusterInfo{
+						{
+							ClusterID:   "abc",
+							ClusterName: "Shared Autoscaling",
+						},
+						{
+							ClusterID:   "def",
+							ClusterName: "dev-Shared Autoscaling",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceCluster(),
+		HCL:         `cluster_name = "Shared Autoscaling"`,
+		Read:        true,
+		NonWritable: true,
+		NamePrefix:  "dev-",
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"cluster_id":                  "def",
+		"cluster_name":                "Shared Autoscaling",
+		"cluster_info.0.cluster_name": "dev-Shared Autoscaling",
+	})
+}
+
 func TestClusterDataByName_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -144,7 +177,45 @@ func TestClusterDataByName_DuplicateNames(t *testing.T) {
 		Read:        true,
 		NonWritable: true,
 		ID:          "_",
-	}.ExpectError(t, "there is more than one cluster with name 'Shared Autoscaling'")
+	}.ExpectError(t, "there is more than one cluster with name 'Shared Autoscaling': abc, def. Please specify `cluster_id` instead")
+}
+
+func TestClusterDataByName_SkipsJobClusters(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: ClusterList{
+					Clusters: []ClusterInfo{
+						{
+							ClusterID:     "abc",
+							ClusterName:   "Shared Autoscaling",
+							SparkVersion:  "7.1-scala12",
+							ClusterSource: "UI",
+							State:         ClusterStateRunning,
+						},
+						{
+							ClusterID:     "def",
+							ClusterName:   "Shared Autoscaling",
+							SparkVersion:  "7.1-scala12",
+							ClusterSource: "JOB",
+							State:         ClusterStateTerminated,
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceCluster(),
+		HCL:         `cluster_name = "Shared Autoscaling"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                           "abc",
+		"cluster_info.0.spark_version": "7.1-scala12",
+		"cluster_info.0.state":         "RUNNING",
+	})
 }
 
 func TestClusterDataByName_ListError(t *testing.T) {
@@ -8,6 +8,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// clusterTagsMatch checks that every filter tag is among custom or default tags of the cluster
+func clusterTagsMatch(v ClusterInfo, tags map[string]any) bool {
+	for key, value := range tags {
+		if custom, ok := v.CustomTags[key]; ok && custom == value {
+			continue
+		}
+		if dflt, ok := v.DefaultTags[key]; ok && dflt == value {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
 func DataSourceClusters() common.Resource {
 	return common.Resource{
 		Read: func(ctx context.Context, d *schema.ResourceData, i *common.DatabricksClient) error {
@@ -16,15 +30,38 @@ func DataSourceClusters() common.Resource {
 				return err
 			}
 			ids := schema.NewSet(schema.HashString, []any{})
+			details := []any{}
 			name_contains := strings.ToLower(d.Get("cluster_name_contains").(string))
+			source := d.Get("cluster_source").(string)
+			state := d.Get("cluster_state").(string)
+			tags := d.Get("cluster_tags").(map[string]any)
 			for _, v := range clusters {
 				match_name := strings.Contains(strings.ToLower(v.ClusterName), name_contains)
 				if name_contains != "" && !match_name {
 					continue
 				}
+				if source != "" && !strings.EqualFold(source, string(v.ClusterSource)) {
+					continue
+				}
+				if state != "" && !strings.EqualFold(state, string(v.State)) {
+					continue
+				}
+				if !clusterTagsMatch(v, tags) {
+					continue
+				}
 				ids.Add(v.ClusterID)
+				details = append(details, map[string]any{
+					"cluster_id":        v.ClusterID,
+					"cluster_name":      v.ClusterName,
+					"cluster_source":    string(v.ClusterSource),
+					"state":             string(v.State),
+					"creator_user_name": v.CreatorUserName,
+					"spark_version":     v.SparkVersion,
+					"custom_tags":       v.CustomTags,
+				})
 			}
 			d.Set("ids", ids)
+			d.Set("clusters", details)
 			d.SetId("_")
 			return nil
 		},
@@ -40,6 +77,61 @@ func DataSourceClusters() common.Resource {
 				Optional: true,
 				Type:     schema.TypeString,
 			},
+			"cluster_source": {
+				Optional: true,
+				Type:     schema.TypeString,
+			},
+			"cluster_state": {
+				Optional: true,
+				Type:     schema.TypeString,
+			},
+			"cluster_tags": {
+				Optional: true,
+				Type:     schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"clusters": {
+				Computed: true,
+				Type:     schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"cluster_name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"cluster_source": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"state": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"creator_user_name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"spark_version": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"custom_tags": {
+							Computed: true,
+							Type:     schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -84,3 +84,89 @@ func TestClustersDataSourceErrorsOut(t *testing.T) {
 	assert.NotNil(t, diag)
 	assert.True(t, diag.HasError())
 }
+
+func TestClustersDataSourceFilters(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: ClusterList{
+					Clusters: []ClusterInfo{
+						{
+							ClusterID:       "a",
+							ClusterName:     "nightly etl",
+							ClusterSource:   "JOB",
+							State:           ClusterStateRunning,
+							CreatorUserName: "etl@example.com",
+							CustomTags: map[string]string{
+								"team": "data",
+							},
+						},
+						{
+							ClusterID:     "b",
+							ClusterName:   "interactive",
+							ClusterSource: "UI",
+							State:         ClusterStateRunning,
+							CustomTags: map[string]string{
+								"team": "data",
+							},
+						},
+						{
+							ClusterID:     "c",
+							ClusterName:   "another etl",
+							ClusterSource: "JOB",
+							State:         ClusterStateTerminated,
+							CustomTags: map[string]string{
+								"team": "data",
+							},
+						},
+						{
+							ClusterID:     "d",
+							ClusterName:   "ml etl",
+							ClusterSource: "JOB",
+							State:         ClusterStateRunning,
+							DefaultTags: map[string]string{
+								"team": "ml",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceClusters(),
+		NonWritable: true,
+		Read:        true,
+		ID:          "_",
+		HCL: `
+		cluster_source = "job"
+		cluster_state  = "RUNNING"
+		cluster_tags   = {
+			team = "data"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids.#":                        1,
+		"clusters.#":                   1,
+		"clusters.0.cluster_id":        "a",
+		"clusters.0.cluster_name":      "nightly etl",
+		"clusters.0.cluster_source":    "JOB",
+		"clusters.0.state":             "RUNNING",
+		"clusters.0.creator_user_name": "etl@example.com",
+		"clusters.0.custom_tags.team":  "data",
+	})
+}
+
+func TestClusterTagsMatch(t *testing.T) {
+	cluster := ClusterInfo{
+		CustomTags: map[string]string{
+			"team": "data",
+		},
+		DefaultTags: map[string]string{
+			"Vendor": "Databricks",
+		},
+	}
+	assert.True(t, clusterTagsMatch(cluster, map[string]any{}))
+	assert.True(t, clusterTagsMatch(cluster, map[string]any{"team": "data", "Vendor": "Databricks"}))
+	assert.False(t, clusterTagsMatch(cluster, map[string]any{"team": "ml"}))
+	assert.False(t, clusterTagsMatch(cluster, map[string]any{"owner": "me"}))
+}
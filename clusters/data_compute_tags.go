@@ -0,0 +1,101 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type computeTagsData struct {
+	ClusterId     string            `json:"cluster_id,omitempty"`
+	WarehouseId   string            `json:"warehouse_id,omitempty"`
+	RequiredTags  []string          `json:"required_tags,omitempty"`
+	CustomTags    map[string]string `json:"custom_tags,omitempty" tf:"computed"`
+	DefaultTags   map[string]string `json:"default_tags,omitempty" tf:"computed"`
+	PoolTags      map[string]string `json:"pool_tags,omitempty" tf:"computed"`
+	EffectiveTags map[string]string `json:"effective_tags,omitempty" tf:"computed"`
+	MissingTags   []string          `json:"missing_tags,omitempty" tf:"computed"`
+}
+
+// mergeTags returns union of tags, where values from the latter maps take precedence
+func mergeTags(tags ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, m := range tags {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func (data *computeTagsData) readCluster(ctx context.Context, w *databricks.WorkspaceClient) error {
+	cluster, err := w.Clusters.GetByClusterId(ctx, data.ClusterId)
+	if err != nil {
+		return err
+	}
+	data.CustomTags = cluster.CustomTags
+	data.DefaultTags = cluster.DefaultTags
+	// instances of clusters from pools are also tagged with tags of the pool
+	poolIds := []string{cluster.InstancePoolId}
+	if cluster.DriverInstancePoolId != "" && cluster.DriverInstancePoolId != cluster.InstancePoolId {
+		poolIds = append(poolIds, cluster.DriverInstancePoolId)
+	}
+	for _, poolId := range poolIds {
+		if poolId == "" {
+			continue
+		}
+		pool, err := w.InstancePools.GetByInstancePoolId(ctx, poolId)
+		if err != nil {
+			return fmt.Errorf("instance pool %s: %w", poolId, err)
+		}
+		data.PoolTags = mergeTags(data.PoolTags, pool.DefaultTags, pool.CustomTags)
+	}
+	data.EffectiveTags = mergeTags(data.PoolTags, data.CustomTags, data.DefaultTags)
+	return nil
+}
+
+func (data *computeTagsData) readWarehouse(ctx context.Context, w *databricks.WorkspaceClient) error {
+	warehouse, err := w.Warehouses.GetById(ctx, data.WarehouseId)
+	if err != nil {
+		return err
+	}
+	// default tags of warehouse instances are not exposed by the API
+	data.CustomTags = map[string]string{}
+	if warehouse.Tags != nil {
+		for _, tag := range warehouse.Tags.CustomTags {
+			data.CustomTags[tag.Key] = tag.Value
+		}
+	}
+	data.EffectiveTags = mergeTags(data.CustomTags)
+	return nil
+}
+
+func DataSourceComputeTags() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *computeTagsData, w *databricks.WorkspaceClient) error {
+		var err error
+		switch {
+		case data.ClusterId != "" && data.WarehouseId != "":
+			return fmt.Errorf("only one of `cluster_id` or `warehouse_id` should be specified")
+		case data.ClusterId != "":
+			err = data.readCluster(ctx, w)
+		case data.WarehouseId != "":
+			err = data.readWarehouse(ctx, w)
+		default:
+			return fmt.Errorf("you need to specify either `cluster_id` or `warehouse_id`")
+		}
+		if err != nil {
+			return err
+		}
+		data.MissingTags = []string{}
+		for _, key := range data.RequiredTags {
+			if _, ok := data.EffectiveTags[key]; !ok {
+				data.MissingTags = append(data.MissingTags, key)
+			}
+		}
+		sort.Strings(data.MissingTags)
+		return nil
+	})
+}
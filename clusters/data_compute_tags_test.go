@@ -0,0 +1,129 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestComputeTagsDataCluster(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "abc").Return(&compute.ClusterDetails{
+				ClusterId:      "abc",
+				InstancePoolId: "pool",
+				CustomTags: map[string]string{
+					"CostCenter": "1234",
+					"Team":       "data",
+				},
+				DefaultTags: map[string]string{
+					"Vendor":    "Databricks",
+					"ClusterId": "abc",
+				},
+			}, nil)
+			w.GetMockInstancePoolsAPI().EXPECT().GetByInstancePoolId(mock.Anything, "pool").Return(&compute.GetInstancePool{
+				InstancePoolId: "pool",
+				CustomTags: map[string]string{
+					"Team": "platform",
+					"Env":  "prod",
+				},
+				DefaultTags: map[string]string{
+					"Vendor":                   "Databricks",
+					"DatabricksInstancePoolId": "pool",
+				},
+			}, nil)
+		},
+		Resource:    DataSourceComputeTags(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		cluster_id    = "abc"
+		required_tags = ["CostCenter", "Owner", "Env", "Budget"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"custom_tags.%":                           "2",
+		"default_tags.%":                          "2",
+		"pool_tags.%":                             "4",
+		"pool_tags.Team":                          "platform",
+		"effective_tags.%":                        "6",
+		"effective_tags.Team":                     "data",
+		"effective_tags.Env":                      "prod",
+		"effective_tags.ClusterId":                "abc",
+		"effective_tags.DatabricksInstancePoolId": "pool",
+		"missing_tags":                            []any{"Budget", "Owner"},
+	})
+}
+
+func TestComputeTagsDataWarehouse(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockWarehousesAPI().EXPECT().GetById(mock.Anything, "def").Return(&sql.GetWarehouseResponse{
+				Id: "def",
+				Tags: &sql.EndpointTags{
+					CustomTags: []sql.EndpointTagPair{
+						{Key: "CostCenter", Value: "1234"},
+					},
+				},
+			}, nil)
+		},
+		Resource:    DataSourceComputeTags(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		warehouse_id  = "def"
+		required_tags = ["CostCenter"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"custom_tags.CostCenter":    "1234",
+		"effective_tags.%":          "1",
+		"effective_tags.CostCenter": "1234",
+		"missing_tags":              []any{},
+	})
+}
+
+func TestComputeTagsDataNothingSpecified(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceComputeTags(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         ``,
+	}.ExpectError(t, "you need to specify either `cluster_id` or `warehouse_id`")
+}
+
+func TestComputeTagsDataBothSpecified(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceComputeTags(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		cluster_id   = "abc"
+		warehouse_id = "def"
+		`,
+	}.ExpectError(t, "only one of `cluster_id` or `warehouse_id` should be specified")
+}
+
+func TestComputeTagsDataClusterError(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "abc").Return(nil, &apierr.APIError{
+				ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+				StatusCode: 404,
+				Message:    "Cluster abc does not exist",
+			})
+		},
+		Resource:    DataSourceComputeTags(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `cluster_id = "abc"`,
+	}.ExpectError(t, "Cluster abc does not exist")
+}
@@ -2,7 +2,9 @@ package clusters
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -22,16 +24,70 @@ func defaultSmallestNodeType(w *databricks.WorkspaceClient, request compute.Node
 	return "i3.xlarge"
 }
 
+// NodeTypeRequest extends the selection criteria of the Go SDK with filters on GPU type and local NVMe disks
+type NodeTypeRequest struct {
+	compute.NodeTypeRequest
+	// Case-insensitive part of the node type ID or description, like `A100` or `T4`
+	GpuType       string `json:"gpu_type,omitempty"`
+	LocalNvmeDisk bool   `json:"local_nvme_disk,omitempty"`
+}
+
+func (r NodeTypeRequest) matches(nt compute.NodeType) bool {
+	if r.GpuType != "" {
+		gpuType := strings.ToLower(r.GpuType)
+		if nt.NumGpus == 0 || !(strings.Contains(strings.ToLower(nt.NodeTypeId), gpuType) ||
+			strings.Contains(strings.ToLower(nt.Description), gpuType)) {
+			return false
+		}
+	}
+	if r.LocalNvmeDisk && (nt.NodeInstanceType == nil || nt.NodeInstanceType.LocalNvmeDisks < 1) {
+		return false
+	}
+	return true
+}
+
 func smallestNodeType(ctx context.Context, request compute.NodeTypeRequest, w *databricks.WorkspaceClient) string {
+	// without the filters of the data source, the default node type is returned instead of an error
+	nodeType, _ := smallestMatchingNodeType(ctx, NodeTypeRequest{NodeTypeRequest: request}, w)
+	return nodeType
+}
+
+// hasFilters returns true, if the node type has to match the filters, that the default node types don't match
+func (r NodeTypeRequest) hasFilters() bool {
+	return r.GpuType != "" || r.LocalNvmeDisk
+}
+
+// smallestMatchingNodeType returns the smallest node type, ordered by cores, memory, disks, GPUs and then by name,
+// so that the result is deterministic for the same list of node types. If no node type matches, the default
+// node type of the cloud is returned, unless filters on GPU type or local NVMe disks are specified.
+func smallestMatchingNodeType(ctx context.Context, request NodeTypeRequest, w *databricks.WorkspaceClient) (string, error) {
 	nodeTypes, err := w.Clusters.ListNodeTypes(ctx)
 	if err != nil {
-		return defaultSmallestNodeType(w, request)
+		if request.hasFilters() {
+			return "", err
+		}
+		return defaultSmallestNodeType(w, request.NodeTypeRequest), nil
 	}
-	nodeType, err := nodeTypes.Smallest(request)
+	matching := []compute.NodeType{}
+	for _, nt := range nodeTypes.NodeTypes {
+		if request.matches(nt) {
+			matching = append(matching, nt)
+		}
+	}
+	nodeTypes.NodeTypes = matching
+	if request.GpuType != "" && request.MinGPUs == 0 {
+		// the Go SDK skips GPU node types, unless at least one GPU is requested
+		request.MinGPUs = 1
+	}
+	nodeType, err := nodeTypes.Smallest(request.NodeTypeRequest)
 	if err != nil {
-		nodeType = defaultSmallestNodeType(w, request)
+		if request.hasFilters() {
+			return "", fmt.Errorf("no node type matches gpu_type = %q and local_nvme_disk = %t "+
+				"together with the other criteria", request.GpuType, request.LocalNvmeDisk)
+		}
+		return defaultSmallestNodeType(w, request.NodeTypeRequest), nil
 	}
-	return nodeType
+	return nodeType, nil
 }
 
 func (a ClustersAPI) GetSmallestNodeType(request compute.NodeTypeRequest) string {
@@ -41,8 +97,12 @@ func (a ClustersAPI) GetSmallestNodeType(request compute.NodeTypeRequest) string
 
 // DataSourceNodeType returns smallest node depedning on the cloud
 func DataSourceNodeType() common.Resource {
-	return common.WorkspaceData(func(ctx context.Context, data *compute.NodeTypeRequest, w *databricks.WorkspaceClient) error {
-		data.Id = smallestNodeType(ctx, *data, w)
+	return common.WorkspaceData(func(ctx context.Context, data *NodeTypeRequest, w *databricks.WorkspaceClient) error {
+		nodeType, err := smallestMatchingNodeType(ctx, *data, w)
+		if err != nil {
+			return err
+		}
+		data.Id = nodeType
 		log.Printf("[DEBUG] smallest node: %s", data.Id)
 		return nil
 	})
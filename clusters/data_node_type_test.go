@@ -1,9 +1,11 @@
 package clusters
 
 import (
+	"context"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
 )
@@ -318,6 +320,140 @@ func TestNodeTypeFleet(t *testing.T) {
 	assert.Equal(t, "m-fleet.2xlarge", d.Id())
 }
 
+var gpuNodeTypes = compute.ListNodeTypesResponse{
+	NodeTypes: []compute.NodeType{
+		{
+			NodeTypeId:     "Standard_NC24ads_A100_v4",
+			InstanceTypeId: "Standard_NC24ads_A100_v4",
+			Description:    "Standard_NC24ads_A100_v4",
+			MemoryMb:       225280,
+			NumCores:       24,
+			NumGpus:        1,
+		},
+		{
+			NodeTypeId:     "Standard_NC4as_T4_v3",
+			InstanceTypeId: "Standard_NC4as_T4_v3",
+			Description:    "Standard_NC4as_T4_v3",
+			MemoryMb:       28672,
+			NumCores:       4,
+			NumGpus:        1,
+		},
+		{
+			NodeTypeId:     "Standard_NC8as_T4_v3",
+			InstanceTypeId: "Standard_NC8as_T4_v3",
+			Description:    "Standard_NC8as_T4_v3",
+			MemoryMb:       57344,
+			NumCores:       8,
+			NumGpus:        1,
+			NodeInstanceType: &compute.NodeInstanceType{
+				LocalNvmeDisks:      1,
+				LocalNvmeDiskSizeGb: 360,
+			},
+		},
+		{
+			NodeTypeId:     "Standard_L8s_v3",
+			InstanceTypeId: "Standard_L8s_v3",
+			Description:    "Standard_L8s_v3",
+			MemoryMb:       65536,
+			NumCores:       8,
+			NodeInstanceType: &compute.NodeInstanceType{
+				LocalNvmeDisks:      1,
+				LocalNvmeDiskSizeGb: 1788,
+			},
+		},
+	},
+}
+
+func TestNodeTypeGpuType(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response:     gpuNodeTypes,
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceNodeType(),
+		NonWritable: true,
+		State: map[string]any{
+			"gpu_type": "a100",
+		},
+		ID: ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "Standard_NC24ads_A100_v4", d.Id())
+}
+
+func TestNodeTypeGpuTypeAndLocalNvmeDisk(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response:     gpuNodeTypes,
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceNodeType(),
+		NonWritable: true,
+		State: map[string]any{
+			"gpu_type":        "T4",
+			"local_nvme_disk": true,
+		},
+		ID: ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "Standard_NC8as_T4_v3", d.Id())
+}
+
+func TestNodeTypeLocalNvmeDisk(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response:     gpuNodeTypes,
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceNodeType(),
+		NonWritable: true,
+		State: map[string]any{
+			"local_nvme_disk": true,
+		},
+		ID: ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "Standard_L8s_v3", d.Id())
+}
+
+func TestNodeTypeGpuTypeNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response:     gpuNodeTypes,
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceNodeType(),
+		NonWritable: true,
+		Azure:       true,
+		State: map[string]any{
+			"gpu_type":        "H100",
+			"local_nvme_disk": true,
+		},
+		ID: ".",
+	}.ExpectError(t, "no node type matches gpu_type = \"H100\" and local_nvme_disk = true "+
+		"together with the other criteria")
+}
+
 func TestNodeTypeEmptyList(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -360,3 +496,37 @@ func TestNodeTypeFleetEmptyList(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "md-fleet.xlarge", d.Id())
 }
+
+func TestNodeType_CachedAndFilteredPerRequest(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/list-node-types",
+			Response: compute.ListNodeTypesResponse{
+				NodeTypes: []compute.NodeType{
+					{
+						NodeTypeId: "small",
+						MemoryMb:   1024,
+						NumCores:   4,
+					},
+					{
+						NodeTypeId: "gpu",
+						MemoryMb:   2048,
+						NumCores:   8,
+						NumGpus:    1,
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		w, err := client.WorkspaceClient()
+		assert.NoError(t, err)
+		nodeType, err := smallestMatchingNodeType(ctx, NodeTypeRequest{GpuType: "gpu"}, w)
+		assert.NoError(t, err)
+		assert.Equal(t, "gpu", nodeType)
+		// the cached list isn't changed by the filter of the previous request
+		nodeType, err = smallestMatchingNodeType(ctx, NodeTypeRequest{}, w)
+		assert.NoError(t, err)
+		assert.Equal(t, "small", nodeType)
+	})
+}
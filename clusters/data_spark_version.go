@@ -34,15 +34,18 @@ type SparkVersionRequest struct {
 	GPU             bool   `json:"gpu,omitempty"`
 	Scala           string `json:"scala,omitempty" tf:"default:2.12"`
 	SparkVersion    string `json:"spark_version,omitempty"`
-	Photon          bool   `json:"photon,omitempty"`
-	Graviton        bool   `json:"graviton,omitempty"`
+	// Constraint on Databricks Runtime version, like `>= 13.3, < 15` or `~> 14`
+	RuntimeVersion string `json:"runtime_version,omitempty"`
+	Photon         bool   `json:"photon,omitempty"`
+	Graviton       bool   `json:"graviton,omitempty"`
 }
 
 // ListSparkVersions returns smallest (or default) node type id given the criteria
 func (a ClustersAPI) ListSparkVersions() (SparkVersionsList, error) {
-	var sparkVersions SparkVersionsList
-	err := a.client.Get(a.context, "/clusters/spark-versions", nil, &sparkVersions)
-	return sparkVersions, err
+	return common.CachedLookup(a.client, "spark-versions", func() (sparkVersions SparkVersionsList, err error) {
+		err = a.client.Get(a.context, "/clusters/spark-versions", nil, &sparkVersions)
+		return
+	})
 }
 
 type sparkVersionsType []string
@@ -68,6 +71,62 @@ func (s sparkVersionsType) Less(i, j int) bool {
 	return semver.Compare("v"+extractDbrVersions(s[i]), "v"+extractDbrVersions(s[j])) > 0
 }
 
+func versionParts(v string) int {
+	return len(strings.Split(v, "."))
+}
+
+// matchesVersionConstraint checks the runtime version against comma-separated list of conditions with
+// `=`, `!=`, `>`, `>=`, `<`, `<=` and `~>` operators. `~> 14.1` allows versions from 14.1 up to 15.0,
+// excluding the latter, and `= 14` matches any 14.x version.
+func matchesVersionConstraint(runtimeVersion, constraint string) (bool, error) {
+	version := "v" + runtimeVersion
+	if !semver.IsValid(version) {
+		return false, nil
+	}
+	for _, condition := range strings.Split(constraint, ",") {
+		condition = strings.TrimSpace(condition)
+		operator := "="
+		for _, op := range []string{"~>", ">=", "<=", "!=", ">", "<", "="} {
+			if strings.HasPrefix(condition, op) {
+				operator = op
+				condition = strings.TrimSpace(strings.TrimPrefix(condition, op))
+				break
+			}
+		}
+		operand := "v" + condition
+		if !semver.IsValid(operand) || versionParts(condition) > 2 {
+			return false, fmt.Errorf("invalid runtime_version constraint: %s", constraint)
+		}
+		// `14` is compared to the major version, `14.3` to the major and minor version
+		truncated := semver.MajorMinor(version)
+		if versionParts(condition) == 1 {
+			truncated = semver.Major(version)
+		}
+		cmp := semver.Compare(truncated, operand)
+		var ok bool
+		switch operator {
+		case "=":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case "~>":
+			ok = semver.Compare(version, operand) >= 0 && semver.Major(version) == semver.Major(operand)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // LatestSparkVersion returns latest version matching the request parameters
 func (sparkVersions SparkVersionsList) LatestSparkVersion(req SparkVersionRequest) (string, error) {
 	var versions []string
@@ -87,6 +146,13 @@ func (sparkVersions SparkVersionsList) LatestSparkVersion(req SparkVersionReques
 			if matches && len(req.SparkVersion) > 0 {
 				matches = (matches && strings.Contains(version.Description, "Apache Spark "+req.SparkVersion))
 			}
+			if matches && req.RuntimeVersion != "" {
+				var err error
+				matches, err = matchesVersionConstraint(extractDbrVersions(version.Version), req.RuntimeVersion)
+				if err != nil {
+					return "", err
+				}
+			}
 			if matches {
 				versions = append(versions, version.Version)
 			}
@@ -145,5 +211,6 @@ func DataSourceSparkVersion() common.Resource {
 			d.SetId(version)
 			return nil
 		},
+		WorkspaceLevel: true,
 	}
 }
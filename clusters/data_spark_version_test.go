@@ -234,3 +234,73 @@ func TestSparkVersionErrorBadAnswer(t *testing.T) {
 	assert.Error(t, err)
 	require.Equal(t, true, strings.Contains(err.Error(), "invalid character 'g' looking"))
 }
+
+func TestSparkVersionRuntimeVersionConstraint(t *testing.T) {
+	for constraint, expected := range map[string]string{
+		"< 7.4":  "7.3.x-scala2.12",
+		"~> 7.3": "7.4.x-scala2.12",
+		"= 7":    "7.4.x-scala2.12",
+		"!= 7.4": "7.3.x-scala2.12",
+		"7.1":    "7.1.x-scala2.12",
+	} {
+		d, err := qa.ResourceFixture{
+			Fixtures:    commonFixtures(),
+			Read:        true,
+			Resource:    DataSourceSparkVersion(),
+			NonWritable: true,
+			State: map[string]any{
+				"runtime_version": constraint,
+			},
+			ID: ".",
+		}.Apply(t)
+		assert.NoError(t, err, constraint)
+		assert.Equal(t, expected, d.Id(), constraint)
+	}
+}
+
+func TestSparkVersionRuntimeVersionInvalidConstraint(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    commonFixtures(),
+		Read:        true,
+		Resource:    DataSourceSparkVersion(),
+		NonWritable: true,
+		State: map[string]any{
+			"runtime_version": ">= 7.x",
+		},
+		ID: ".",
+	}.ExpectError(t, "invalid runtime_version constraint: >= 7.x")
+}
+
+func TestSparkVersionRuntimeVersionNoResults(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    commonFixtures(),
+		Read:        true,
+		Resource:    DataSourceSparkVersion(),
+		NonWritable: true,
+		State: map[string]any{
+			"runtime_version": ">= 7.1, < 7",
+		},
+		ID: ".",
+	}.ExpectError(t, "spark versions query returned no results. Please change your search criteria and try again")
+}
+
+func TestMatchesVersionConstraint(t *testing.T) {
+	for _, tc := range []struct {
+		version    string
+		constraint string
+		matches    bool
+	}{
+		{"14.3", "~> 14", true},
+		{"15.0", "~> 14", false},
+		{"14.0", "~> 14.1", false},
+		{"13.3", ">= 13.3, < 15", true},
+		{"15.1", ">= 13.3, < 15", false},
+		{"14.3", "<= 14", true},
+		{"14.3", "> 14", false},
+		{"apache-spark-2.4.x-scala2.11", "> 1", false},
+	} {
+		matches, err := matchesVersionConstraint(tc.version, tc.constraint)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.matches, matches, "%s %s", tc.version, tc.constraint)
+	}
+}
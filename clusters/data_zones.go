@@ -33,5 +33,6 @@ func DataSourceClusterZones() common.Resource {
 				ForceNew: true,
 			},
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -1,9 +1,11 @@
 package clusters
 
 import (
+	"context"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
 )
@@ -46,3 +48,22 @@ func TestZones_404(t *testing.T) {
 		ID:          ".",
 	}.ExpectError(t, "missing")
 }
+
+func TestZones_Cached(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/list-zones",
+			Response: ZonesInfo{
+				DefaultZone: "a",
+				Zones:       []string{"a", "b"},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		for i := 0; i < 3; i++ {
+			zones, err := NewClustersAPI(ctx, client).ListZones()
+			assert.NoError(t, err)
+			assert.Equal(t, "a", zones.DefaultZone)
+		}
+	})
+}
@@ -2,13 +2,18 @@ package clusters
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/libraries"
 )
@@ -18,6 +23,81 @@ const DefaultProvisionTimeout = 30 * time.Minute
 
 const DbfsDeprecationWarning = "For init scripts use 'volumes', 'workspace' or cloud storage location instead of 'dbfs'."
 
+var (
+	validateWorkspaceInitScript = validation.StringMatch(regexp.MustCompile(`^/`),
+		"workspace init script must be an absolute path, like /Users/me@example.com/init.sh")
+	validateVolumesInitScript = validation.StringMatch(regexp.MustCompile(`^/Volumes/[^/]+/[^/]+/[^/]+/.+`),
+		"volumes init script must be a file in a Unity Catalog volume, like /Volumes/main/default/scripts/init.sh")
+	validateVolumesClusterLog = validation.StringMatch(regexp.MustCompile(`^/Volumes/[^/]+/[^/]+/[^/]+(/.*)?$`),
+		"cluster logs must be delivered to a Unity Catalog volume path, like /Volumes/main/default/logs")
+)
+
+// dataSecurityModes lists access modes of clusters. The last ones are from the newer naming scheme:
+// DATA_SECURITY_MODE_DEDICATED is the alias of SINGLE_USER, DATA_SECURITY_MODE_STANDARD of USER_ISOLATION,
+// and with DATA_SECURITY_MODE_AUTO Databricks picks the mode from the rest of the cluster configuration.
+var dataSecurityModes = []string{
+	string(compute.DataSecurityModeSingleUser),
+	string(compute.DataSecurityModeUserIsolation),
+	string(compute.DataSecurityModeNone),
+	string(compute.DataSecurityModeLegacyTableAcl),
+	string(compute.DataSecurityModeLegacyPassthrough),
+	string(compute.DataSecurityModeLegacySingleUser),
+	"DATA_SECURITY_MODE_DEDICATED",
+	"DATA_SECURITY_MODE_STANDARD",
+	"DATA_SECURITY_MODE_AUTO",
+}
+
+// azureAvailabilities lists availability types of nodes of clusters on Azure
+var azureAvailabilities = []string{
+	AzureAvailabilitySpot,
+	AzureAvailabilityOnDemand,
+	AzureAvailabilitySpotWithFallback,
+}
+
+// ValidateSpotBidMaxPrice checks that the max price of Azure spot instances is either -1, which means
+// the on-demand price, or a price that isn't negative
+func ValidateSpotBidMaxPrice(i any, k string) (warnings []string, errors []error) {
+	v, ok := i.(float64)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be float", k)}
+	}
+	if v < 0 && v != -1 {
+		return nil, []error{fmt.Errorf("%s must be -1 to use the on-demand price or a non-negative price, got: %v", k, v)}
+	}
+	return nil, nil
+}
+
+// validateSingleUser checks that clusters assigned to a single principal have it set
+func validateSingleUser(dataSecurityMode, singleUserName string) error {
+	switch dataSecurityMode {
+	case string(compute.DataSecurityModeSingleUser),
+		string(compute.DataSecurityModeLegacySingleUser),
+		"DATA_SECURITY_MODE_DEDICATED":
+		if singleUserName == "" {
+			return fmt.Errorf("single_user_name is required for data_security_mode = %s", dataSecurityMode)
+		}
+	}
+	return nil
+}
+
+// SetInitScriptsValidation checks paths of init scripts from workspace files and Unity Catalog volumes
+// in the cluster schema, so that mistakes are caught during the plan instead of cluster start
+func SetInitScriptsValidation(clusterSchema map[string]*schema.Schema) {
+	if p, err := common.SchemaPath(clusterSchema, "init_scripts", "workspace", "destination"); err == nil {
+		p.ValidateFunc = validateWorkspaceInitScript
+	}
+	if p, err := common.SchemaPath(clusterSchema, "init_scripts", "volumes", "destination"); err == nil {
+		p.ValidateFunc = validateVolumesInitScript
+	}
+}
+
+// SetClusterLogConfValidation checks that cluster logs delivered to Unity Catalog volumes have a /Volumes/... destination
+func SetClusterLogConfValidation(clusterSchema map[string]*schema.Schema) {
+	if p, err := common.SchemaPath(clusterSchema, "cluster_log_conf", "volumes", "destination"); err == nil {
+		p.ValidateFunc = validateVolumesClusterLog
+	}
+}
+
 var clusterSchema = resourceClusterSchema()
 
 // ResourceCluster - returns Cluster resource description
@@ -30,6 +110,12 @@ func ResourceCluster() common.Resource {
 			d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewClustersAPI(ctx, c).PermanentDelete(d.Id())
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if !d.NewValueKnown("data_security_mode") || !d.NewValueKnown("single_user_name") {
+				return nil
+			}
+			return validateSingleUser(d.Get("data_security_mode").(string), d.Get("single_user_name").(string))
+		},
 		Schema:        clusterSchema,
 		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
@@ -37,6 +123,7 @@ func ResourceCluster() common.Resource {
 			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
 			Delete: schema.DefaultTimeout(DefaultProvisionTimeout),
 		},
+		WorkspaceLevel: true,
 	}
 }
 
@@ -74,8 +161,9 @@ func (ClusterSpec) CustomizeSchema(s map[string]*schema.Schema) map[string]*sche
 	common.CustomizeSchemaPath(s, "init_scripts", "dbfs").SetDeprecated(DbfsDeprecationWarning)
 	common.CustomizeSchemaPath(s, "init_scripts", "dbfs", "destination").SetRequired()
 	common.CustomizeSchemaPath(s, "init_scripts", "s3", "destination").SetRequired()
-	common.CustomizeSchemaPath(s, "init_scripts", "volumes", "destination").SetRequired()
-	common.CustomizeSchemaPath(s, "init_scripts", "workspace", "destination").SetRequired()
+	common.CustomizeSchemaPath(s, "init_scripts", "volumes", "destination").SetRequired().SetValidateFunc(validateVolumesInitScript)
+	common.CustomizeSchemaPath(s, "init_scripts", "workspace", "destination").SetRequired().SetValidateFunc(validateWorkspaceInitScript)
+	common.CustomizeSchemaPath(s, "workload_type").SetSuppressDiff()
 	common.CustomizeSchemaPath(s, "workload_type", "clients").SetRequired()
 	common.CustomizeSchemaPath(s, "workload_type", "clients", "notebooks").SetDefault(true)
 	common.CustomizeSchemaPath(s, "workload_type", "clients", "jobs").SetDefault(true)
@@ -84,7 +172,7 @@ func (ClusterSpec) CustomizeSchema(s map[string]*schema.Schema) map[string]*sche
 		Optional: true,
 		ForceNew: true,
 	})
-	common.CustomizeSchemaPath(s, "data_security_mode").SetSuppressDiff()
+	common.CustomizeSchemaPath(s, "data_security_mode").SetSuppressDiff().SetValidateFunc(validation.StringInSlice(dataSecurityModes, false))
 	common.CustomizeSchemaPath(s, "docker_image", "url").SetRequired()
 	common.CustomizeSchemaPath(s, "docker_image", "basic_auth", "password").SetRequired().SetSensitive()
 	common.CustomizeSchemaPath(s, "docker_image", "basic_auth", "username").SetRequired()
@@ -92,6 +180,9 @@ func (ClusterSpec) CustomizeSchema(s map[string]*schema.Schema) map[string]*sche
 	common.CustomizeSchemaPath(s, "aws_attributes").SetSuppressDiff().SetConflictsWith([]string{"azure_attributes", "gcp_attributes"})
 	common.CustomizeSchemaPath(s, "aws_attributes", "zone_id").SetCustomSuppressDiff(ZoneDiffSuppress)
 	common.CustomizeSchemaPath(s, "azure_attributes").SetSuppressDiff().SetConflictsWith([]string{"aws_attributes", "gcp_attributes"})
+	common.CustomizeSchemaPath(s, "azure_attributes", "availability").SetValidateFunc(validation.StringInSlice(azureAvailabilities, false))
+	common.CustomizeSchemaPath(s, "azure_attributes", "first_on_demand").SetValidateFunc(validation.IntAtLeast(0))
+	common.CustomizeSchemaPath(s, "azure_attributes", "spot_bid_max_price").SetValidateFunc(ValidateSpotBidMaxPrice)
 	common.CustomizeSchemaPath(s, "gcp_attributes").SetSuppressDiff().SetConflictsWith([]string{"aws_attributes", "azure_attributes"})
 
 	common.CustomizeSchemaPath(s).AddNewField("library", common.StructToSchema(libraries.ClusterLibraryList{},
@@ -102,12 +193,27 @@ func (ClusterSpec) CustomizeSchema(s map[string]*schema.Schema) map[string]*sche
 			}
 			return ss
 		})["library"])
+	common.CustomizeSchemaPath(s, "library", "pypi", "package").SetValidateDiagFunc(libraries.ValidateRuntimeProvidedPackage)
 
 	common.CustomizeSchemaPath(s, "autotermination_minutes").SetDefault(60)
 	common.CustomizeSchemaPath(s, "autoscale", "max_workers").SetOptional()
 	common.CustomizeSchemaPath(s, "autoscale", "min_workers").SetOptional()
 	common.CustomizeSchemaPath(s, "cluster_log_conf", "dbfs", "destination").SetRequired()
 	common.CustomizeSchemaPath(s, "cluster_log_conf", "s3", "destination").SetRequired()
+	common.CustomizeSchemaPath(s, "cluster_log_conf").AddNewField("volumes", &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateVolumesClusterLog,
+				},
+			},
+		},
+	})
 	common.CustomizeSchemaPath(s, "spark_version").SetRequired()
 	common.CustomizeSchemaPath(s).AddNewField("cluster_id", &schema.Schema{
 		Type:     schema.TypeString,
@@ -135,6 +241,16 @@ func (ClusterSpec) CustomizeSchema(s map[string]*schema.Schema) map[string]*sche
 			return old == new
 		},
 	})
+	for _, field := range []string{"no_wait", "create_stopped"} {
+		common.CustomizeSchemaPath(s).AddNewField(field, &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return old == "" && new == "false"
+			},
+		})
+	}
 	common.CustomizeSchemaPath(s).AddNewField("url", &schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
@@ -151,6 +267,82 @@ func (ClusterSpec) CustomizeSchema(s map[string]*schema.Schema) map[string]*sche
 	return s
 }
 
+var secretReferenceRegex = regexp.MustCompile(`\{\{secrets/([^/{}]+)/([^/{}]+)\}\}`)
+
+// validateSecretReferences checks that `{{secrets/scope/key}}` references in spark_conf,
+// spark_env_vars and docker_image credentials point to existing secrets, so that broken references are reported
+// before a long cluster start fails. Validation is skipped when secret metadata can't be read.
+func validateSecretReferences(ctx context.Context, c *common.DatabricksClient, cluster Cluster) error {
+	references := map[string]map[string][]string{}
+	collect := func(field string, conf map[string]string) {
+		for k, v := range conf {
+			for _, match := range secretReferenceRegex.FindAllStringSubmatch(v, -1) {
+				scope, key := match[1], match[2]
+				if references[scope] == nil {
+					references[scope] = map[string][]string{}
+				}
+				references[scope][key] = append(references[scope][key], fmt.Sprintf("%s.%s", field, k))
+			}
+		}
+	}
+	collect("spark_conf", cluster.SparkConf)
+	collect("spark_env_vars", cluster.SparkEnvVars)
+	if cluster.DockerImage != nil && cluster.DockerImage.BasicAuth != nil {
+		collect("docker_image.basic_auth", map[string]string{
+			"username": cluster.DockerImage.BasicAuth.Username,
+			"password": cluster.DockerImage.BasicAuth.Password,
+		})
+	}
+	if len(references) == 0 {
+		return nil
+	}
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	scopes := make([]string, 0, len(references))
+	for scope := range references {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	for _, scope := range scopes {
+		secrets, err := w.Secrets.ListSecretsAll(ctx, workspace.ListSecretsRequest{Scope: scope})
+		if apierr.IsMissing(err) {
+			var fields []string
+			for _, v := range references[scope] {
+				fields = append(fields, v...)
+			}
+			return fmt.Errorf("secret scope %s referenced in %s does not exist",
+				scope, firstSecretReference(fields))
+		}
+		if err != nil {
+			log.Printf("[WARN] cannot validate secret references for scope %s: %s", scope, err)
+			continue
+		}
+		existing := map[string]bool{}
+		for _, secret := range secrets {
+			existing[secret.Key] = true
+		}
+		keys := make([]string, 0, len(references[scope]))
+		for key := range references[scope] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if !existing[key] {
+				return fmt.Errorf("secret %s in scope %s referenced in %s does not exist",
+					key, scope, firstSecretReference(references[scope][key]))
+			}
+		}
+	}
+	return nil
+}
+
+func firstSecretReference(fields []string) string {
+	sort.Strings(fields)
+	return fields[0]
+}
+
 func resourceClusterSchema() map[string]*schema.Schema {
 	return common.StructToSchema(ClusterSpec{}, nil)
 }
@@ -164,14 +356,30 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 	if err := cluster.Validate(); err != nil {
 		return err
 	}
+	if err := validateSecretReferences(ctx, c, cluster); err != nil {
+		return err
+	}
 	cluster.ModifyRequestOnInstancePool()
-	// TODO: propagate d.Timeout(schema.TimeoutCreate)
-	clusterInfo, err := clusters.Create(cluster)
+	noWait := d.Get("no_wait").(bool) || d.Get("create_stopped").(bool)
+	var clusterInfo ClusterInfo
+	var err error
+	if noWait {
+		clusterInfo, err = clusters.CreateNoWait(cluster)
+	} else {
+		// TODO: propagate d.Timeout(schema.TimeoutCreate)
+		clusterInfo, err = clusters.Create(cluster)
+	}
 	if err != nil {
 		return err
 	}
 	d.SetId(clusterInfo.ClusterID)
 	d.Set("cluster_id", clusterInfo.ClusterID)
+	if d.Get("create_stopped").(bool) {
+		// clusters are always started on creation, so the new one is terminated before it's up
+		if err = clusters.Terminate(clusterInfo.ClusterID); err != nil {
+			return err
+		}
+	}
 	isPinned, ok := d.GetOk("is_pinned")
 	if ok && isPinned.(bool) {
 		err = clusters.Pin(clusterInfo.ClusterID)
@@ -186,6 +394,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 		if err = libs.Install(libraryList); err != nil {
 			return err
 		}
+		if noWait {
+			// libraries are installed once the cluster is running
+			return nil
+		}
 		_, err := libs.WaitForLibrariesInstalled(libraries.Wait{
 			ClusterID: d.Id(),
 			Timeout:   timeout - time.Since(start),
@@ -223,6 +435,11 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, c *common.
 	if err != nil {
 		return err
 	}
+	if docker := clusterInfo.DockerImage; docker != nil && docker.BasicAuth != nil && docker.BasicAuth.Password == "" {
+		// password of the registry is never returned by the API
+		docker.BasicAuth.Password = d.Get("docker_image.0.basic_auth.0.password").(string)
+	}
+	clusterInfo.CustomTags = c.RemoveIgnoredTags(clusterInfo.CustomTags, d.Get("custom_tags").(map[string]any))
 	if err = common.StructToData(clusterInfo, clusterSchema, d); err != nil {
 		return err
 	}
@@ -246,14 +463,34 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, c *common.
 	if err != nil {
 		return err
 	}
+	if shouldSkipLibrariesRead {
+		// libraries installed with databricks_library or by other means aren't managed by the cluster resource
+		managed := libsClusterStatus.Only(librariesFromSet(d.Get("library")))
+		libsClusterStatus = &managed
+	}
 	libList := libsClusterStatus.ToLibraryList()
 	return common.StructToData(libList, clusterSchema, d)
 }
 
+// librariesFromSet converts the value of the `library` set to the list of libraries
+func librariesFromSet(v any) (libs []libraries.Library) {
+	for _, i := range v.(*schema.Set).List() {
+		libs = append(libs, libraries.NewLibraryFromInstanceState(i))
+	}
+	return
+}
+
+// nonClusterConfigFields are managed by the provider and aren't sent to the Clusters API
+var nonClusterConfigFields = map[string]bool{
+	"library":        true,
+	"is_pinned":      true,
+	"no_wait":        true,
+	"create_stopped": true,
+}
+
 func hasClusterConfigChanged(d *schema.ResourceData) bool {
 	for k := range clusterSchema {
-		// TODO: create a map if we'll add more non-cluster config parameters in the future
-		if k == "library" || k == "is_pinned" {
+		if nonClusterConfigFields[k] {
 			continue
 		}
 		if d.HasChange(k) {
@@ -276,6 +513,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 		if err := cluster.Validate(); err != nil {
 			return err
 		}
+		if d.HasChanges("spark_conf", "spark_env_vars", "docker_image") {
+			if err := validateSecretReferences(ctx, c, cluster); err != nil {
+				return err
+			}
+		}
 		cluster.ModifyRequestOnInstancePool()
 		cluster.FixInstancePoolChangeIfAny(d)
 
@@ -285,8 +527,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 		hasAutoscaleChanged := d.HasChange("autoscale")
 		hasOnlyResizeClusterConfigChanged := true
 		for k := range clusterSchema {
-			if k == "library" ||
-				k == "is_pinned" ||
+			if nonClusterConfigFields[k] ||
 				k == "num_workers" ||
 				k == "autoscale" {
 				continue
@@ -299,6 +540,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 		if err != nil {
 			return err
 		}
+		cluster.CustomTags = c.KeepIgnoredTags(cluster.CustomTags, clusterInfo.CustomTags)
 
 		isNumWorkersResizeForNonAutoscalingCluster := hasOnlyResizeClusterConfigChanged &&
 			hasNumWorkersChanged &&
@@ -370,6 +612,10 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 	if err != nil {
 		return err
 	}
+	// only uninstall libraries previously declared on this resource and keep the ones installed
+	// with databricks_library, so that both resources can manage libraries on the same cluster
+	oldLibs, newLibs := d.GetChange("library")
+	libsClusterStatus = libsClusterStatus.Only(append(librariesFromSet(oldLibs), librariesFromSet(newLibs)...))
 	libraryList.ClusterID = clusterID
 	libsToInstall, libsToUninstall := libraryList.Diff(libsClusterStatus)
 	if len(libsToUninstall.Libraries) > 0 || len(libsToInstall.Libraries) > 0 {
@@ -9,7 +9,10 @@ import (
 	"github.com/databricks/terraform-provider-databricks/libraries"
 
 	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -83,36 +86,489 @@ func TestResourceClusterCreate(t *testing.T) {
 	assert.Equal(t, "abc", d.Id())
 }
 
+func TestResourceClusterCreate_MissingSecretScope(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=missing",
+				Status:   404,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Scope missing does not exist!",
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		spark_conf = {
+			"fs.azure.account.key" = "{{secrets/missing/key}}"
+		}`,
+	}.ExpectError(t, "secret scope missing referenced in spark_conf.fs.azure.account.key does not exist")
+}
+
+func TestResourceClusterCreate_MissingSecretKey(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=scope",
+				Response: workspace.ListSecretsResponse{
+					Secrets: []workspace.SecretMetadata{
+						{
+							Key: "present",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		spark_env_vars = {
+			"PRESENT" = "{{secrets/scope/present}}"
+			"ABSENT" = "{{secrets/scope/absent}}"
+		}`,
+	}.ExpectError(t, "secret absent in scope scope referenced in spark_env_vars.ABSENT does not exist")
+}
+
+func TestResourceClusterCreate_DockerImage(t *testing.T) {
+	dockerImage := &compute.DockerImage{
+		Url: "myregistry.azurecr.io/runtime:latest",
+		BasicAuth: &compute.DockerBasicAuth{
+			Username: "{{secrets/registry/username}}",
+			Password: "{{secrets/registry/password}}",
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=registry",
+				Response: workspace.ListSecretsResponse{
+					Secrets: []workspace.SecretMetadata{
+						{
+							Key: "username",
+						},
+						{
+							Key: "password",
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: compute.ClusterSpec{
+					NumWorkers:             1,
+					ClusterName:            "Containers",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeId:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					DockerImage:            dockerImage,
+				},
+				Response: compute.ClusterDetails{
+					ClusterId: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: compute.ClusterDetails{
+					ClusterId:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Containers",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeId:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					State:                  ClusterStateRunning,
+					DockerImage: &compute.DockerImage{
+						Url: "myregistry.azurecr.io/runtime:latest",
+						BasicAuth: &compute.DockerBasicAuth{
+							Username: "{{secrets/registry/username}}",
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Containers"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		docker_image {
+			url = "myregistry.azurecr.io/runtime:latest"
+			basic_auth {
+				username = "{{secrets/registry/username}}"
+				password = "{{secrets/registry/password}}"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "myregistry.azurecr.io/runtime:latest", d.Get("docker_image.0.url"))
+	// password isn't returned by the API and is kept from the configuration
+	assert.Equal(t, "{{secrets/registry/password}}", d.Get("docker_image.0.basic_auth.0.password"))
+}
+
+func TestResourceClusterCreate_MissingDockerSecret(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=registry",
+				Response: workspace.ListSecretsResponse{
+					Secrets: []workspace.SecretMetadata{
+						{
+							Key: "username",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Containers"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		docker_image {
+			url = "myregistry.azurecr.io/runtime:latest"
+			basic_auth {
+				username = "{{secrets/registry/username}}"
+				password = "{{secrets/registry/password}}"
+			}
+		}`,
+	}.ExpectError(t, "secret password in scope registry referenced in docker_image.basic_auth.password does not exist")
+}
+
+func TestResourceClusterCreate_SecretValidationSkippedWithoutPermissions(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=scope",
+				Status:   403,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "PERMISSION_DENIED",
+					Message:   "User does not have READ permission on scope scope",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: compute.ClusterSpec{
+					NumWorkers:             1,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeId:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					SparkConf: map[string]string{
+						"fs.azure.account.key": "{{secrets/scope/key}}",
+					},
+				},
+				Response: compute.ClusterDetails{
+					ClusterId: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: compute.ClusterDetails{
+					ClusterId:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeId:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					SparkConf: map[string]string{
+						"fs.azure.account.key": "{{secrets/scope/key}}",
+					},
+					State: ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					LibraryStatuses: []libraries.LibraryStatus{},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		spark_conf = {
+			"fs.azure.account.key" = "{{secrets/scope/key}}"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+}
+
 func TestResourceClusterCreatePinned(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/clusters/create",
-				ExpectedRequest: Cluster{
+				ExpectedRequest: Cluster{
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+				},
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/clusters/pin",
+				ExpectedRequest: ClusterID{ClusterID: "abc"},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					LibraryStatuses: []libraries.LibraryStatus{},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events: []ClusterEvent{
+						{
+							ClusterID: "abc",
+							Timestamp: int64(123),
+							Type:      EvTypePinned,
+							Details:   EventDetails{},
+						},
+					},
+					TotalCount: 1,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		State: map[string]any{
+			"autotermination_minutes": 15,
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             100,
+			"is_pinned":               true,
+		},
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+}
+
+func TestResourceClusterCreate_NoWait(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: compute.ClusterSpec{
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeId:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+				},
+				Response: compute.ClusterDetails{
+					ClusterId: "abc",
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: compute.ClusterDetails{
+					ClusterId:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeId:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStatePending,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/install",
+				ExpectedRequest: libraries.ClusterLibraryList{
+					ClusterID: "abc",
+					Libraries: []libraries.Library{
+						{
+							Jar: "dbfs://foo.jar",
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{
+								Jar: "dbfs://foo.jar",
+							},
+							Status: "PENDING",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		autotermination_minutes = 15
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 100
+		no_wait = true
+		library {
+			jar = "dbfs://foo.jar"
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "PENDING", d.Get("state"))
+	assert.Equal(t, true, d.Get("no_wait"))
+}
+
+func TestResourceClusterCreate_Stopped(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: compute.ClusterSpec{
 					NumWorkers:             100,
 					ClusterName:            "Shared Autoscaling",
 					SparkVersion:           "7.1-scala12",
-					NodeTypeID:             "i3.xlarge",
+					NodeTypeId:             "i3.xlarge",
 					AutoterminationMinutes: 15,
 				},
-				Response: ClusterInfo{
-					ClusterID: "abc",
-					State:     ClusterStateRunning,
+				Response: compute.ClusterDetails{
+					ClusterId: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: compute.ClusterDetails{
+					ClusterId: "abc",
+					State:     ClusterStatePending,
 				},
 			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/clusters/delete",
+				ExpectedRequest: ClusterID{ClusterID: "abc"},
+			},
 			{
 				Method:       "GET",
 				ReuseRequest: true,
 				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
-				Response: ClusterInfo{
-					ClusterID:              "abc",
+				Response: compute.ClusterDetails{
+					ClusterId:              "abc",
 					NumWorkers:             100,
 					ClusterName:            "Shared Autoscaling",
 					SparkVersion:           "7.1-scala12",
-					NodeTypeID:             "i3.xlarge",
+					NodeTypeId:             "i3.xlarge",
 					AutoterminationMinutes: 15,
-					State:                  ClusterStateRunning,
+					State:                  ClusterStateTerminated,
 				},
 			},
 			{
@@ -120,13 +576,6 @@ func TestResourceClusterCreatePinned(t *testing.T) {
 				Resource:        "/api/2.0/clusters/pin",
 				ExpectedRequest: ClusterID{ClusterID: "abc"},
 			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
-				Response: libraries.ClusterLibraryStatuses{
-					LibraryStatuses: []libraries.LibraryStatus{},
-				},
-			},
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/clusters/events",
@@ -140,9 +589,7 @@ func TestResourceClusterCreatePinned(t *testing.T) {
 					Events: []ClusterEvent{
 						{
 							ClusterID: "abc",
-							Timestamp: int64(123),
 							Type:      EvTypePinned,
-							Details:   EventDetails{},
 						},
 					},
 					TotalCount: 1,
@@ -151,17 +598,20 @@ func TestResourceClusterCreatePinned(t *testing.T) {
 		},
 		Create:   true,
 		Resource: ResourceCluster(),
-		State: map[string]any{
-			"autotermination_minutes": 15,
-			"cluster_name":            "Shared Autoscaling",
-			"spark_version":           "7.1-scala12",
-			"node_type_id":            "i3.xlarge",
-			"num_workers":             100,
-			"is_pinned":               true,
-		},
+		HCL: `
+		autotermination_minutes = 15
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 100
+		create_stopped = true
+		is_pinned = true
+		`,
 	}.Apply(t)
 	assert.NoError(t, err)
 	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "TERMINATED", d.Get("state"))
+	assert.Equal(t, true, d.Get("is_pinned"))
 }
 
 func TestResourceClusterCreate_WithLibraries(t *testing.T) {
@@ -487,29 +937,303 @@ func TestResourceClusterRead(t *testing.T) {
 					Order:      SortDescending,
 					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
 				},
-				Response: EventsResponse{
-					Events:     []ClusterEvent{},
-					TotalCount: 0,
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		Resource: ResourceCluster(),
+		Read:     true,
+		ID:       "abc",
+		New:      true,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", d.Id(), "Id should not be empty")
+	assert.Equal(t, 15, d.Get("autotermination_minutes"))
+	assert.Equal(t, "Shared Autoscaling", d.Get("cluster_name"))
+	assert.Equal(t, "i3.xlarge", d.Get("node_type_id"))
+	assert.Equal(t, 4, d.Get("autoscale.0.max_workers"))
+	assert.Equal(t, "RUNNING", d.Get("state"))
+	assert.Equal(t, false, d.Get("is_pinned"))
+
+	for k, v := range d.State().Attributes {
+		fmt.Printf("assert.Equal(t, %#v, d.Get(%#v))\n", v, k)
+	}
+}
+
+func TestResourceClusterCreate_WorkloadType(t *testing.T) {
+	workloadType := &WorkloadType{
+		Clients: &WorkloadTypeClients{
+			Notebooks: true,
+			Jobs:      false,
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: Cluster{
+					NumWorkers:             1,
+					ClusterName:            "Shared Analysis",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					WorkloadType:           workloadType,
+				},
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Shared Analysis",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					State:                  ClusterStateRunning,
+					WorkloadType:           workloadType,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Analysis"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		workload_type {
+			clients {
+				jobs = false
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, true, d.Get("workload_type.0.clients.0.notebooks"))
+	assert.Equal(t, false, d.Get("workload_type.0.clients.0.jobs"))
+}
+
+func TestResourceClusterRead_WorkloadTypeDrift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Shared Analysis",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					State:                  ClusterStateRunning,
+					WorkloadType: &WorkloadType{
+						Clients: &WorkloadTypeClients{
+							Notebooks: true,
+							Jobs:      true,
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		Resource: ResourceCluster(),
+		Read:     true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"workload_type.#":                     "1",
+			"workload_type.0.clients.#":           "1",
+			"workload_type.0.clients.0.jobs":      "false",
+			"workload_type.0.clients.0.notebooks": "true",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"workload_type.0.clients.0.jobs": true,
+	})
+}
+
+func TestResourceClusterRead_IgnoreTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Shared Analysis",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					State:                  ClusterStateRunning,
+					CustomTags: map[string]string{
+						"team":   "data",
+						"owner":  "finance",
+						"budget": "1234",
+					},
+					DefaultTags: map[string]string{
+						"Vendor":      "Databricks",
+						"ClusterName": "Shared Analysis",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		Resource:   ResourceCluster(),
+		IgnoreTags: []string{"owner", "budget"},
+		Read:       true,
+		New:        true,
+		ID:         "abc",
+		HCL: `
+		cluster_name = "Shared Analysis"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		autotermination_minutes = 60
+		custom_tags = {
+			team = "data"
+			budget = "1234"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"custom_tags": map[string]any{
+			"team":   "data",
+			"budget": "1234",
+		},
+	})
+}
+
+func TestResourceClusterUpdate_KeepsIgnoredTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				ReuseRequest: true,
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateTerminated,
+					CustomTags: map[string]string{
+						"team":  "data",
+						"owner": "finance",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/edit",
+				ExpectedRequest: Cluster{
+					AutoterminationMinutes: 15,
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					CustomTags: map[string]string{
+						"team":  "ml",
+						"owner": "finance",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: libraries.ClusterLibraryStatuses{
+					LibraryStatuses: []libraries.LibraryStatus{},
 				},
 			},
 		},
-		Resource: ResourceCluster(),
-		Read:     true,
-		ID:       "abc",
-		New:      true,
-	}.Apply(t)
-	require.NoError(t, err)
-	assert.Equal(t, "abc", d.Id(), "Id should not be empty")
-	assert.Equal(t, 15, d.Get("autotermination_minutes"))
-	assert.Equal(t, "Shared Autoscaling", d.Get("cluster_name"))
-	assert.Equal(t, "i3.xlarge", d.Get("node_type_id"))
-	assert.Equal(t, 4, d.Get("autoscale.0.max_workers"))
-	assert.Equal(t, "RUNNING", d.Get("state"))
-	assert.Equal(t, false, d.Get("is_pinned"))
-
-	for k, v := range d.State().Attributes {
-		fmt.Printf("assert.Equal(t, %#v, d.Get(%#v))\n", v, k)
-	}
+		ID:         "abc",
+		Update:     true,
+		Resource:   ResourceCluster(),
+		IgnoreTags: []string{"owner"},
+		InstanceState: map[string]string{
+			"autotermination_minutes": "15",
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "100",
+			"custom_tags.%":           "1",
+			"custom_tags.team":        "data",
+		},
+		HCL: `
+		autotermination_minutes = 15
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 100
+		custom_tags = {
+			team = "ml"
+		}`,
+	}.ApplyNoError(t)
 }
 
 func TestResourceClusterRead_NotFound(t *testing.T) {
@@ -1629,6 +2353,62 @@ func TestReadOnStoppedClusterWithLibrariesDoesNotFail(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestReadClusterIgnoresLibrariesInstalledSeparately(t *testing.T) {
+	managed := fmt.Sprintf("library.%d", schema.HashString("whl:bar.whl"))
+	qa.ResourceFixture{
+		Resource: ResourceCluster(),
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=foo",
+				Response: ClusterInfo{
+					SparkVersion: "11.3.x-scala2.12",
+					State:        ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=foo",
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "foo",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Status: "INSTALLED",
+							Library: &libraries.Library{
+								Jar: "installed-with-databricks-library.jar",
+							},
+						},
+						{
+							Status: "INSTALLED",
+							Library: &libraries.Library{
+								Whl: "bar.whl",
+							},
+						},
+					},
+				},
+			},
+		},
+		InstanceState: map[string]string{
+			"library.#":      "1",
+			managed + ".whl": "bar.whl",
+		},
+		HCL: `
+		spark_version = "11.3.x-scala2.12"
+		library {
+			whl = "bar.whl"
+		}`,
+		Read: true,
+		ID:   "foo",
+	}.ApplyAndExpectData(t, map[string]any{
+		"library.#":      1,
+		managed + ".whl": "bar.whl",
+	})
+}
+
 // https://github.com/databricks/terraform-provider-databricks/issues/599
 func TestRefreshOnRunningClusterWithFailedLibraryUninstallsIt(t *testing.T) {
 	qa.ResourceFixture{
@@ -1684,3 +2464,269 @@ func TestRefreshOnRunningClusterWithFailedLibraryUninstallsIt(t *testing.T) {
 		ID:   "foo",
 	}.ApplyNoError(t)
 }
+
+func TestResourceClusterCreate_InvalidInitScripts(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		init_scripts {
+			volumes {
+				destination = "/Volumes/main/default/init.sh"
+			}
+		}
+		init_scripts {
+			workspace {
+				destination = "/Users/me@example.com/init.sh"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [init_scripts.#.volumes.#.destination] "+
+		"invalid value for init_scripts.0.volumes.0.destination "+
+		"(volumes init script must be a file in a Unity Catalog volume, like /Volumes/main/default/scripts/init.sh)")
+}
+
+func TestResourceClusterCreate_ClusterLogConfVolumes(t *testing.T) {
+	logConf := &StorageInfo{
+		Volumes: &compute.VolumesStorageInfo{
+			Destination: "/Volumes/main/default/logs",
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: Cluster{
+					NumWorkers:             1,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					ClusterLogConf:         logConf,
+				},
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					ClusterLogConf:         logConf,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					LibraryStatuses: []libraries.LibraryStatus{},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		cluster_log_conf {
+			volumes {
+				destination = "/Volumes/main/default/logs"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "/Volumes/main/default/logs", d.Get("cluster_log_conf.0.volumes.0.destination"))
+}
+
+func TestResourceClusterCreate_InvalidClusterLogConfVolumes(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		cluster_log_conf {
+			volumes {
+				destination = "dbfs:/cluster-logs"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [cluster_log_conf.#.volumes.#.destination] "+
+		"invalid value for cluster_log_conf.0.volumes.0.destination "+
+		"(cluster logs must be delivered to a Unity Catalog volume path, like /Volumes/main/default/logs)")
+}
+
+func TestResourceClusterCreate_InvalidAzureAvailability(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Spot"
+		spark_version = "7.1-scala12"
+		node_type_id = "Standard_DS3_v2"
+		num_workers = 1
+		azure_attributes {
+			availability = "SPOT_WITH_FALLBACK"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [azure_attributes.#.availability] "+
+		"expected azure_attributes.0.availability to be one of "+
+		"[SPOT_AZURE ON_DEMAND_AZURE SPOT_WITH_FALLBACK_AZURE], got SPOT_WITH_FALLBACK")
+}
+
+func TestResourceClusterCreate_InvalidSpotBidMaxPrice(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Spot"
+		spark_version = "7.1-scala12"
+		node_type_id = "Standard_DS3_v2"
+		num_workers = 1
+		azure_attributes {
+			availability = "SPOT_WITH_FALLBACK_AZURE"
+			first_on_demand = 1
+			spot_bid_max_price = -2
+		}`,
+	}.ExpectError(t, "invalid config supplied. [azure_attributes.#.spot_bid_max_price] "+
+		"azure_attributes.0.spot_bid_max_price must be -1 to use the on-demand price or a non-negative price, got: -2")
+}
+
+func TestResourceClusterCreate_SingleUserWithoutName(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Personal"
+		spark_version = "13.3.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "SINGLE_USER"`,
+	}.ExpectError(t, "single_user_name is required for data_security_mode = SINGLE_USER")
+}
+
+func TestResourceClusterCreate_InvalidDataSecurityMode(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Personal"
+		spark_version = "13.3.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "SHARED"`,
+	}.ExpectError(t, "invalid config supplied. [data_security_mode] expected data_security_mode "+
+		"to be one of [SINGLE_USER USER_ISOLATION NONE LEGACY_TABLE_ACL "+
+		"LEGACY_PASSTHROUGH LEGACY_SINGLE_USER DATA_SECURITY_MODE_DEDICATED "+
+		"DATA_SECURITY_MODE_STANDARD DATA_SECURITY_MODE_AUTO], got SHARED")
+}
+
+func TestValidateSingleUser(t *testing.T) {
+	assert.NoError(t, validateSingleUser("SINGLE_USER", "me@example.com"))
+	assert.NoError(t, validateSingleUser("USER_ISOLATION", ""))
+	assert.NoError(t, validateSingleUser("", ""))
+	assert.EqualError(t, validateSingleUser("DATA_SECURITY_MODE_DEDICATED", ""),
+		"single_user_name is required for data_security_mode = DATA_SECURITY_MODE_DEDICATED")
+}
+
+func TestResourceClusterUpdate_PinnedClustersLimit(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/pin",
+				Status:   400,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "QUOTA_EXCEEDED",
+					Message:   "Cannot pin more than 100 clusters",
+				},
+			},
+		},
+		ID:       "abc",
+		Update:   true,
+		Resource: ResourceCluster(),
+		InstanceState: map[string]string{
+			"autotermination_minutes": "15",
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "100",
+			"is_pinned":               "false",
+		},
+		State: map[string]any{
+			"autotermination_minutes": 15,
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             100,
+			"is_pinned":               true,
+		},
+	}.ExpectError(t, "cannot pin cluster abc, because at most 100 clusters can be pinned in a workspace. "+
+		"Unpin clusters that are no longer needed or set is_pinned = false: Cannot pin more than 100 clusters")
+}
+
+func TestResourceClusterLibrary_RuntimeProvidedPackage(t *testing.T) {
+	r := ResourceCluster().ToResource()
+	assert.NoError(t, r.InternalValidate(nil, true))
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"spark_version": "15.4.x-scala2.12",
+		"node_type_id":  "i3.xlarge",
+		"num_workers":   1,
+		"library": []any{
+			map[string]any{
+				"pypi": []any{
+					map[string]any{
+						"package": "pyspark==3.3.0",
+					},
+				},
+			},
+		},
+	}))
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "pyspark is provided by the Databricks Runtime", diags[0].Summary)
+}
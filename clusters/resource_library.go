@@ -21,6 +21,7 @@ func (LibraryResource) CustomizeSchema(s map[string]*schema.Schema) map[string]*
 		Type:     schema.TypeString,
 		Required: true,
 	})
+	common.CustomizeSchemaPath(s, "pypi", "package").SetValidateDiagFunc(libraries.ValidateRuntimeProvidedPackage)
 	return s
 }
 
@@ -112,5 +113,6 @@ func ResourceLibrary() common.Resource {
 			}
 			return apierr.NotFound(fmt.Sprintf("cannot find %s on %s", libraryRep, clusterID))
 		},
+		WorkspaceLevel: true,
 	}
 }
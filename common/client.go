@@ -2,11 +2,13 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/client"
@@ -48,6 +50,25 @@ type DatabricksClient struct {
 	cachedWorkspaceClient *databricks.WorkspaceClient
 	cachedAccountClient   *databricks.AccountClient
 	mu                    sync.Mutex
+
+	// ReadOnly blocks creation, update and deletion of all resources
+	ReadOnly bool
+
+	// NamePrefix and NameSuffix are added to names of objects, that are created by resources
+	// configured with AddNameAffixes
+	NamePrefix string
+	NameSuffix string
+
+	// IgnoreTags are keys of custom tags, that are set outside of Terraform, in addition to DatabricksInjectedTags
+	IgnoreTags []string
+
+	// MaxDeletes limits the number of resources, that a single plan can delete. Zero means no limit
+	MaxDeletes int
+
+	// LookupCacheTTL keeps results of CachedLookup on disk for this long. Zero keeps them only in memory
+	LookupCacheTTL time.Duration
+	lookupCacheDir string
+	lookups        map[string]*lookupEntry
 }
 
 func (c *DatabricksClient) WorkspaceClient() (*databricks.WorkspaceClient, error) {
@@ -57,6 +78,9 @@ func (c *DatabricksClient) WorkspaceClient() (*databricks.WorkspaceClient, error
 		return c.cachedWorkspaceClient, nil
 	}
 	w, err := databricks.NewWorkspaceClient((*databricks.Config)(c.DatabricksClient.Config))
+	if errors.Is(err, databricks.ErrNotWorkspaceClient) {
+		return nil, workspaceHostRequired(c.DatabricksClient.Config.Host, err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +88,10 @@ func (c *DatabricksClient) WorkspaceClient() (*databricks.WorkspaceClient, error
 	w.CurrentUser.WithImpl(&cachedMe{
 		internalImpl: internalImpl,
 	})
+	w.Clusters.WithImpl(&cachedClusters{
+		ClustersService: w.Clusters.Impl(),
+		c:               c,
+	})
 	c.cachedWorkspaceClient = w
 	return w, nil
 }
@@ -278,6 +306,11 @@ func (c *DatabricksClient) ClientForHost(ctx context.Context, url string) (*Data
 		DebugTruncateBytes:   c.Config.DebugTruncateBytes,
 		DebugHeaders:         c.Config.DebugHeaders,
 		RateLimitPerSecond:   c.Config.RateLimitPerSecond,
+		HTTPTransport:        c.Config.HTTPTransport,
+	}
+	if c.ReadOnly {
+		// clients for other hosts, like the workspaces created by databricks_mws_workspaces, are read-only as well
+		cfg.HTTPTransport = ReadOnlyTransport(cfg.HTTPTransport)
 	}
 	client, err := client.New(cfg)
 	if err != nil {
@@ -287,6 +320,7 @@ func (c *DatabricksClient) ClientForHost(ctx context.Context, url string) (*Data
 	return &DatabricksClient{
 		DatabricksClient: client,
 		commandFactory:   c.commandFactory,
+		ReadOnly:         c.ReadOnly,
 	}, nil
 }
 
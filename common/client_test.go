@@ -314,3 +314,21 @@ func TestGetJWTProperty_Authenticate_Fail(t *testing.T) {
 	assert.True(t, strings.HasPrefix(err.Error(),
 		"default auth: azure-cli: cannot get access token: This is just a failing script"))
 }
+
+func TestClientForHostReadOnly(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		DatabricksClient: &client.DatabricksClient{
+			Config: &config.Config{
+				Host:     "https://accounts.cloud.databricks.com/",
+				Username: "abc",
+				Password: "bcd",
+			},
+		},
+		ReadOnly: true,
+	})
+	assert.NoError(t, err)
+	cc, err := dc.ClientForHost(context.Background(), "https://e2-workspace.cloud.databricks.com/")
+	assert.NoError(t, err)
+	assert.True(t, cc.ReadOnly)
+	assert.IsType(t, readOnlyTransport{}, cc.Config.HTTPTransport)
+}
@@ -55,4 +55,11 @@ func addContextToResource(name string, r *schema.Resource) {
 	if r.DeleteContext != nil {
 		r.DeleteContext = addName(op(r.DeleteContext))
 	}
+	if r.CustomizeDiff != nil {
+		customizeDiff := r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m any) error {
+			ctx = useragent.InContext(ctx, "resource", name)
+			return customizeDiff(context.WithValue(ctx, ResourceName, name), d, m)
+		}
+	}
 }
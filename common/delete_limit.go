@@ -0,0 +1,103 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deleteLimitServer fails the plan, once it deletes or replaces more than `max_deletes` resources, so that
+// nothing is deleted. It guards against wiping a workspace because of a corrupted state or a misconfigured
+// provider alias. Terraform plans each resource separately, so planned deletions are counted per provider
+// process, which is a single plan of a single provider configuration.
+type deleteLimitServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+
+	mu      sync.Mutex
+	planned int
+}
+
+// NewDeleteLimitServer returns the gRPC server of the provider, that asks Terraform to plan deletions of
+// resources and enforces `max_deletes` on them
+func NewDeleteLimitServer(p *schema.Provider) tfprotov5.ProviderServer {
+	return &deleteLimitServer{
+		ProviderServer: schema.NewGRPCProviderServer(p),
+		provider:       p,
+	}
+}
+
+// withPlanDestroy makes Terraform call PlanResourceChange for resources, that are going to be deleted
+func withPlanDestroy(c *tfprotov5.ServerCapabilities) *tfprotov5.ServerCapabilities {
+	if c == nil {
+		c = &tfprotov5.ServerCapabilities{}
+	}
+	c.PlanDestroy = true
+	return c
+}
+
+func (s *deleteLimitServer) GetMetadata(ctx context.Context,
+	req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.ProviderServer.GetMetadata(ctx, req)
+	if resp != nil {
+		resp.ServerCapabilities = withPlanDestroy(resp.ServerCapabilities)
+	}
+	return resp, err
+}
+
+func (s *deleteLimitServer) GetProviderSchema(ctx context.Context,
+	req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if resp != nil {
+		resp.ServerCapabilities = withPlanDestroy(resp.ServerCapabilities)
+	}
+	return resp, err
+}
+
+// plansDeletion checks if the resource is going to be deleted, either on its own or as part of replacement
+func plansDeletion(req *tfprotov5.PlanResourceChangeRequest, resp *tfprotov5.PlanResourceChangeResponse) bool {
+	if req.PriorState == nil {
+		return false
+	}
+	if created, err := req.PriorState.IsNull(); err != nil || created {
+		return false
+	}
+	if req.ProposedNewState == nil {
+		return true
+	}
+	if deleted, err := req.ProposedNewState.IsNull(); err == nil && deleted {
+		return true
+	}
+	return len(resp.RequiresReplace) > 0
+}
+
+func (s *deleteLimitServer) PlanResourceChange(ctx context.Context,
+	req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	c, ok := s.provider.Meta().(*DatabricksClient)
+	if !ok || c == nil || c.MaxDeletes <= 0 || !plansDeletion(req, resp) {
+		return resp, nil
+	}
+	s.mu.Lock()
+	s.planned++
+	planned := s.planned
+	s.mu.Unlock()
+	if planned != c.MaxDeletes+1 {
+		// report the limit only once, one error is enough to fail the plan
+		return resp, nil
+	}
+	resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  fmt.Sprintf("plan deletes more than max_deletes = %d resources", c.MaxDeletes),
+		Detail: fmt.Sprintf("Deletion of %s exceeds the limit, so nothing was deleted. Check the plan for "+
+			"unexpected deletions or replacements, e.g. because of a wrong provider alias. If the deletions "+
+			"are intended, increase max_deletes.", req.TypeName),
+	})
+	return resp, nil
+}
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var deleteLimitType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+	},
+}
+
+func deleteLimitValue(t *testing.T, name string) *tfprotov5.DynamicValue {
+	v := tftypes.NewValue(deleteLimitType, nil)
+	if name != "" {
+		v = tftypes.NewValue(deleteLimitType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, name),
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	dv, err := tfprotov5.NewDynamicValue(deleteLimitType, v)
+	require.NoError(t, err)
+	return &dv
+}
+
+func deleteLimitFixture(t *testing.T, maxDeletes int) tfprotov5.ProviderServer {
+	ok := func(ctx context.Context, rd *schema.ResourceData, i any) diag.Diagnostics {
+		return nil
+	}
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"foo_bar": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+				},
+				CreateContext: ok,
+				ReadContext:   ok,
+				DeleteContext: ok,
+			},
+		},
+	}
+	c := clientWithHost("https://adb-123.4.azuredatabricks.net")
+	c.MaxDeletes = maxDeletes
+	p.SetMeta(c)
+	return NewDeleteLimitServer(p)
+}
+
+func planErrors(t *testing.T, s tfprotov5.ProviderServer, prior, proposed string) (errors []string) {
+	resp, err := s.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "foo_bar",
+		PriorState:       deleteLimitValue(t, prior),
+		ProposedNewState: deleteLimitValue(t, proposed),
+		Config:           deleteLimitValue(t, proposed),
+	})
+	require.NoError(t, err)
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			errors = append(errors, d.Summary)
+		}
+	}
+	return errors
+}
+
+func TestDeleteLimitServer_PlanDestroy(t *testing.T) {
+	s := deleteLimitFixture(t, 2)
+	resp, err := s.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.ServerCapabilities.PlanDestroy)
+	metadata, err := s.GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+	require.NoError(t, err)
+	assert.True(t, metadata.ServerCapabilities.PlanDestroy)
+}
+
+func TestDeleteLimitServer(t *testing.T) {
+	s := deleteLimitFixture(t, 2)
+	assert.Empty(t, planErrors(t, s, "", "a"), "creation")
+	assert.Empty(t, planErrors(t, s, "a", ""), "first deletion")
+	assert.Empty(t, planErrors(t, s, "b", ""), "second deletion")
+	assert.Equal(t, []string{"plan deletes more than max_deletes = 2 resources"},
+		planErrors(t, s, "c", ""))
+}
+
+func TestDeleteLimitServer_Replacement(t *testing.T) {
+	s := deleteLimitFixture(t, 1)
+	assert.Empty(t, planErrors(t, s, "a", ""))
+	assert.Equal(t, []string{"plan deletes more than max_deletes = 1 resources"},
+		planErrors(t, s, "b", "c"))
+}
+
+func TestDeleteLimitServer_Unlimited(t *testing.T) {
+	s := deleteLimitFixture(t, 0)
+	for i := 0; i < 10; i++ {
+		assert.Empty(t, planErrors(t, s, "a", ""))
+	}
+}
@@ -0,0 +1,152 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accountsHosts maps DNS zones of workspaces to the accounts console hosts of the same cloud,
+// including government and sovereign clouds
+var accountsHosts = []struct {
+	dnsZone      string
+	accountsHost string
+}{
+	{".azuredatabricks.net", "https://accounts.azuredatabricks.net"},
+	{".databricks.azure.us", "https://accounts.databricks.azure.us"},
+	{".databricks.azure.cn", "https://accounts.databricks.azure.cn"},
+	{".gcp.databricks.com", "https://accounts.gcp.databricks.com"},
+	{".cloud.databricks.us", "https://accounts.cloud.databricks.us"},
+	{".cloud.databricks.mil", "https://accounts-dod.cloud.databricks.mil"},
+}
+
+// azureAccountsHosts maps values of `azure_environment` to the accounts console hosts
+var azureAccountsHosts = map[string]string{
+	"PUBLIC":       "https://accounts.azuredatabricks.net",
+	"USGOVERNMENT": "https://accounts.databricks.azure.us",
+	"CHINA":        "https://accounts.databricks.azure.cn",
+}
+
+// AzureEnvironments returns the supported values of `azure_environment`
+func AzureEnvironments() []string {
+	return []string{"PUBLIC", "USGOVERNMENT", "CHINA"}
+}
+
+// AzureAccountsHost returns the accounts console host of the Azure environment
+func AzureAccountsHost(azureEnvironment string) string {
+	return azureAccountsHosts[strings.ToUpper(azureEnvironment)]
+}
+
+// accountsHostFor returns the accounts console host of the cloud, where given workspace is
+func accountsHostFor(host string) string {
+	for _, v := range accountsHosts {
+		if strings.Contains(host, v.dnsZone) {
+			return v.accountsHost
+		}
+	}
+	return "https://accounts.cloud.databricks.com"
+}
+
+// isAccountsHost checks if the host is one of the accounts consoles
+func isAccountsHost(host string) bool {
+	return strings.HasPrefix(host, "https://accounts.") || strings.HasPrefix(host, "https://accounts-dod.")
+}
+
+// isWorkspaceHost checks if the host is recognisably a workspace of one of the clouds. Hosts behind
+// custom domains or proxies are not checked, as the provider cannot tell what is behind them.
+func isWorkspaceHost(host string) bool {
+	if isAccountsHost(host) {
+		return false
+	}
+	if strings.Contains(host, ".cloud.databricks.com") {
+		return true
+	}
+	for _, v := range accountsHosts {
+		if strings.Contains(host, v.dnsZone) {
+			return true
+		}
+	}
+	return false
+}
+
+// requireHostLevel returns an error, if provider is configured with a workspace host for an account-level
+// resource or data source, or with the accounts host for a workspace-level one. Otherwise, users would get
+// generic 404 errors.
+func (r Resource) requireHostLevel(ctx context.Context, m any) error {
+	c, ok := m.(*DatabricksClient)
+	if !ok || c == nil || c.DatabricksClient == nil || c.Config == nil || c.Config.Host == "" {
+		return nil
+	}
+	kind := "resource"
+	if IsData.GetOrUnknown(ctx) == "yes" {
+		kind = "data source"
+	}
+	name := "this"
+	if v := ResourceName.GetOrUnknown(ctx); v != "unknown" {
+		name = "databricks_" + v
+	}
+	host := c.Config.Host
+	if r.AccountLevel && isWorkspaceHost(host) {
+		return fmt.Errorf("%s is an account-level %s and requires a provider configured "+
+			"with the accounts host (%s) and account_id, but host is %s",
+			name, kind, accountsHostFor(host), host)
+	}
+	if r.WorkspaceLevel && isAccountsHost(host) {
+		return fmt.Errorf("%s is a workspace-level %s and requires a provider configured "+
+			"with a workspace host, but host is the accounts console %s", name, kind, host)
+	}
+	return nil
+}
+
+// workspaceHostRequired explains, why workspace-level API cannot be used with the accounts host
+func workspaceHostRequired(host string, err error) error {
+	return fmt.Errorf("workspace-level resources and data sources require a provider "+
+		"configured with a workspace host, but host is the accounts console %s: %w", host, err)
+}
+
+// withHostLevelChecks makes CRUD operations of account-level and workspace-level resources fail with
+// a precise message, when the provider is configured with a host of another level
+func (r Resource) withHostLevelChecks() Resource {
+	if !r.AccountLevel && !r.WorkspaceLevel {
+		return r
+	}
+	wrap := func(f func(context.Context, *schema.ResourceData, *DatabricksClient) error) func(
+		context.Context, *schema.ResourceData, *DatabricksClient) error {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			if err := r.requireHostLevel(ctx, c); err != nil {
+				return err
+			}
+			return f(ctx, d, c)
+		}
+	}
+	checked := r
+	checked.Create = wrap(r.Create)
+	checked.Read = wrap(r.Read)
+	checked.Update = wrap(r.Update)
+	checked.Delete = wrap(r.Delete)
+	return checked
+}
+
+// customizeDiff checks the host level of the provider during the plan, before the CustomizeDiff
+// of the resource
+func (r Resource) customizeDiff() schema.CustomizeDiffFunc {
+	customizeDiff := r.saferCustomizeDiff()
+	isResource := r.Create != nil || r.Update != nil || r.Delete != nil
+	if !isResource || (!r.AccountLevel && !r.WorkspaceLevel) {
+		return customizeDiff
+	}
+	return func(ctx context.Context, d *schema.ResourceDiff, m any) error {
+		if err := r.requireHostLevel(ctx, m); err != nil {
+			return err
+		}
+		if customizeDiff == nil {
+			return nil
+		}
+		return customizeDiff(ctx, d, m)
+	}
+}
@@ -0,0 +1,152 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/client"
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clientWithHost(host string) *DatabricksClient {
+	return &DatabricksClient{
+		DatabricksClient: &client.DatabricksClient{
+			Config: &config.Config{
+				Host:  host,
+				Token: "x",
+			},
+		},
+	}
+}
+
+func hostLevelProvider() *schema.Provider {
+	ok := func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+		return nil
+	}
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"databricks_account_thing": Resource{
+				AccountLevel: true,
+				Create:       ok,
+				Read:         ok,
+				Update:       ok,
+				Delete:       ok,
+			}.ToResource(),
+			"databricks_workspace_thing": Resource{
+				WorkspaceLevel: true,
+				Create:         ok,
+				Read:           ok,
+				Delete:         ok,
+			}.ToResource(),
+			"databricks_any_thing": Resource{
+				Read: ok,
+			}.ToResource(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"databricks_account_things": Resource{
+				AccountLevel: true,
+				Read:         ok,
+			}.ToResource(),
+		},
+	}
+	AddContextToAllResources(p, "databricks")
+	return p
+}
+
+func TestHostLevelChecks_WorkspaceHost(t *testing.T) {
+	p := hostLevelProvider()
+	c := clientWithHost("https://adb-123.4.azuredatabricks.net")
+	ctx := context.Background()
+
+	err := p.ResourcesMap["databricks_account_thing"].CustomizeDiff(ctx, nil, c)
+	assert.EqualError(t, err, "databricks_account_thing is an account-level resource and requires a provider "+
+		"configured with the accounts host (https://accounts.azuredatabricks.net) and account_id, "+
+		"but host is https://adb-123.4.azuredatabricks.net")
+
+	diags := p.ResourcesMap["databricks_account_thing"].CreateContext(ctx, nil, c)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "databricks_account_thing is an account-level resource")
+
+	diags = p.DataSourcesMap["databricks_account_things"].ReadContext(ctx, nil, c)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "databricks_account_things is an account-level data source")
+
+	assert.NoError(t, p.ResourcesMap["databricks_workspace_thing"].CustomizeDiff(ctx, nil, c))
+	assert.Nil(t, p.ResourcesMap["databricks_any_thing"].CustomizeDiff)
+}
+
+func TestHostLevelChecks_AccountHost(t *testing.T) {
+	p := hostLevelProvider()
+	c := clientWithHost("https://accounts.cloud.databricks.com")
+	ctx := context.Background()
+
+	for _, diags := range []diag.Diagnostics{
+		p.ResourcesMap["databricks_account_thing"].CreateContext(ctx, nil, c),
+		p.ResourcesMap["databricks_account_thing"].UpdateContext(ctx, nil, c),
+		p.ResourcesMap["databricks_account_thing"].DeleteContext(ctx, nil, c),
+		p.DataSourcesMap["databricks_account_things"].ReadContext(ctx, nil, c),
+	} {
+		assert.False(t, diags.HasError())
+	}
+	assert.NoError(t, p.ResourcesMap["databricks_account_thing"].CustomizeDiff(ctx, nil, c))
+
+	err := p.ResourcesMap["databricks_workspace_thing"].CustomizeDiff(ctx, nil, c)
+	assert.EqualError(t, err, "databricks_workspace_thing is a workspace-level resource and requires a provider "+
+		"configured with a workspace host, but host is the accounts console https://accounts.cloud.databricks.com")
+
+	diags := p.ResourcesMap["databricks_workspace_thing"].DeleteContext(ctx, nil, c)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "databricks_workspace_thing is a workspace-level resource")
+}
+
+func TestHostLevelChecks_CustomHost(t *testing.T) {
+	p := hostLevelProvider()
+	c := clientWithHost("https://databricks.mycorp.io")
+	ctx := context.Background()
+	assert.NoError(t, p.ResourcesMap["databricks_account_thing"].CustomizeDiff(ctx, nil, c))
+	assert.NoError(t, p.ResourcesMap["databricks_workspace_thing"].CustomizeDiff(ctx, nil, c))
+}
+
+func TestIsWorkspaceHost(t *testing.T) {
+	assert.True(t, isWorkspaceHost("https://dbc-1234.cloud.databricks.com"))
+	assert.True(t, isWorkspaceHost("https://adb-123.4.azuredatabricks.net"))
+	assert.True(t, isWorkspaceHost("https://dbc-1234.cloud.databricks.mil"))
+	assert.False(t, isWorkspaceHost("https://accounts.cloud.databricks.com"))
+	assert.False(t, isWorkspaceHost("https://accounts-dod.cloud.databricks.mil"))
+	assert.False(t, isWorkspaceHost("http://127.0.0.1:8080"))
+}
+
+func TestAccountsHostFor(t *testing.T) {
+	assert.Equal(t, "https://accounts.cloud.databricks.com",
+		accountsHostFor("https://dbc-1234.cloud.databricks.com"))
+	assert.Equal(t, "https://accounts.gcp.databricks.com",
+		accountsHostFor("https://123.4.gcp.databricks.com"))
+	assert.Equal(t, "https://accounts.azuredatabricks.net",
+		accountsHostFor("https://adb-123.4.azuredatabricks.net"))
+	assert.Equal(t, "https://accounts.databricks.azure.us",
+		accountsHostFor("https://adb-123.4.databricks.azure.us"))
+	assert.Equal(t, "https://accounts.databricks.azure.cn",
+		accountsHostFor("https://adb-123.4.databricks.azure.cn"))
+	assert.Equal(t, "https://accounts.cloud.databricks.us",
+		accountsHostFor("https://dbc-1234.cloud.databricks.us"))
+	assert.Equal(t, "https://accounts-dod.cloud.databricks.mil",
+		accountsHostFor("https://dbc-1234.cloud.databricks.mil"))
+}
+
+func TestAzureAccountsHost(t *testing.T) {
+	assert.Equal(t, "https://accounts.databricks.azure.us", AzureAccountsHost("usgovernment"))
+	assert.Equal(t, "https://accounts.databricks.azure.cn", AzureAccountsHost("CHINA"))
+	assert.Equal(t, "", AzureAccountsHost("GERMAN"))
+}
+
+func TestWorkspaceClientWithAccountHost(t *testing.T) {
+	c := clientWithHost("https://accounts.cloud.databricks.com")
+	_, err := c.WorkspaceClient()
+	assert.EqualError(t, err, "workspace-level resources and data sources require a provider "+
+		"configured with a workspace host, but host is the accounts console "+
+		"https://accounts.cloud.databricks.com: invalid Databricks Workspace configuration")
+}
@@ -0,0 +1,137 @@
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/service/compute"
+)
+
+// lookupEntry holds the JSON of the lookup result. Concurrent lookups of the same key wait for the
+// first one, instead of calling the API in parallel.
+type lookupEntry struct {
+	mu    sync.Mutex
+	value []byte
+}
+
+// CachedLookup returns the result of a lookup, that doesn't change during a run, like the list of Spark
+// versions or zones, so that configurations, that use the same data source many times, call the API only
+// once per provider instance. With LookupCacheTTL, results are also kept on disk and shared between runs.
+// Errors aren't cached. Every caller gets its own copy of the result, so it can be modified in place.
+func CachedLookup[T any](c *DatabricksClient, key string, fetch func() (T, error)) (result T, err error) {
+	raw, err := c.lookup(key, func() ([]byte, error) {
+		v, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(v)
+	})
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(raw, &result)
+	return
+}
+
+func (c *DatabricksClient) lookup(key string, fetch func() ([]byte, error)) ([]byte, error) {
+	c.mu.Lock()
+	if c.lookups == nil {
+		c.lookups = map[string]*lookupEntry{}
+	}
+	e, ok := c.lookups[key]
+	if !ok {
+		e = &lookupEntry{}
+		c.lookups[key] = e
+	}
+	c.mu.Unlock()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.value != nil {
+		return e.value, nil
+	}
+	if v, ok := c.readLookupFile(key); ok {
+		e.value = v
+		return v, nil
+	}
+	v, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	e.value = v
+	c.writeLookupFile(key, v)
+	return v, nil
+}
+
+// lookupFile returns the path of the lookup result on disk, that is specific to the host of the workspace
+func (c *DatabricksClient) lookupFile(key string) string {
+	if c.LookupCacheTTL <= 0 {
+		return ""
+	}
+	dir := c.lookupCacheDir
+	if dir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			log.Printf("[DEBUG] Not caching lookups on disk: %s", err)
+			return ""
+		}
+		dir = filepath.Join(userCacheDir, "terraform-provider-databricks", "lookups")
+	}
+	host := ""
+	if c.DatabricksClient != nil {
+		host = c.DatabricksClient.Config.Host
+	}
+	sum := sha256.Sum256([]byte(host + "|" + key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *DatabricksClient) readLookupFile(key string) ([]byte, bool) {
+	path := c.lookupFile(key)
+	if path == "" {
+		return nil, false
+	}
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > c.LookupCacheTTL {
+		return nil, false
+	}
+	v, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("[DEBUG] Cannot read cached %s: %s", key, err)
+		return nil, false
+	}
+	log.Printf("[DEBUG] Using %s cached in %s", key, path)
+	return v, true
+}
+
+// writeLookupFile keeps the result on disk. Failures only mean that the next run calls the API again
+func (c *DatabricksClient) writeLookupFile(key string, v []byte) {
+	path := c.lookupFile(key)
+	if path == "" {
+		return
+	}
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err == nil {
+		err = os.WriteFile(path, v, 0o600)
+	}
+	if err != nil {
+		log.Printf("[WARN] Cannot cache %s in %s: %s", key, path, err)
+	}
+}
+
+// cachedClusters caches the list of node types, that is used by every databricks_node_type data source
+type cachedClusters struct {
+	compute.ClustersService
+	c *DatabricksClient
+}
+
+func (a *cachedClusters) ListNodeTypes(ctx context.Context) (*compute.ListNodeTypesResponse, error) {
+	return CachedLookup(a.c, "node-types", func() (*compute.ListNodeTypesResponse, error) {
+		return a.ClustersService.ListNodeTypes(ctx)
+	})
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type zones struct {
+	Zones []string `json:"zones"`
+}
+
+func TestCachedLookup(t *testing.T) {
+	c := clientWithHost("https://adb-123.4.azuredatabricks.net")
+	calls := 0
+	fetch := func() (zones, error) {
+		calls++
+		return zones{Zones: []string{"a", "b"}}, nil
+	}
+	first, err := CachedLookup(c, "zones", fetch)
+	require.NoError(t, err)
+	first.Zones[0] = "changed"
+
+	second, err := CachedLookup(c, "zones", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, second.Zones)
+	assert.Equal(t, 1, calls)
+}
+
+func TestCachedLookup_ErrorsAreNotCached(t *testing.T) {
+	c := clientWithHost("https://adb-123.4.azuredatabricks.net")
+	calls := 0
+	fetch := func() (zones, error) {
+		calls++
+		if calls == 1 {
+			return zones{}, fmt.Errorf("nope")
+		}
+		return zones{Zones: []string{"a"}}, nil
+	}
+	_, err := CachedLookup(c, "zones", fetch)
+	assert.EqualError(t, err, "nope")
+
+	result, err := CachedLookup(c, "zones", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, result.Zones)
+	assert.Equal(t, 2, calls)
+}
+
+func TestCachedLookup_OnDisk(t *testing.T) {
+	dir := t.TempDir()
+	newClient := func(host string) *DatabricksClient {
+		c := clientWithHost(host)
+		c.LookupCacheTTL = time.Hour
+		c.lookupCacheDir = dir
+		return c
+	}
+	calls := 0
+	fetch := func() (zones, error) {
+		calls++
+		return zones{Zones: []string{fmt.Sprintf("call-%d", calls)}}, nil
+	}
+	_, err := CachedLookup(newClient("https://a.cloud.databricks.com"), "zones", fetch)
+	require.NoError(t, err)
+
+	// the next run reuses the result of the same workspace
+	c := newClient("https://a.cloud.databricks.com")
+	result, err := CachedLookup(c, "zones", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"call-1"}, result.Zones)
+
+	// but not the one of another workspace
+	result, err = CachedLookup(newClient("https://b.cloud.databricks.com"), "zones", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"call-2"}, result.Zones)
+
+	// and not an expired one
+	expired := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(c.lookupFile("zones"), expired, expired))
+	result, err = CachedLookup(newClient("https://a.cloud.databricks.com"), "zones", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"call-3"}, result.Zones)
+}
+
+func TestCachedLookup_NotOnDiskWithoutTTL(t *testing.T) {
+	c := clientWithHost("https://adb-123.4.azuredatabricks.net")
+	c.lookupCacheDir = t.TempDir()
+	_, err := CachedLookup(c, "zones", func() (zones, error) {
+		return zones{}, nil
+	})
+	require.NoError(t, err)
+	entries, err := os.ReadDir(c.lookupCacheDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+}
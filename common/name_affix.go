@@ -0,0 +1,77 @@
+package common
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AffixName adds the configured name prefix and suffix to the name of an object
+func (c *DatabricksClient) AffixName(name string) string {
+	if name == "" {
+		return name
+	}
+	return c.NamePrefix + name + c.NameSuffix
+}
+
+// StripNameAffix removes the configured name prefix and suffix from the name of an object. Names without
+// them are returned as is, so that objects renamed outside of Terraform show up as a drift.
+func (c *DatabricksClient) StripNameAffix(name string) string {
+	if !strings.HasPrefix(name, c.NamePrefix) || !strings.HasSuffix(name, c.NameSuffix) ||
+		len(name) <= len(c.NamePrefix)+len(c.NameSuffix) {
+		return name
+	}
+	return name[len(c.NamePrefix) : len(name)-len(c.NameSuffix)]
+}
+
+// AddNameAffixes makes resources create objects with the name prefix and suffix from the provider
+// configuration. Keys of fields are resource types and values are names of their name attributes.
+// The state keeps names without the affixes, so that the configuration doesn't drift from it, and
+// the name of the object in Databricks is available in the computed `effective_name` attribute.
+func AddNameAffixes(p *schema.Provider, fields map[string]string) {
+	for k, field := range fields {
+		r, ok := p.ResourcesMap[k]
+		if !ok {
+			continue
+		}
+		r.Schema["effective_name"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(op(r.CreateContext).withNameAffix(field, true))
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(op(r.ReadContext).withNameAffix(field, false))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(op(r.UpdateContext).withNameAffix(field, true))
+		}
+	}
+}
+
+// wrap operation invokations with adding the name affixes before and stripping them after the call
+func (f op) withNameAffix(field string, affix bool) op {
+	return func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		c, ok := m.(*DatabricksClient)
+		if !ok || c == nil {
+			return f(ctx, d, m)
+		}
+		if affix {
+			if err := d.Set(field, c.AffixName(d.Get(field).(string))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		diags := f(ctx, d, m)
+		name := d.Get(field).(string)
+		if err := d.Set("effective_name", name); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if err := d.Set(field, c.StripNameAffix(name)); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
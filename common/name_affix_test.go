@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func nameAffixProvider(seen *[]string) *schema.Provider {
+	record := func(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+		*seen = append(*seen, d.Get("name").(string))
+		return nil
+	}
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"foo_bar": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+				CreateContext: record,
+				ReadContext: func(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+					// the API returns the name of the object, as it was created
+					d.Set("name", "dev-abc-x")
+					return nil
+				},
+				UpdateContext: record,
+			},
+		},
+	}
+}
+
+func TestStripNameAffix(t *testing.T) {
+	c := &DatabricksClient{NamePrefix: "dev-", NameSuffix: "-x"}
+	assert.Equal(t, "dev-abc-x", c.AffixName("abc"))
+	assert.Equal(t, "", c.AffixName(""))
+	assert.Equal(t, "abc", c.StripNameAffix("dev-abc-x"))
+	assert.Equal(t, "dev-abc", c.StripNameAffix("dev-abc"))
+	assert.Equal(t, "renamed", c.StripNameAffix("renamed"))
+	assert.Equal(t, "dev--x", c.StripNameAffix("dev--x"))
+}
+
+func TestAddNameAffixes(t *testing.T) {
+	seen := []string{}
+	p := nameAffixProvider(&seen)
+	AddNameAffixes(p, map[string]string{"foo_bar": "name", "missing": "name"})
+	c := &DatabricksClient{NamePrefix: "dev-", NameSuffix: "-x"}
+	ctx := context.Background()
+	r := p.ResourcesMap["foo_bar"]
+	d := r.TestResourceData()
+	d.Set("name", "abc")
+
+	require.False(t, r.CreateContext(ctx, d, c).HasError())
+	assert.Equal(t, "abc", d.Get("name"))
+	require.False(t, r.UpdateContext(ctx, d, c).HasError())
+	assert.Equal(t, "abc", d.Get("name"))
+	assert.Equal(t, []string{"dev-abc-x", "dev-abc-x"}, seen)
+
+	require.False(t, r.ReadContext(ctx, d, c).HasError())
+	assert.Equal(t, "abc", d.Get("name"))
+	assert.Equal(t, "dev-abc-x", d.Get("effective_name"))
+}
+
+func TestAddNameAffixes_NotConfigured(t *testing.T) {
+	seen := []string{}
+	p := nameAffixProvider(&seen)
+	AddNameAffixes(p, map[string]string{"foo_bar": "name"})
+	r := p.ResourcesMap["foo_bar"]
+	d := r.TestResourceData()
+	d.Set("name", "abc")
+
+	require.False(t, r.CreateContext(context.Background(), d, &DatabricksClient{}).HasError())
+	assert.Equal(t, []string{"abc"}, seen)
+	require.False(t, r.ReadContext(context.Background(), d, &DatabricksClient{}).HasError())
+	assert.Equal(t, "dev-abc-x", d.Get("name"))
+	assert.Equal(t, "dev-abc-x", d.Get("effective_name"))
+}
@@ -0,0 +1,86 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AddReadOnlyChecks makes create, update and delete of all resources fail without calling
+// any API, when the provider is configured with `read_only = true`. Reading resources and
+// data sources isn't affected, so that plans of audit pipelines work as usual. Reads that
+// change something are blocked by ReadOnlyTransport.
+func AddReadOnlyChecks(p *schema.Provider) {
+	for k, r := range p.ResourcesMap {
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(op(r.CreateContext).denyInReadOnlyMode("create", k))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(op(r.UpdateContext).denyInReadOnlyMode("update", k))
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = schema.DeleteContextFunc(op(r.DeleteContext).denyInReadOnlyMode("delete", k))
+		}
+	}
+}
+
+// wrap operation invokations with read-only mode check
+func (f op) denyInReadOnlyMode(action, name string) op {
+	return func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		c, ok := m.(*DatabricksClient)
+		if !ok || c == nil || !c.ReadOnly {
+			return f(ctx, d, m)
+		}
+		id := ""
+		if d != nil {
+			id = d.Id()
+		}
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("cannot %s %s: provider is in read-only mode", action, name),
+				Detail: fmt.Sprintf("The provider is configured with read_only = true, so it doesn't make any "+
+					"changes. The %s of %s[id=%s] was blocked before calling the API. "+
+					"Remove read_only from the provider configuration to apply changes.", action, name, id),
+			},
+		}
+	}
+}
+
+// readOnlyPostRequests are API calls, that use POST only to pass the query in the request body
+var readOnlyPostRequests = map[string]bool{
+	"/api/2.0/clusters/events": true,
+}
+
+// ReadOnlyTransport rejects all API requests, that may change something in Databricks, before they are
+// sent. Only GET and HEAD requests are allowed, so that even refreshes, that would start clusters or
+// run commands, don't have side effects. Requests outside of the REST API, like getting OAuth tokens,
+// aren't affected.
+func ReadOnlyTransport(next http.RoundTripper) http.RoundTripper {
+	if t, ok := next.(readOnlyTransport); ok {
+		return t
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return readOnlyTransport{next}
+}
+
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func (t readOnlyTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	switch {
+	case r.Method == http.MethodGet, r.Method == http.MethodHead:
+	case !strings.HasPrefix(r.URL.Path, "/api/"):
+	case r.Method == http.MethodPost && readOnlyPostRequests[r.URL.Path]:
+	default:
+		return nil, fmt.Errorf("%s %s is blocked, because the provider is in read-only mode", r.Method, r.URL.Path)
+	}
+	return t.next.RoundTrip(r)
+}
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readOnlyProvider(calls *int) *schema.Provider {
+	ok := func(ctx context.Context, rd *schema.ResourceData, i any) diag.Diagnostics {
+		*calls++
+		return nil
+	}
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"foo_bar": {
+				CreateContext: ok,
+				ReadContext:   ok,
+				UpdateContext: ok,
+				DeleteContext: ok,
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"foo_bars": {
+				ReadContext: ok,
+			},
+		},
+	}
+}
+
+func TestAddReadOnlyChecks_ReadOnly(t *testing.T) {
+	calls := 0
+	p := readOnlyProvider(&calls)
+	AddReadOnlyChecks(p)
+	c := clientWithHost("https://adb-123.4.azuredatabricks.net")
+	c.ReadOnly = true
+	ctx := context.Background()
+	r := p.ResourcesMap["foo_bar"]
+
+	for action, diags := range map[string]diag.Diagnostics{
+		"create": r.CreateContext(ctx, nil, c),
+		"update": r.UpdateContext(ctx, nil, c),
+		"delete": r.DeleteContext(ctx, nil, c),
+	} {
+		require.True(t, diags.HasError())
+		assert.Equal(t, "cannot "+action+" foo_bar: provider is in read-only mode", diags[0].Summary)
+	}
+	assert.Equal(t, 0, calls)
+
+	assert.False(t, r.ReadContext(ctx, nil, c).HasError())
+	assert.False(t, p.DataSourcesMap["foo_bars"].ReadContext(ctx, nil, c).HasError())
+	assert.Equal(t, 2, calls)
+}
+
+func TestAddReadOnlyChecks_Writable(t *testing.T) {
+	calls := 0
+	p := readOnlyProvider(&calls)
+	AddReadOnlyChecks(p)
+	c := clientWithHost("https://adb-123.4.azuredatabricks.net")
+	ctx := context.Background()
+	r := p.ResourcesMap["foo_bar"]
+
+	for _, diags := range []diag.Diagnostics{
+		r.CreateContext(ctx, nil, c),
+		r.UpdateContext(ctx, nil, c),
+		r.DeleteContext(ctx, nil, c),
+	} {
+		assert.False(t, diags.HasError())
+	}
+	assert.Equal(t, 3, calls)
+}
+
+type roundTripFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	sent := 0
+	transport := ReadOnlyTransport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: 200}, nil
+	}))
+	for _, tc := range []struct {
+		method, path string
+		allowed      bool
+	}{
+		{"GET", "/api/2.0/clusters/get", true},
+		{"HEAD", "/api/2.0/workspace/export", true},
+		{"POST", "/api/2.0/clusters/events", true},
+		{"POST", "/oidc/v1/token", true},
+		{"POST", "/api/2.0/clusters/start", false},
+		{"PATCH", "/api/2.0/preview/scim/v2/Users/abc", false},
+		{"DELETE", "/api/2.0/jobs/delete", false},
+	} {
+		sent = 0
+		r, err := http.NewRequest(tc.method, "https://localhost"+tc.path, nil)
+		require.NoError(t, err)
+		_, err = transport.RoundTrip(r)
+		if tc.allowed {
+			assert.NoError(t, err, tc.path)
+			assert.Equal(t, 1, sent, tc.path)
+		} else {
+			assert.EqualError(t, err, tc.method+" "+tc.path+" is blocked, because the provider is in read-only mode")
+			assert.Equal(t, 0, sent, tc.path)
+		}
+	}
+}
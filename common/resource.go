@@ -27,6 +27,12 @@ type Resource struct {
 	Timeouts           *schema.ResourceTimeout
 	DeprecationMessage string
 	Importer           *schema.ResourceImporter
+
+	// AccountLevel resources and data sources fail during the plan, if the provider is configured with
+	// a workspace host. WorkspaceLevel ones fail, if it's configured with the accounts host. Resources,
+	// that work at both levels, set neither.
+	AccountLevel   bool
+	WorkspaceLevel bool
 }
 
 func nicerError(ctx context.Context, err error, action string) error {
@@ -84,6 +90,7 @@ func (r Resource) saferCustomizeDiff() schema.CustomizeDiffFunc {
 
 // ToResource converts to Terraform resource definition
 func (r Resource) ToResource() *schema.Resource {
+	r = r.withHostLevelChecks()
 	var update func(ctx context.Context, d *schema.ResourceData,
 		m any) diag.Diagnostics
 	if r.Update != nil {
@@ -147,7 +154,7 @@ func (r Resource) ToResource() *schema.Resource {
 		Schema:             r.Schema,
 		SchemaVersion:      r.SchemaVersion,
 		StateUpgraders:     r.StateUpgraders,
-		CustomizeDiff:      r.saferCustomizeDiff(),
+		CustomizeDiff:      r.customizeDiff(),
 		ReadContext:        generateReadFunc(ignoreMissingForRead),
 		UpdateContext:      update,
 		Importer:           r.Importer,
@@ -249,9 +256,11 @@ func DataResource(sc any, read func(context.Context, any, *DatabricksClient) err
 //		...
 //	})
 func WorkspaceData[T any](read func(context.Context, *T, *databricks.WorkspaceClient) error) Resource {
-	return genericDatabricksData((*DatabricksClient).WorkspaceClient, func(ctx context.Context, s struct{}, t *T, wc *databricks.WorkspaceClient) error {
+	r := genericDatabricksData((*DatabricksClient).WorkspaceClient, func(ctx context.Context, s struct{}, t *T, wc *databricks.WorkspaceClient) error {
 		return read(ctx, t, wc)
 	}, false)
+	r.WorkspaceLevel = true
+	return r
 }
 
 // WorkspaceDataWithParams defines a data source that can be used to read data from the workspace API.
@@ -286,7 +295,7 @@ func WorkspaceData[T any](read func(context.Context, *T, *databricks.WorkspaceCl
 //	         ...
 //	     })
 func WorkspaceDataWithParams[T, P any](read func(context.Context, P, *databricks.WorkspaceClient) (*T, error)) Resource {
-	return genericDatabricksData((*DatabricksClient).WorkspaceClient, func(ctx context.Context, o P, s *T, w *databricks.WorkspaceClient) error {
+	r := genericDatabricksData((*DatabricksClient).WorkspaceClient, func(ctx context.Context, o P, s *T, w *databricks.WorkspaceClient) error {
 		res, err := read(ctx, o, w)
 		if err != nil {
 			return err
@@ -294,6 +303,8 @@ func WorkspaceDataWithParams[T, P any](read func(context.Context, P, *databricks
 		*s = *res
 		return nil
 	}, true)
+	r.WorkspaceLevel = true
+	return r
 }
 
 // AccountData is a generic way to define account data resources in Terraform provider.
@@ -308,9 +319,11 @@ func WorkspaceDataWithParams[T, P any](read func(context.Context, P, *databricks
 //		...
 //	})
 func AccountData[T any](read func(context.Context, *T, *databricks.AccountClient) error) Resource {
-	return genericDatabricksData((*DatabricksClient).AccountClient, func(ctx context.Context, s struct{}, t *T, ac *databricks.AccountClient) error {
+	r := genericDatabricksData((*DatabricksClient).AccountClient, func(ctx context.Context, s struct{}, t *T, ac *databricks.AccountClient) error {
 		return read(ctx, t, ac)
 	}, false)
+	r.AccountLevel = true
+	return r
 }
 
 // AccountDataWithParams defines a data source that can be used to read data from the workspace API.
@@ -345,7 +358,7 @@ func AccountData[T any](read func(context.Context, *T, *databricks.AccountClient
 //	         ...
 //		  })
 func AccountDataWithParams[T, P any](read func(context.Context, P, *databricks.AccountClient) (*T, error)) Resource {
-	return genericDatabricksData((*DatabricksClient).AccountClient, func(ctx context.Context, o P, s *T, a *databricks.AccountClient) error {
+	r := genericDatabricksData((*DatabricksClient).AccountClient, func(ctx context.Context, o P, s *T, a *databricks.AccountClient) error {
 		res, err := read(ctx, o, a)
 		if err != nil {
 			return err
@@ -353,6 +366,8 @@ func AccountDataWithParams[T, P any](read func(context.Context, P, *databricks.A
 		*s = *res
 		return nil
 	}, true)
+	r.AccountLevel = true
+	return r
 }
 
 // genericDatabricksData is generic and common way to define both account and workspace data and calls their respective clients.
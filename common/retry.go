@@ -0,0 +1,64 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	retryMinWait = 500 * time.Millisecond
+	retryMaxWait = 10 * time.Second
+)
+
+// RetryContext calls the function until it succeeds, returns a non-retryable error or the timeout
+// expires, with the same backoff as retry.RetryContext. Unlike it, the wait is abandoned as soon as
+// the context is cancelled, e.g. with Ctrl-C or by the deadline of the resource timeout, no
+// goroutines are left polling, and the returned error has both the reason of cancellation and
+// the last error of the function, so that the real timeout error isn't masked.
+func RetryContext(ctx context.Context, timeout time.Duration, f retry.RetryFunc) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	wait := retryMinWait
+	var lastErr error
+	for {
+		if err := ctx.Err(); err != nil {
+			return cancelledRetryError(err, lastErr)
+		}
+		result := f()
+		if result == nil {
+			return nil
+		}
+		if !result.Retryable {
+			return result.Err
+		}
+		lastErr = result.Err
+		next := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			next.Stop()
+			return cancelledRetryError(ctx.Err(), lastErr)
+		case <-deadline.C:
+			next.Stop()
+			if lastErr != nil {
+				return lastErr
+			}
+			return &retry.TimeoutError{
+				LastState:     "retryableerror",
+				Timeout:       timeout,
+				ExpectedState: []string{"success"},
+			}
+		case <-next.C:
+		}
+		wait = min(wait*2, retryMaxWait)
+	}
+}
+
+func cancelledRetryError(err, lastErr error) error {
+	if lastErr == nil {
+		return err
+	}
+	return fmt.Errorf("%w, last error: %w", err, lastErr)
+}
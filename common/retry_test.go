@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryContext_Succeeds(t *testing.T) {
+	attempts := 0
+	err := RetryContext(context.Background(), time.Minute, func() *retry.RetryError {
+		attempts++
+		if attempts < 2 {
+			return retry.RetryableError(fmt.Errorf("cluster is PENDING"))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryContext_NonRetryable(t *testing.T) {
+	err := RetryContext(context.Background(), time.Minute, func() *retry.RetryError {
+		return retry.NonRetryableError(fmt.Errorf("cluster is TERMINATED"))
+	})
+	assert.EqualError(t, err, "cluster is TERMINATED")
+}
+
+func TestRetryContext_CancelledKeepsLastError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := RetryContext(ctx, time.Minute, func() *retry.RetryError {
+		cancel()
+		return retry.RetryableError(fmt.Errorf("cluster is PENDING"))
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, "cluster is PENDING")
+}
+
+func TestRetryContext_DeadlineKeepsLastError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := RetryContext(ctx, time.Hour, func() *retry.RetryError {
+		return retry.RetryableError(fmt.Errorf("warehouse is STARTING"))
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "warehouse is STARTING")
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
+
+func TestRetryContext_TimeoutReturnsLastError(t *testing.T) {
+	err := RetryContext(context.Background(), 100*time.Millisecond, func() *retry.RetryError {
+		return retry.RetryableError(fmt.Errorf("pipeline is RUNNING"))
+	})
+	assert.EqualError(t, err, "pipeline is RUNNING")
+}
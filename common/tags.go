@@ -0,0 +1,70 @@
+package common
+
+// DatabricksInjectedTags are keys of tags, that Databricks adds to custom tags of compute resources
+var DatabricksInjectedTags = []string{
+	"Vendor",
+	"Creator",
+	"ClusterName",
+	"ClusterId",
+	"DatabricksInstancePoolId",
+	"DatabricksInstancePoolCreatorId",
+	"DatabricksInstanceGroupId",
+	"SqlEndpointId",
+}
+
+func containsTag(keys []string, key string) bool {
+	for _, v := range keys {
+		if v == key {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIgnoredTag checks if the tag returned by the API isn't managed by Terraform, because it's injected
+// by Databricks or listed in the ignore_tags provider option. Tags from the configuration are never ignored.
+func (c *DatabricksClient) IsIgnoredTag(key string, configured map[string]any) bool {
+	if _, ok := configured[key]; ok {
+		return false
+	}
+	return containsTag(DatabricksInjectedTags, key) || containsTag(c.IgnoreTags, key)
+}
+
+// IsKeptTag checks if the ignored tag returned by the API has to be sent back on update, as updates replace
+// all custom tags. Only tags from the ignore_tags provider option are kept, as Databricks adds injected
+// tags by itself.
+func (c *DatabricksClient) IsKeptTag(key string, configured map[string]any) bool {
+	if _, ok := configured[key]; ok {
+		return false
+	}
+	return containsTag(c.IgnoreTags, key) && !containsTag(DatabricksInjectedTags, key)
+}
+
+// RemoveIgnoredTags returns custom tags from the API without the tags, that aren't managed by Terraform
+func (c *DatabricksClient) RemoveIgnoredTags(tags map[string]string, configured map[string]any) map[string]string {
+	if tags == nil {
+		return nil
+	}
+	managed := map[string]string{}
+	for k, v := range tags {
+		if !c.IsIgnoredTag(k, configured) {
+			managed[k] = v
+		}
+	}
+	return managed
+}
+
+// KeepIgnoredTags adds the tags from the ignore_tags provider option, that the API returned, to the custom tags
+// of an update request, as updates replace all custom tags and would otherwise remove them
+func (c *DatabricksClient) KeepIgnoredTags(tags map[string]string, remote map[string]string) map[string]string {
+	for k, v := range remote {
+		if _, ok := tags[k]; ok || !c.IsKeptTag(k, nil) {
+			continue
+		}
+		if tags == nil {
+			tags = map[string]string{}
+		}
+		tags[k] = v
+	}
+	return tags
+}
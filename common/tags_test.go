@@ -0,0 +1,63 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	c := &DatabricksClient{IgnoreTags: []string{"owner", "budget"}}
+	assert.Equal(t, map[string]string{
+		"team":   "data",
+		"budget": "1234",
+	}, c.RemoveIgnoredTags(map[string]string{
+		"team":    "data",
+		"owner":   "finance",
+		"budget":  "1234",
+		"Vendor":  "Databricks",
+		"Creator": "me@example.com",
+	}, map[string]any{
+		"team":   "data",
+		"budget": "1234",
+	}))
+	assert.Nil(t, c.RemoveIgnoredTags(nil, nil))
+}
+
+func TestIsIgnoredTag(t *testing.T) {
+	c := &DatabricksClient{IgnoreTags: []string{"owner"}}
+	assert.True(t, c.IsIgnoredTag("owner", nil))
+	assert.True(t, c.IsIgnoredTag("Vendor", nil))
+	assert.False(t, c.IsIgnoredTag("team", nil))
+	assert.False(t, c.IsIgnoredTag("owner", map[string]any{"owner": "me"}))
+	assert.False(t, c.IsIgnoredTag("Vendor", map[string]any{"Vendor": "Acme"}))
+}
+
+func TestIsKeptTag(t *testing.T) {
+	c := &DatabricksClient{IgnoreTags: []string{"owner", "Creator"}}
+	assert.True(t, c.IsKeptTag("owner", nil))
+	assert.False(t, c.IsKeptTag("owner", map[string]any{"owner": "me"}))
+	assert.False(t, c.IsKeptTag("Creator", nil))
+	assert.False(t, c.IsKeptTag("Vendor", nil))
+}
+
+func TestKeepIgnoredTags(t *testing.T) {
+	c := &DatabricksClient{IgnoreTags: []string{"owner", "budget"}}
+	assert.Equal(t, map[string]string{
+		"team":   "data",
+		"owner":  "finance",
+		"budget": "5678",
+	}, c.KeepIgnoredTags(map[string]string{
+		"team":   "data",
+		"budget": "5678",
+	}, map[string]string{
+		"team":   "ml",
+		"owner":  "finance",
+		"budget": "1234",
+		"other":  "removed",
+		"Vendor": "Databricks",
+	}))
+	assert.Equal(t, map[string]string{"owner": "finance"},
+		c.KeepIgnoredTags(nil, map[string]string{"owner": "finance"}))
+	assert.Nil(t, c.KeepIgnoredTags(nil, map[string]string{"other": "x"}))
+}
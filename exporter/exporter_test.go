@@ -626,7 +626,7 @@ func TestImportingUsersGroupsSecretScopes(t *testing.T) {
 			// TODO: add groups to the output
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/123?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/123?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Response: scim.User{ID: "123", DisplayName: "test@test.com", UserName: "test@test.com"},
 			},
 			{
@@ -1111,6 +1111,11 @@ func TestImportingJobs_JobList(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=14",
+				Response: jobs.JobRunsList{},
+			},
 			{
 				Method:   "GET",
 				Resource: "/api/2.0/policies/clusters/get?policy_id=123",
@@ -1362,6 +1367,11 @@ func TestImportingJobs_JobListMultiTask(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=14",
+				Response: jobs.JobRunsList{},
+			},
 			{
 				Method:   "GET",
 				Resource: "/api/2.0/policies/clusters/get?policy_id=123",
@@ -1892,6 +1902,11 @@ func TestImportingSqlObjects(t *testing.T) {
 				Resource: "/api/2.0/preview/sql/alerts/3cf91a42-6217-4f3c-a6f0-345d489051b9?",
 				Response: getJSONObject("test-data/get-sql-alert.json"),
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/3cf91a42-6217-4f3c-a6f0-345d489051b9/subscriptions",
+				Response: []any{},
+			},
 			{
 				Method:   "GET",
 				Resource: "/api/2.0/preview/sql/permissions/alerts/3cf91a42-6217-4f3c-a6f0-345d489051b9",
@@ -1972,7 +1987,7 @@ func TestImportingDLTPipelines(t *testing.T) {
 			userFixture[1],
 			{
 				Method:       "GET",
-				Resource:     "/api/2.0/preview/scim/v2/Users/123?attributes=userName,displayName,active,externalId,entitlements",
+				Resource:     "/api/2.0/preview/scim/v2/Users/123?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Response:     scim.User{ID: "123", DisplayName: "user@domain.com", UserName: "user@domain.com"},
 				ReuseRequest: true,
 			},
@@ -2561,11 +2576,21 @@ func TestImportingRunJobTask(t *testing.T) {
 				Resource: "/api/2.1/jobs/get?job_id=1047501313827425",
 				Response: getJSONObject("test-data/run-job-main.json"),
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=1047501313827425",
+				Response: jobs.JobRunsList{},
+			},
 			{
 				Method:   "GET",
 				Resource: "/api/2.1/jobs/get?job_id=932035899730845",
 				Response: getJSONObject("test-data/run-job-child.json"),
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=932035899730845",
+				Response: jobs.JobRunsList{},
+			},
 		},
 		func(ctx context.Context, client *common.DatabricksClient) {
 			tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
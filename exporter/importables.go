@@ -1376,7 +1376,8 @@ var resourcesMap map[string]importable = map[string]importable{
 			case "git_provider":
 				url := d.Get("url").(string)
 				provider := repos.GetGitProviderFromUrl(url)
-				return provider != "" // omit git_provider only for well-known URLs
+				// omit git_provider only if it's inferred correctly from the URL
+				return provider != "" && strings.EqualFold(provider, d.Get("git_provider").(string))
 			}
 			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
 		},
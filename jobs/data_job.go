@@ -3,6 +3,7 @@ package jobs
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 )
@@ -14,8 +15,10 @@ func DataSourceJob() common.Resource {
 		Name    string `json:"name,omitempty" tf:"computed"`
 		JobName string `json:"job_name,omitempty" tf:"computed"`
 		Job     *Job   `json:"job_settings,omitempty" tf:"computed"`
+		// CreatorUserName is the same as in job_settings, but easier to reference
+		CreatorUserName string `json:"creator_user_name,omitempty" tf:"computed"`
 	}
-	return common.DataResource(queryableJobData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+	r := common.DataResource(queryableJobData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*queryableJobData)
 		jobsAPI := NewJobsAPI(ctx, c)
 		var list []Job
@@ -26,9 +29,11 @@ func DataSourceJob() common.Resource {
 		if data.Name == "" {
 			data.Name = data.JobName
 		}
+		// jobs are created with the name prefix and suffix of the provider
+		name := c.AffixName(data.Name)
 		if data.Name != "" {
 			// if name is provided, need to list all jobs ny name
-			list, err = jobsAPI.ListByName(data.Name, true)
+			list, err = jobsAPI.ListByName(name, true)
 		} else {
 			// otherwise, just read the job
 			var job Job
@@ -37,26 +42,39 @@ func DataSourceJob() common.Resource {
 				return err
 			}
 			data.Job = &job
-			data.Name = job.Settings.Name
+			data.Name = c.StripNameAffix(job.Settings.Name)
 		}
 		if err != nil {
 			return err
 		}
+		var matches []Job
 		for _, job := range list {
-			currentJob := job // De-referencing the temp variable used by the loop
-			currentJobId := currentJob.ID()
-			currentJobName := currentJob.Settings.Name
-			if currentJobName == data.Name || currentJobId == data.Id {
-				data.Job = &currentJob
-				data.Name = currentJobName
-				data.Id = currentJobId
-				data.JobId = currentJobId
-				return nil // break the loop after we found the job
+			if job.Settings.Name == name || job.ID() == data.Id {
+				matches = append(matches, job)
 			}
 		}
+		if len(matches) > 1 {
+			// jobs deployed with the same name, e.g. by different bundle targets, can't be told apart
+			ids := []string{}
+			for _, job := range matches {
+				ids = append(ids, job.ID())
+			}
+			return fmt.Errorf("there are %d jobs named %s: %s. Use job_id instead",
+				len(matches), name, strings.Join(ids, ", "))
+		}
+		if len(matches) == 1 {
+			data.Job = &matches[0]
+			data.Id = data.Job.ID()
+			data.Name = c.StripNameAffix(data.Job.Settings.Name)
+		}
 		if data.Job == nil {
 			return fmt.Errorf("no job found with specified name")
 		}
+		data.JobId = data.Id
+		data.JobName = data.Name
+		data.CreatorUserName = data.Job.CreatorUserName
 		return nil
 	})
+	r.WorkspaceLevel = true
+	return r
 }
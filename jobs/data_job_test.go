@@ -190,6 +190,40 @@ func TestDataSourceQueryableJobMatchesName(t *testing.T) {
 	})
 }
 
+func TestDataSourceQueryableJobMatchesAffixedName(t *testing.T) {
+	fixtures := commonFixtures("dev-First-a")
+	fixtures[0].Response = JobListResponse{
+		Jobs: []Job{
+			{
+				JobID: 123,
+				Settings: &JobSettings{
+					Name: "First",
+				},
+			},
+			{
+				JobID: 234,
+				Settings: &JobSettings{
+					Name: "dev-First-a",
+				},
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures:    fixtures[:1],
+		Resource:    DataSourceJob(),
+		Read:        true,
+		NonWritable: true,
+		NamePrefix:  "dev-",
+		NameSuffix:  "-a",
+		HCL:         `job_name = "First"`,
+		ID:          "234",
+	}.ApplyAndExpectData(t, map[string]any{
+		"job_id":                         "234",
+		"job_name":                       "First",
+		"job_settings.0.settings.0.name": "dev-First-a",
+	})
+}
+
 func TestDataSourceQueryableJobNoMatchName(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -229,3 +263,67 @@ func TestDataSourceQueryableJobNoMatchId(t *testing.T) {
 		ID:          "_",
 	}.ExpectError(t, "Job 567 does not exist.")
 }
+
+func TestDataSourceQueryableJobCreator(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/list?expand_tasks=true&limit=25&name=Bundled",
+				Response: JobListResponse{
+					Jobs: []Job{
+						{
+							JobID:           345,
+							CreatorUserName: "deployer@example.com",
+							Settings: &JobSettings{
+								Name: "Bundled",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceJob(),
+		Read:        true,
+		NonWritable: true,
+		HCL:         `job_name = "Bundled"`,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "345",
+		"job_id":            "345",
+		"name":              "Bundled",
+		"creator_user_name": "deployer@example.com",
+	})
+}
+
+func TestDataSourceQueryableJobAmbiguousName(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/list?expand_tasks=true&limit=25&name=Bundled",
+				Response: JobListResponse{
+					Jobs: []Job{
+						{
+							JobID: 345,
+							Settings: &JobSettings{
+								Name: "Bundled",
+							},
+						},
+						{
+							JobID: 456,
+							Settings: &JobSettings{
+								Name: "Bundled",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceJob(),
+		Read:        true,
+		NonWritable: true,
+		HCL:         `job_name = "Bundled"`,
+		ID:          "_",
+	}.ExpectError(t, "there are 2 jobs named Bundled: 345, 456. Use job_id instead")
+}
@@ -11,7 +11,7 @@ func DataSourceJobs() common.Resource {
 	type jobsData struct {
 		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
 	}
-	return common.DataResource(jobsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+	r := common.DataResource(jobsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		response := e.(*jobsData)
 		jobsAPI := NewJobsAPI(ctx, c)
 		list, err := jobsAPI.List()
@@ -29,4 +29,6 @@ func DataSourceJobs() common.Resource {
 		}
 		return nil
 	})
+	r.WorkspaceLevel = true
+	return r
 }
@@ -1,10 +1,14 @@
 package jobs
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -24,11 +28,51 @@ import (
 	"github.com/databricks/terraform-provider-databricks/repos"
 )
 
+// NotebookParameters are the values of notebook widgets. The API may return numbers and booleans,
+// which are kept in their canonical JSON form, so that `1` and `true` from HCL don't cause a diff.
+type NotebookParameters map[string]string
+
+// UnmarshalJSON converts non-string values to their JSON representation
+func (p *NotebookParameters) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*p = nil
+		return nil
+	}
+	params := NotebookParameters{}
+	for k, v := range raw {
+		var str string
+		if err := json.Unmarshal(v, &str); err == nil {
+			params[k] = str
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(v, &value); err != nil {
+			return fmt.Errorf("base_parameters.%s: %w", k, err)
+		}
+		if value == nil {
+			params[k] = ""
+			continue
+		}
+		// compacted form keeps numbers as they were sent, e.g. `1.0` doesn't become `1`
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, v); err != nil {
+			return fmt.Errorf("base_parameters.%s: %w", k, err)
+		}
+		params[k] = buf.String()
+	}
+	*p = params
+	return nil
+}
+
 // NotebookTask contains the information for notebook jobs
 type NotebookTask struct {
-	NotebookPath   string            `json:"notebook_path"`
-	Source         string            `json:"source,omitempty" tf:"suppress_diff"`
-	BaseParameters map[string]string `json:"base_parameters,omitempty"`
+	NotebookPath   string             `json:"notebook_path"`
+	Source         string             `json:"source,omitempty" tf:"suppress_diff"`
+	BaseParameters NotebookParameters `json:"base_parameters,omitempty"`
 }
 
 // SparkPythonTask contains the information for python jobs
@@ -138,6 +182,7 @@ type ForEachNestedTask struct {
 	NewCluster        *clusters.Cluster   `json:"new_cluster,omitempty" tf:"group:cluster_type"`
 	JobClusterKey     string              `json:"job_cluster_key,omitempty" tf:"group:cluster_type"`
 	ComputeKey        string              `json:"compute_key,omitempty" tf:"group:cluster_type"`
+	EnvironmentKey    string              `json:"environment_key,omitempty" tf:"group:cluster_type"`
 	Libraries         []libraries.Library `json:"libraries,omitempty" tf:"slice_set,alias:library"`
 
 	NotebookTask    *NotebookTask       `json:"notebook_task,omitempty" tf:"group:task_type"`
@@ -161,6 +206,128 @@ type ForEachNestedTask struct {
 	Health                 *JobHealth                     `json:"health,omitempty"`
 }
 
+// validate checks settings of the task, that can't be expressed in the schema. Values, for which known
+// returns false during the plan, are skipped. Paths passed to known are relative to the task, like
+// `sql_task.0.warehouse_id`.
+func (t *JobTaskSettings) validate(known func(path string) bool, gitSource *GitSource) error {
+	hasCluster := t.ExistingClusterID != "" || t.NewCluster != nil || t.JobClusterKey != "" || t.ComputeKey != ""
+	// pipeline, run job and for each tasks run with the clusters from settings of the pipeline,
+	// of the other job or of the nested task
+	var taskType, owner string
+	switch {
+	case t.PipelineTask != nil:
+		taskType, owner = "pipeline_task", "pipeline"
+	case t.RunJobTask != nil:
+		taskType, owner = "run_job_task", "triggered job"
+	case t.ForEachTask != nil:
+		taskType, owner = "for_each_task", "nested task"
+	}
+	if taskType != "" && hasCluster {
+		return fmt.Errorf("task %s has `%s`, that runs on clusters of the %s, "+
+			"so `existing_cluster_id`, `new_cluster`, `job_cluster_key` and `compute_key` can't be specified",
+			t.TaskKey, taskType, owner)
+	}
+	if taskType != "" && len(t.Libraries) > 0 {
+		return fmt.Errorf("task %s has `%s` with `library`, specify libraries in the %s instead",
+			t.TaskKey, taskType, owner)
+	}
+	// tasks with environment_key run on serverless compute, where libraries are installed from
+	// the dependencies of the environment
+	if t.EnvironmentKey != "" && hasCluster {
+		return fmt.Errorf("task %s has `environment_key`, so it runs on serverless compute, "+
+			"and `existing_cluster_id`, `new_cluster`, `job_cluster_key` and `compute_key` can't be specified", t.TaskKey)
+	}
+	if t.EnvironmentKey != "" && len(t.Libraries) > 0 {
+		return fmt.Errorf("task %s has `environment_key` with `library`, "+
+			"specify libraries in `dependencies` of the environment instead", t.TaskKey)
+	}
+	if t.SqlTask != nil {
+		kinds := 0
+		for _, set := range []bool{t.SqlTask.Query != nil, t.SqlTask.Dashboard != nil,
+			t.SqlTask.Alert != nil, t.SqlTask.File != nil} {
+			if set {
+				kinds++
+			}
+		}
+		if kinds != 1 {
+			return fmt.Errorf("task %s has `sql_task` that must have exactly one of `query`, `dashboard`, "+
+				"`alert` or `file` blocks, but has %d", t.TaskKey, kinds)
+		}
+		if t.SqlTask.WarehouseID == "" && known("sql_task.0.warehouse_id") {
+			return fmt.Errorf("task %s has `sql_task` without `warehouse_id`", t.TaskKey)
+		}
+		if t.SqlTask.File != nil && t.SqlTask.File.Source == "GIT" && gitSource == nil {
+			return fmt.Errorf("task %s has `sql_task` with a `file` from `source = \"GIT\"`, but `git_source` isn't specified", t.TaskKey)
+		}
+	}
+	if t.NotebookTask != nil && t.NotebookTask.Source == "GIT" && gitSource == nil {
+		return fmt.Errorf("task %s has `notebook_task` with `source = \"GIT\"`, but `git_source` isn't specified", t.TaskKey)
+	}
+	if t.SparkPythonTask != nil && t.SparkPythonTask.Source == "GIT" && gitSource == nil {
+		return fmt.Errorf("task %s has `spark_python_task` with `source = \"GIT\"`, but `git_source` isn't specified", t.TaskKey)
+	}
+	if t.DbtTask != nil {
+		if t.DbtTask.Source == "GIT" && gitSource == nil {
+			return fmt.Errorf("task %s has `dbt_task` with `source = \"GIT\"`, but `git_source` isn't specified", t.TaskKey)
+		}
+		if t.DbtTask.Source == "WORKSPACE" && t.DbtTask.ProjectDirectory == "" && known("dbt_task.0.project_directory") {
+			return fmt.Errorf("task %s has `dbt_task` with `source = \"WORKSPACE\"`, but `project_directory` isn't specified", t.TaskKey)
+		}
+	}
+	if err := t.Health.validate("task " + t.TaskKey); err != nil {
+		return err
+	}
+	if t.NewCluster != nil {
+		if err := t.NewCluster.Validate(); err != nil {
+			return fmt.Errorf("task %s invalid: %w", t.TaskKey, err)
+		}
+	}
+	if t.ForEachTask != nil {
+		nested := t.ForEachTask.Task.toTaskSettings()
+		if nested.TaskKey == t.TaskKey {
+			return fmt.Errorf("task %s has `for_each_task` with a nested task with the same task_key", t.TaskKey)
+		}
+		return nested.validate(func(path string) bool {
+			return known("for_each_task.0.task.0." + path)
+		}, gitSource)
+	}
+	return nil
+}
+
+// toTaskSettings returns the nested task of the for each task as a task of the job
+func (t ForEachNestedTask) toTaskSettings() JobTaskSettings {
+	return JobTaskSettings{
+		TaskKey:                t.TaskKey,
+		Description:            t.Description,
+		DependsOn:              t.DependsOn,
+		RunIf:                  t.RunIf,
+		ExistingClusterID:      t.ExistingClusterID,
+		NewCluster:             t.NewCluster,
+		JobClusterKey:          t.JobClusterKey,
+		ComputeKey:             t.ComputeKey,
+		EnvironmentKey:         t.EnvironmentKey,
+		Libraries:              t.Libraries,
+		NotebookTask:           t.NotebookTask,
+		SparkJarTask:           t.SparkJarTask,
+		SparkPythonTask:        t.SparkPythonTask,
+		SparkSubmitTask:        t.SparkSubmitTask,
+		PipelineTask:           t.PipelineTask,
+		PythonWheelTask:        t.PythonWheelTask,
+		SqlTask:                t.SqlTask,
+		DbtTask:                t.DbtTask,
+		RunJobTask:             t.RunJobTask,
+		ConditionTask:          t.ConditionTask,
+		EmailNotifications:     t.EmailNotifications,
+		WebhookNotifications:   t.WebhookNotifications,
+		NotificationSettings:   t.NotificationSettings,
+		TimeoutSeconds:         t.TimeoutSeconds,
+		MaxRetries:             t.MaxRetries,
+		MinRetryIntervalMillis: t.MinRetryIntervalMillis,
+		RetryOnTimeout:         t.RetryOnTimeout,
+		Health:                 t.Health,
+	}
+}
+
 func sortWebhookNotifications(wn *jobs.WebhookNotifications) {
 	if wn == nil {
 		return
@@ -199,15 +366,42 @@ type GitSource struct {
 // End Jobs + Repo integration preview
 
 type JobHealthRule struct {
-	Metric    string `json:"metric,omitempty"`
-	Operation string `json:"op,omitempty"`
-	Value     int64  `json:"value,omitempty"`
+	Metric    string `json:"metric"`
+	Operation string `json:"op"`
+	Value     int64  `json:"value"`
 }
 
 type JobHealth struct {
 	Rules []JobHealthRule `json:"rules"`
 }
 
+// jobHealthMetrics are metrics, that can be checked by health rules of jobs and tasks
+var jobHealthMetrics = []string{
+	"RUN_DURATION_SECONDS",
+	"STREAMING_BACKLOG_BYTES",
+	"STREAMING_BACKLOG_RECORDS",
+	"STREAMING_BACKLOG_SECONDS",
+	"STREAMING_BACKLOG_FILES",
+}
+
+// validate checks that every metric has at most one rule, because the Jobs API can't tell which one applies
+func (h *JobHealth) validate(owner string) error {
+	if h == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	for _, rule := range h.Rules {
+		if rule.Metric == "" {
+			continue
+		}
+		if seen[rule.Metric] {
+			return fmt.Errorf("health of %s has more than one rule for %s", owner, rule.Metric)
+		}
+		seen[rule.Metric] = true
+	}
+	return nil
+}
+
 type JobTaskSettings struct {
 	TaskKey     string                `json:"task_key,omitempty"`
 	Description string                `json:"description,omitempty"`
@@ -218,6 +412,7 @@ type JobTaskSettings struct {
 	NewCluster        *clusters.Cluster   `json:"new_cluster,omitempty" tf:"group:cluster_type"`
 	JobClusterKey     string              `json:"job_cluster_key,omitempty" tf:"group:cluster_type"`
 	ComputeKey        string              `json:"compute_key,omitempty" tf:"group:cluster_type"`
+	EnvironmentKey    string              `json:"environment_key,omitempty" tf:"group:cluster_type"`
 	Libraries         []libraries.Library `json:"libraries,omitempty" tf:"slice_set,alias:library"`
 
 	NotebookTask    *NotebookTask       `json:"notebook_task,omitempty" tf:"group:task_type"`
@@ -252,6 +447,17 @@ type JobCompute struct {
 	ComputeSpec *compute.ComputeSpec `json:"spec,omitempty" tf:"group:cluster_type"`
 }
 
+// JobEnvironmentSpec is the environment of tasks on serverless compute
+type JobEnvironmentSpec struct {
+	Client       string   `json:"client"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+type JobEnvironment struct {
+	EnvironmentKey string              `json:"environment_key"`
+	Spec           *JobEnvironmentSpec `json:"spec"`
+}
+
 type ContinuousConf struct {
 	PauseStatus string `json:"pause_status,omitempty" tf:"default:UNPAUSED"`
 }
@@ -267,8 +473,16 @@ type FileArrival struct {
 	WaitAfterLastChangeSeconds    int32  `json:"wait_after_last_change_seconds,omitempty"`
 }
 
+type TableUpdate struct {
+	TableNames                    []string `json:"table_names"`
+	Condition                     string   `json:"condition,omitempty" tf:"default:ANY_UPDATED"`
+	MinTimeBetweenTriggersSeconds int32    `json:"min_time_between_triggers_seconds,omitempty"`
+	WaitAfterLastChangeSeconds    int32    `json:"wait_after_last_change_seconds,omitempty"`
+}
+
 type Trigger struct {
-	FileArrival *FileArrival `json:"file_arrival"`
+	FileArrival *FileArrival `json:"file_arrival,omitempty"`
+	TableUpdate *TableUpdate `json:"table_update,omitempty"`
 	PauseStatus string       `json:"pause_status,omitempty" tf:"default:UNPAUSED"`
 }
 
@@ -296,10 +510,11 @@ type JobSettings struct {
 	// END Jobs API 2.0
 
 	// BEGIN Jobs API 2.1
-	Tasks       []JobTaskSettings `json:"tasks,omitempty" tf:"alias:task"`
-	Format      string            `json:"format,omitempty" tf:"computed"`
-	JobClusters []JobCluster      `json:"job_clusters,omitempty" tf:"alias:job_cluster"`
-	Compute     []JobCompute      `json:"compute,omitempty" tf:"alias:compute"`
+	Tasks        []JobTaskSettings `json:"tasks,omitempty" tf:"alias:task"`
+	Format       string            `json:"format,omitempty" tf:"computed"`
+	JobClusters  []JobCluster      `json:"job_clusters,omitempty" tf:"alias:job_cluster"`
+	Compute      []JobCompute      `json:"compute,omitempty" tf:"alias:compute"`
+	Environments []JobEnvironment  `json:"environments,omitempty" tf:"alias:environment"`
 	// END Jobs API 2.1
 
 	// BEGIN Jobs + Repo integration preview
@@ -314,7 +529,8 @@ type JobSettings struct {
 	WebhookNotifications *jobs.WebhookNotifications    `json:"webhook_notifications,omitempty" tf:"suppress_diff"`
 	NotificationSettings *jobs.JobNotificationSettings `json:"notification_settings,omitempty"`
 	Tags                 map[string]string             `json:"tags,omitempty"`
-	Queue                *jobs.QueueSettings           `json:"queue,omitempty"`
+	BudgetPolicyID       string                        `json:"budget_policy_id,omitempty"`
+	Queue                *jobs.QueueSettings           `json:"queue,omitempty" tf:"computed"`
 	RunAs                *JobRunAs                     `json:"run_as,omitempty" tf:"computed"`
 	Health               *JobHealth                    `json:"health,omitempty"`
 	Parameters           []jobs.JobParameterDefinition `json:"parameters,omitempty" tf:"alias:parameter"`
@@ -342,6 +558,310 @@ func (js *JobSettings) adjustTasks() {
 	}
 }
 
+// singleTaskKey is the task_key of the task, that deprecated single-task jobs are converted to
+const singleTaskKey = "main"
+
+// topLevelTaskTypes are the deprecated top-level task attributes of single-task jobs
+var topLevelTaskTypes = []string{
+	"notebook_task",
+	"spark_jar_task",
+	"spark_python_task",
+	"spark_submit_task",
+	"pipeline_task",
+	"python_wheel_task",
+	"dbt_task",
+	"run_job_task",
+}
+
+// singleTaskAttributes are the deprecated top-level attributes of single-task jobs, that are moved
+// into the task during the conversion to the multi-task format
+var singleTaskAttributes = append([]string{
+	"existing_cluster_id",
+	"new_cluster",
+	"library",
+	"max_retries",
+	"min_retry_interval_millis",
+	"retry_on_timeout",
+}, topLevelTaskTypes...)
+
+// isSingleTask is true for jobs, that are defined with the deprecated top-level task attributes
+func (js *JobSettings) isSingleTask() bool {
+	if len(js.Tasks) > 0 {
+		return false
+	}
+	return js.NotebookTask != nil || js.SparkJarTask != nil || js.SparkPythonTask != nil ||
+		js.SparkSubmitTask != nil || js.PipelineTask != nil || js.PythonWheelTask != nil ||
+		js.DbtTask != nil || js.RunJobTask != nil
+}
+
+// toMultiTask moves the deprecated top-level task attributes into a single task
+func (js *JobSettings) toMultiTask() {
+	js.Tasks = []JobTaskSettings{
+		{
+			TaskKey:                singleTaskKey,
+			ExistingClusterID:      js.ExistingClusterID,
+			NewCluster:             js.NewCluster,
+			Libraries:              js.Libraries,
+			NotebookTask:           js.NotebookTask,
+			SparkJarTask:           js.SparkJarTask,
+			SparkPythonTask:        js.SparkPythonTask,
+			SparkSubmitTask:        js.SparkSubmitTask,
+			PipelineTask:           js.PipelineTask,
+			PythonWheelTask:        js.PythonWheelTask,
+			DbtTask:                js.DbtTask,
+			RunJobTask:             js.RunJobTask,
+			MaxRetries:             js.MaxRetries,
+			MinRetryIntervalMillis: js.MinRetryIntervalMillis,
+			RetryOnTimeout:         js.RetryOnTimeout,
+		},
+	}
+	js.Format = "MULTI_TASK"
+	js.ExistingClusterID = ""
+	js.NewCluster = nil
+	js.Libraries = nil
+	js.NotebookTask = nil
+	js.SparkJarTask = nil
+	js.SparkPythonTask = nil
+	js.SparkSubmitTask = nil
+	js.PipelineTask = nil
+	js.PythonWheelTask = nil
+	js.DbtTask = nil
+	js.RunJobTask = nil
+	js.MaxRetries = 0
+	js.MinRetryIntervalMillis = 0
+	js.RetryOnTimeout = false
+}
+
+// fromMultiTask moves attributes of the only task of the job back to the top level, so that jobs, that are
+// converted to the multi-task format, can still be configured with the deprecated top-level attributes
+func (js *JobSettings) fromMultiTask() {
+	if len(js.Tasks) != 1 {
+		return
+	}
+	task := js.Tasks[0]
+	single := JobSettings{
+		NotebookTask:    task.NotebookTask,
+		SparkJarTask:    task.SparkJarTask,
+		SparkPythonTask: task.SparkPythonTask,
+		SparkSubmitTask: task.SparkSubmitTask,
+		PipelineTask:    task.PipelineTask,
+		PythonWheelTask: task.PythonWheelTask,
+		DbtTask:         task.DbtTask,
+		RunJobTask:      task.RunJobTask,
+	}
+	if !single.isSingleTask() {
+		return
+	}
+	js.Tasks = nil
+	js.ExistingClusterID = task.ExistingClusterID
+	js.NewCluster = task.NewCluster
+	js.Libraries = task.Libraries
+	js.NotebookTask = task.NotebookTask
+	js.SparkJarTask = task.SparkJarTask
+	js.SparkPythonTask = task.SparkPythonTask
+	js.SparkSubmitTask = task.SparkSubmitTask
+	js.PipelineTask = task.PipelineTask
+	js.PythonWheelTask = task.PythonWheelTask
+	js.DbtTask = task.DbtTask
+	js.RunJobTask = task.RunJobTask
+	js.MaxRetries = task.MaxRetries
+	js.MinRetryIntervalMillis = task.MinRetryIntervalMillis
+	js.RetryOnTimeout = task.RetryOnTimeout
+}
+
+// keepSingleTaskKey keeps the task_key from the state for jobs, that are still in the single-task format
+// in Databricks, e.g. after the state upgrade. Their only task gets a key assigned by the Jobs API, which
+// isn't known to the configuration, and it's replaced with the one from the state with the first update.
+func (js *JobSettings) keepSingleTaskKey(taskKey string) {
+	if js.isSingleTask() {
+		js.toMultiTask()
+		js.Format = "SINGLE_TASK"
+	}
+	if len(js.Tasks) == 1 {
+		js.Tasks[0].TaskKey = taskKey
+	}
+}
+
+// jobMigrateV2 moves the deprecated top-level task attributes of single-task jobs into a task block, so
+// that configurations can be migrated to the multi-task format without changes to the job. The task_key
+// is kept on refresh, while the job is still in the single-task format in Databricks.
+func jobMigrateV2(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	if tasks, ok := rawState["task"].([]any); ok && len(tasks) > 0 {
+		return rawState, nil
+	}
+	singleTask := false
+	for _, attr := range topLevelTaskTypes {
+		if blocks, ok := rawState[attr].([]any); ok && len(blocks) > 0 {
+			singleTask = true
+		}
+	}
+	if !singleTask {
+		return rawState, nil
+	}
+	log.Printf("[INFO] Moving top-level task attributes of job %v into a task block", rawState["id"])
+	task := map[string]any{
+		"task_key": singleTaskKey,
+	}
+	for _, attr := range singleTaskAttributes {
+		if v, ok := rawState[attr]; ok {
+			task[attr] = v
+			delete(rawState, attr)
+		}
+	}
+	rawState["task"] = []any{task}
+	rawState["format"] = "MULTI_TASK"
+	return rawState, nil
+}
+
+// validate checks settings of the job and of its tasks, that can't be expressed in the schema. Values,
+// for which known returns false during the plan, are skipped.
+func (js *JobSettings) validate(known func(path string) bool) error {
+	if js.Continuous != nil && js.MaxConcurrentRuns > 1 {
+		return fmt.Errorf("`continuous` must be specified only with `max_concurrent_runs = 1`")
+	}
+	if js.Queue != nil && js.Queue.Enabled && js.MaxConcurrentRuns == 0 {
+		return fmt.Errorf("`queue` must be specified only with `max_concurrent_runs` of at least 1, " +
+			"otherwise no queued run ever starts")
+	}
+	if err := js.validateTaskGraph(); err != nil {
+		return err
+	}
+	if err := js.validateJobParameters(); err != nil {
+		return err
+	}
+	if err := js.Health.validate("job"); err != nil {
+		return err
+	}
+	for i, task := range js.Tasks {
+		prefix := fmt.Sprintf("task.%d.", i)
+		err := task.validate(func(path string) bool {
+			return known(prefix + path)
+		}, js.GitSource)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTaskGraph checks that task keys are unique, and that tasks refer only to existing job clusters
+// and tasks without forming a dependency cycle. Keys that aren't known during the plan are skipped.
+func (js *JobSettings) validateTaskGraph() error {
+	tasks := map[string]JobTaskSettings{}
+	for _, task := range js.Tasks {
+		if task.TaskKey == "" {
+			return nil
+		}
+		if _, ok := tasks[task.TaskKey]; ok {
+			return fmt.Errorf("task_key %s is used by more than one task", task.TaskKey)
+		}
+		tasks[task.TaskKey] = task
+	}
+	jobClusters := map[string]bool{}
+	for _, jc := range js.JobClusters {
+		if jc.JobClusterKey == "" {
+			return nil
+		}
+		if jobClusters[jc.JobClusterKey] {
+			return fmt.Errorf("job_cluster_key %s is used by more than one job_cluster", jc.JobClusterKey)
+		}
+		jobClusters[jc.JobClusterKey] = true
+	}
+	environments := map[string]bool{}
+	for _, env := range js.Environments {
+		if env.EnvironmentKey == "" {
+			return nil
+		}
+		if environments[env.EnvironmentKey] {
+			return fmt.Errorf("environment_key %s is used by more than one environment", env.EnvironmentKey)
+		}
+		environments[env.EnvironmentKey] = true
+	}
+	for _, task := range js.Tasks {
+		if task.JobClusterKey != "" && !jobClusters[task.JobClusterKey] {
+			return fmt.Errorf("task %s refers to unknown job_cluster_key %s", task.TaskKey, task.JobClusterKey)
+		}
+		if task.EnvironmentKey != "" && !environments[task.EnvironmentKey] {
+			return fmt.Errorf("task %s refers to unknown environment_key %s", task.TaskKey, task.EnvironmentKey)
+		}
+		if task.ForEachTask != nil {
+			nested := task.ForEachTask.Task
+			if nested.JobClusterKey != "" && !jobClusters[nested.JobClusterKey] {
+				return fmt.Errorf("task %s refers to unknown job_cluster_key %s", nested.TaskKey, nested.JobClusterKey)
+			}
+			if nested.EnvironmentKey != "" && !environments[nested.EnvironmentKey] {
+				return fmt.Errorf("task %s refers to unknown environment_key %s", nested.TaskKey, nested.EnvironmentKey)
+			}
+		}
+		for _, dep := range task.DependsOn {
+			if _, ok := tasks[dep.TaskKey]; dep.TaskKey != "" && !ok {
+				return fmt.Errorf("task %s depends on unknown task %s", task.TaskKey, dep.TaskKey)
+			}
+		}
+	}
+	const visiting, visited = 1, 2
+	state := map[string]int{}
+	var visit func(key string, path []string) error
+	visit = func(key string, path []string) error {
+		switch state[key] {
+		case visiting:
+			cycle := append(path[slices.Index(path, key):], key)
+			return fmt.Errorf("tasks form a dependency cycle: %s", strings.Join(cycle, " -> "))
+		case visited:
+			return nil
+		}
+		state[key] = visiting
+		path = append(path, key)
+		for _, dep := range tasks[key].DependsOn {
+			if dep.TaskKey == "" {
+				continue
+			}
+			if err := visit(dep.TaskKey, path); err != nil {
+				return err
+			}
+		}
+		state[key] = visited
+		return nil
+	}
+	for _, task := range js.Tasks {
+		if err := visit(task.TaskKey, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jobParameterReference matches references to job parameters in task settings, like {{job.parameters.env}}
+var jobParameterReference = regexp.MustCompile(`\{\{\s*job\.parameters\.([\w.-]+)\s*\}\}`)
+
+// validateJobParameters checks that names of job parameters are unique, and that tasks refer only
+// to defined job parameters. Names that aren't known during the plan are skipped.
+func (js *JobSettings) validateJobParameters() error {
+	defined := map[string]bool{}
+	for _, p := range js.Parameters {
+		if p.Name == "" {
+			return nil
+		}
+		if defined[p.Name] {
+			return fmt.Errorf("job parameter %s is defined more than once", p.Name)
+		}
+		defined[p.Name] = true
+	}
+	for _, task := range js.Tasks {
+		raw, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		for _, m := range jobParameterReference.FindAllStringSubmatch(string(raw), -1) {
+			if !defined[m[1]] {
+				return fmt.Errorf("task %s refers to job parameter %s, that isn't defined in a `parameter` block",
+					task.TaskKey, m[1])
+			}
+		}
+	}
+	return nil
+}
+
 func (js *JobSettings) sortWebhooksByID() {
 	sortWebhookNotifications(js.WebhookNotifications)
 }
@@ -361,6 +881,9 @@ type Job struct {
 	RunAsUserName   string       `json:"run_as_user_name,omitempty" tf:"computed"`
 	Settings        *JobSettings `json:"settings,omitempty"`
 	CreatedTime     int64        `json:"created_time,omitempty"`
+	// EffectiveBudgetPolicyID is the budget policy that is applied to the job, either set explicitly
+	// or inherited from the default policy of the user
+	EffectiveBudgetPolicyID string `json:"effective_budget_policy_id,omitempty"`
 }
 
 // ID returns job id as string
@@ -454,7 +977,14 @@ func (a JobsAPI) ListByName(name string, expandTasks bool) ([]Job, error) {
 	nextPageToken := ""
 	ctx := context.WithValue(a.context, common.Api, common.API_2_1)
 	for {
-		var resp JobListResponse
+		var resp struct {
+			JobListResponse
+			Jobs []struct {
+				Job
+				// tasks and job clusters of the job are truncated
+				HasMore bool `json:"has_more,omitempty"`
+			} `json:"jobs"`
+		}
 		if nextPageToken != "" {
 			params["page_token"] = nextPageToken
 		}
@@ -462,7 +992,17 @@ func (a JobsAPI) ListByName(name string, expandTasks bool) ([]Job, error) {
 		if err != nil {
 			return nil, err
 		}
-		jobs = append(jobs, resp.Jobs...)
+		for _, v := range resp.Jobs {
+			if expandTasks && v.HasMore {
+				// the list only has the first page of tasks, so the whole job is read separately
+				job, err := a.Read(v.ID())
+				if err != nil {
+					return nil, err
+				}
+				v.Job = job
+			}
+			jobs = append(jobs, v.Job)
+		}
 		if !resp.HasMore {
 			break
 		}
@@ -496,7 +1036,7 @@ func (a JobsAPI) RunsCancel(runID int64, timeout time.Duration) error {
 }
 
 func (a JobsAPI) waitForRunState(runID int64, desiredState string, timeout time.Duration) error {
-	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+	return common.RetryContext(a.context, timeout, func() *resource.RetryError {
 		jobRun, err := a.RunsGet(runID)
 		if err != nil {
 			return resource.NonRetryableError(
@@ -544,6 +1084,39 @@ func (a JobsAPI) Start(jobID int64, timeout time.Duration) error {
 	return a.waitForRunState(runID, "RUNNING", timeout)
 }
 
+// ActiveRunsCount returns the number of active runs of the job
+func (a JobsAPI) ActiveRunsCount(jobID int64) (int, error) {
+	count := 0
+	r := JobRunsListRequest{JobID: jobID, ActiveOnly: true}
+	for {
+		runs, err := a.RunsList(r)
+		if err != nil {
+			return 0, err
+		}
+		count += len(runs.Runs)
+		if !runs.HasMore || len(runs.Runs) == 0 {
+			return count, nil
+		}
+		r.Offset += int32(len(runs.Runs))
+	}
+}
+
+// WaitForActiveRunsToDrain waits till the job has no more than maxActiveRuns active runs
+func (a JobsAPI) WaitForActiveRunsToDrain(jobID int64, maxActiveRuns int, timeout time.Duration) error {
+	return common.RetryContext(a.context, timeout, func() *resource.RetryError {
+		count, err := a.ActiveRunsCount(jobID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if count > maxActiveRuns {
+			return resource.RetryableError(fmt.Errorf(
+				"job %d has %d active runs, waiting till no more than %d remain",
+				jobID, count, maxActiveRuns))
+		}
+		return nil
+	})
+}
+
 func (a JobsAPI) StopActiveRun(jobID int64, timeout time.Duration) error {
 	runs, err := a.RunsList(JobRunsListRequest{JobID: jobID, ActiveOnly: true})
 	if err != nil {
@@ -594,15 +1167,47 @@ func (a JobsAPI) Update(id string, jobSettings JobSettings) error {
 	}, nil), id)
 }
 
+// jobPage is a single response of /jobs/get. Tasks, job clusters, parameters and environments
+// of large jobs are split into many pages.
+type jobPage struct {
+	Job
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+// readAllPages reads the job and merges all pages of its tasks, job clusters, parameters and environments,
+// so that jobs with more than 100 tasks aren't truncated
+func (a JobsAPI) readAllPages(jobID int64, job *Job) error {
+	params := map[string]any{
+		"job_id": jobID,
+	}
+	for {
+		var page jobPage
+		err := a.client.Get(a.context, "/jobs/get", params, &page)
+		if err != nil {
+			return err
+		}
+		if job.Settings == nil || page.Settings == nil {
+			*job = page.Job
+		} else {
+			job.Settings.Tasks = append(job.Settings.Tasks, page.Settings.Tasks...)
+			job.Settings.JobClusters = append(job.Settings.JobClusters, page.Settings.JobClusters...)
+			job.Settings.Parameters = append(job.Settings.Parameters, page.Settings.Parameters...)
+			job.Settings.Environments = append(job.Settings.Environments, page.Settings.Environments...)
+		}
+		if page.NextPageToken == "" {
+			return nil
+		}
+		params["page_token"] = page.NextPageToken
+	}
+}
+
 // Read returns the job object with all the attributes
 func (a JobsAPI) Read(id string) (job Job, err error) {
 	jobID, err := parseJobId(id)
 	if err != nil {
 		return
 	}
-	err = wrapMissingJobError(a.client.Get(a.context, "/jobs/get", map[string]int64{
-		"job_id": jobID,
-	}, &job), id)
+	err = wrapMissingJobError(a.readAllPages(jobID, &job), id)
 	if job.Settings != nil {
 		job.Settings.adjustTasks()
 		job.Settings.sortWebhooksByID()
@@ -657,6 +1262,39 @@ func wrapMissingJobError(err error, id string) error {
 	return err
 }
 
+// validateForEachInputs checks that inputs of the for each task are either a JSON array or
+// a reference to a parameter or a task value, like `{{job.parameters.tables}}`
+func validateForEachInputs(i any, k string) (_ []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if strings.Contains(v, "{{") {
+		return
+	}
+	var inputs []any
+	if err := json.Unmarshal([]byte(v), &inputs); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be a JSON array, like [\"a\", \"b\"], "+
+			"or a reference, like {{job.parameters.tables}}, got: %s", k, v))
+	}
+	return
+}
+
+// validateTriggerSeconds allows 0 for the default and at least a minute otherwise, like the Jobs API
+func validateTriggerSeconds(i any, k string) (_ []string, errs []error) {
+	v, ok := i.(int)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be integer", k)}
+	}
+	if v != 0 && v < 60 {
+		errs = append(errs, fmt.Errorf("%s must be at least 60 seconds, got: %d", k, v))
+	}
+	return
+}
+
+var validateDbtCommand = validation.StringMatch(regexp.MustCompile(`^\s*dbt(\s|$)`),
+	"dbt commands must start with `dbt`, like `dbt run`")
+
 func jobSettingsSchema(s *map[string]*schema.Schema, prefix string) {
 	if p, err := common.SchemaPath(*s, "new_cluster", "num_workers"); err == nil {
 		p.Optional = true
@@ -668,6 +1306,42 @@ func jobSettingsSchema(s *map[string]*schema.Schema, prefix string) {
 	if p, err := common.SchemaPath(*s, "new_cluster", "init_scripts", "dbfs"); err == nil {
 		p.Deprecated = clusters.DbfsDeprecationWarning
 	}
+	if p, err := common.SchemaPath(*s, "new_cluster"); err == nil {
+		clusters.SetInitScriptsValidation(p.Elem.(*schema.Resource).Schema)
+		clusters.SetClusterLogConfValidation(p.Elem.(*schema.Resource).Schema)
+	}
+	if p, err := common.SchemaPath(*s, "sql_task", "file", "source"); err == nil {
+		p.ValidateFunc = validation.StringInSlice([]string{"GIT", "WORKSPACE"}, false)
+	}
+	if p, err := common.SchemaPath(*s, "dbt_task", "source"); err == nil {
+		p.ValidateFunc = validation.StringInSlice([]string{"GIT", "WORKSPACE"}, false)
+	}
+	if p, err := common.SchemaPath(*s, "dbt_task", "commands"); err == nil {
+		p.MinItems = 1
+		p.Elem.(*schema.Schema).ValidateFunc = validateDbtCommand
+	}
+	if p, err := common.SchemaPath(*s, "for_each_task", "concurrency"); err == nil {
+		p.ValidateDiagFunc = validation.ToDiagFunc(validation.IntBetween(1, 100))
+	}
+	if p, err := common.SchemaPath(*s, "for_each_task", "inputs"); err == nil {
+		p.ValidateFunc = validateForEachInputs
+	}
+	if p, err := common.SchemaPath(*s, "health", "rules"); err == nil {
+		p.MinItems = 1
+		rule := p.Elem.(*schema.Resource).Schema
+		rule["metric"].ValidateFunc = validation.StringInSlice(jobHealthMetrics, false)
+		rule["op"].ValidateFunc = validation.StringInSlice([]string{"GREATER_THAN"}, false)
+		rule["value"].ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(1))
+	}
+	for _, event := range []string{"on_start", "on_success", "on_failure", "on_duration_warning_threshold_exceeded"} {
+		if p, err := common.SchemaPath(*s, "webhook_notifications", event); err == nil {
+			// the Jobs API accepts at most 3 destinations for each event
+			p.MaxItems = 3
+		}
+	}
+	if p, err := common.SchemaPath(*s, "run_job_task", "job_id"); err == nil {
+		p.ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(1))
+	}
 	if v, err := common.SchemaPath(*s, "new_cluster", "spark_conf"); err == nil {
 		reSize := common.MustCompileKeyRE(prefix + "new_cluster.0.spark_conf.%")
 		reConf := common.MustCompileKeyRE(prefix + "new_cluster.0.spark_conf.spark.databricks.delta.preview.enabled")
@@ -685,23 +1359,39 @@ func jobSettingsSchema(s *map[string]*schema.Schema, prefix string) {
 
 func gitSourceSchema(r *schema.Resource, prefix string) {
 	r.Schema["url"].ValidateFunc = validation.IsURLWithHTTPS
-	(*r.Schema["tag"]).ConflictsWith = []string{"git_source.0.branch", "git_source.0.commit"}
-	(*r.Schema["branch"]).ConflictsWith = []string{"git_source.0.commit", "git_source.0.tag"}
-	(*r.Schema["commit"]).ConflictsWith = []string{"git_source.0.branch", "git_source.0.tag"}
+	// code is always checked out at a pinned ref
+	refs := []string{"git_source.0.branch", "git_source.0.tag", "git_source.0.commit"}
+	for _, ref := range []string{"branch", "tag", "commit"} {
+		r.Schema[ref].ExactlyOneOf = refs
+	}
 }
 
 var jobSchema = common.StructToSchema(JobSettings{},
 	func(s map[string]*schema.Schema) map[string]*schema.Schema {
 		jobSettingsSchema(&s, "")
 		jobSettingsSchema(&s["task"].Elem.(*schema.Resource).Schema, "task.0.")
+		jobSettingsSchema(&common.MustSchemaPath(s, "task", "for_each_task", "task").Elem.(*schema.Resource).Schema,
+			"task.0.for_each_task.0.task.0.")
 		jobSettingsSchema(&s["job_cluster"].Elem.(*schema.Resource).Schema, "job_cluster.0.")
 		gitSourceSchema(s["git_source"].Elem.(*schema.Resource), "")
 		if p, err := common.SchemaPath(s, "schedule", "pause_status"); err == nil {
 			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
 		}
+		common.MustSchemaPath(s, "parameter", "name").ValidateFunc = validation.StringMatch(
+			regexp.MustCompile(`^[\w.-]+$`), "may only contain alphanumeric characters, `_`, `-` and `.`")
 		if p, err := common.SchemaPath(s, "trigger", "pause_status"); err == nil {
 			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
 		}
+		for _, field := range []string{"min_time_between_triggers_seconds", "wait_after_last_change_seconds"} {
+			common.MustSchemaPath(s, "trigger", "file_arrival", field).ValidateFunc = validateTriggerSeconds
+			common.MustSchemaPath(s, "trigger", "table_update", field).ValidateFunc = validateTriggerSeconds
+		}
+		trigger_eoo := []string{"trigger.0.file_arrival", "trigger.0.table_update"}
+		common.MustSchemaPath(s, "trigger", "file_arrival").ExactlyOneOf = trigger_eoo
+		common.MustSchemaPath(s, "trigger", "table_update").ExactlyOneOf = trigger_eoo
+		common.MustSchemaPath(s, "trigger", "table_update", "table_names").MinItems = 1
+		common.MustSchemaPath(s, "trigger", "table_update", "condition").ValidateFunc = validation.StringInSlice(
+			[]string{"ANY_UPDATED", "ALL_UPDATED"}, false)
 		if p, err := common.SchemaPath(s, "continuous", "pause_status"); err == nil {
 			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
 		}
@@ -724,24 +1414,29 @@ var jobSchema = common.StructToSchema(JobSettings{},
 			Type:          schema.TypeBool,
 			ConflictsWith: []string{"always_running"},
 		}
+		s["drain_active_runs"] = &schema.Schema{
+			Optional: true,
+			Default:  false,
+			Type:     schema.TypeBool,
+		}
+		s["active_runs_count"] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+		s["effective_budget_policy_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
 		s["schedule"].ConflictsWith = []string{"continuous", "trigger"}
 		s["continuous"].ConflictsWith = []string{"schedule", "trigger"}
 		s["trigger"].ConflictsWith = []string{"schedule", "continuous"}
 
 		// Deprecated Job API 2.0 attributes
-		var topLevelDeprecatedAttr = []string{
+		var topLevelDeprecatedAttr = append([]string{
 			"max_retries",
 			"min_retry_interval_millis",
 			"retry_on_timeout",
-			"notebook_task",
-			"spark_jar_task",
-			"spark_python_task",
-			"spark_submit_task",
-			"pipeline_task",
-			"python_wheel_task",
-			"dbt_task",
-			"run_job_task",
-		}
+		}, topLevelTaskTypes...)
 
 		for _, attr := range topLevelDeprecatedAttr {
 			s[attr].Deprecated = "should be used inside a task block and not inside a job block"
@@ -832,7 +1527,7 @@ func (c controlRunStateLifecycleManager) OnCreate(ctx context.Context) error {
 }
 
 func (c controlRunStateLifecycleManager) OnUpdate(ctx context.Context) error {
-	if c.d.Get("continuous") == nil {
+	if len(c.d.Get("continuous").([]any)) == 0 {
 		return nil
 	}
 
@@ -896,7 +1591,14 @@ func ResourceJob() common.Resource {
 	}
 	return common.Resource{
 		Schema:        jobSchema,
-		SchemaVersion: 2,
+		SchemaVersion: 3,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 2,
+				Type:    jobSchemaV2(),
+				Upgrade: jobMigrateV2,
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(clusters.DefaultProvisionTimeout),
 			Update: schema.DefaultTimeout(clusters.DefaultProvisionTimeout),
@@ -917,13 +1619,8 @@ func ResourceJob() common.Resource {
 					return fmt.Errorf("`control_run_state` must be specified only with `max_concurrent_runs = 1`")
 				}
 			}
-			for _, task := range js.Tasks {
-				if task.NewCluster == nil {
-					continue
-				}
-				if err := task.NewCluster.Validate(); err != nil {
-					return fmt.Errorf("task %s invalid: %w", task.TaskKey, err)
-				}
+			if err := js.validate(d.NewValueKnown); err != nil {
+				return err
 			}
 			if js.NewCluster != nil {
 				if err := js.NewCluster.Validate(); err != nil {
@@ -952,12 +1649,38 @@ func ResourceJob() common.Resource {
 			if err != nil {
 				return err
 			}
+			var current JobSettings
+			common.DataToStructPointer(d, jobSchema, &current)
+			if current.isSingleTask() {
+				job.Settings.fromMultiTask()
+			} else if job.Settings.Format == "SINGLE_TASK" && len(current.Tasks) == 1 {
+				job.Settings.keepSingleTaskKey(current.Tasks[0].TaskKey)
+			}
 			d.Set("url", c.FormatURL("#job/", d.Id()))
+			d.Set("effective_budget_policy_id", job.EffectiveBudgetPolicyID)
+			jobID, err := parseJobId(d.Id())
+			if err != nil {
+				return err
+			}
+			count, err := NewJobsAPI(ctx, c).ActiveRunsCount(jobID)
+			if err != nil {
+				return err
+			}
+			d.Set("active_runs_count", count)
 			return common.StructToData(*job.Settings, jobSchema, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var js JobSettings
 			common.DataToStructPointer(d, jobSchema, &js)
+			// jobs, that are already in the multi-task format, e.g. after the state upgrade, stay in it,
+			// even if they are still configured with the deprecated top-level attributes
+			if js.Format == "MULTI_TASK" && js.isSingleTask() {
+				js.toMultiTask()
+			}
+			// jobs, that are still in the single-task format in Databricks, are converted with the first update
+			if js.Format == "SINGLE_TASK" && len(js.Tasks) > 0 {
+				js.Format = "MULTI_TASK"
+			}
 			if js.isMultiTask() {
 				ctx = context.WithValue(ctx, common.Api, common.API_2_1)
 			}
@@ -969,6 +1692,18 @@ func ResourceJob() common.Resource {
 			if err != nil {
 				return err
 			}
+			// runs above the lowered limit are not interrupted, but we may wait for them to finish
+			oldRuns, newRuns := d.GetChange("max_concurrent_runs")
+			if d.Get("drain_active_runs").(bool) && newRuns.(int) < oldRuns.(int) {
+				jobID, err := parseJobId(d.Id())
+				if err != nil {
+					return err
+				}
+				err = jobsAPI.WaitForActiveRunsToDrain(jobID, newRuns.(int), d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return fmt.Errorf("cannot drain active runs: %w", err)
+				}
+			}
 			return getJobLifecycleManager(d, c).OnUpdate(ctx)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -983,5 +1718,6 @@ func ResourceJob() common.Resource {
 			}
 			return w.Jobs.DeleteByJobId(ctx, jobID)
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/libraries"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -107,6 +109,11 @@ func TestResourceJobCreate(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -229,6 +236,11 @@ func TestResourceJobCreate_MultiTask(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -393,6 +405,11 @@ func TestResourceJobCreate_TaskOrder(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -518,6 +535,11 @@ func TestResourceJobCreate_ConditionTask(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=231",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -581,6 +603,11 @@ func TestResourceJobCreate_ForEachTask(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -610,6 +637,722 @@ func TestResourceJobCreate_ForEachTask(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "789", d.Id())
 }
+
+func TestJobTaskSettingsValidate(t *testing.T) {
+	gitSource := &GitSource{
+		Url:      "https://github.com/databricks/terraform-provider-databricks",
+		Provider: "gitHub",
+		Branch:   "main",
+	}
+	pandas := []libraries.Library{{Pypi: &libraries.PyPi{Package: "pandas"}}}
+	for _, tc := range []struct {
+		name      string
+		task      JobTaskSettings
+		gitSource *GitSource
+		unknown   string
+		err       string
+	}{
+		{
+			name: "NotebookTask",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				NotebookTask:      &NotebookTask{NotebookPath: "/Stuff"},
+			},
+		},
+		{
+			name: "ForEachTaskWithCluster",
+			task: JobTaskSettings{
+				TaskKey:           "tables",
+				ExistingClusterID: "abc",
+				ForEachTask: &ForEachTask{
+					Inputs: "[1, 2]",
+					Task: ForEachNestedTask{
+						TaskKey:           "table",
+						ExistingClusterID: "abc",
+						NotebookTask:      &NotebookTask{NotebookPath: "/Stuff"},
+					},
+				},
+			},
+			err: "task tables has `for_each_task`, that runs on clusters of the nested task, " +
+				"so `existing_cluster_id`, `new_cluster`, `job_cluster_key` and `compute_key` can't be specified",
+		},
+		{
+			name: "ForEachTaskSameTaskKey",
+			task: JobTaskSettings{
+				TaskKey: "tables",
+				ForEachTask: &ForEachTask{
+					Inputs: "[1, 2]",
+					Task: ForEachNestedTask{
+						TaskKey:           "tables",
+						ExistingClusterID: "abc",
+						NotebookTask:      &NotebookTask{NotebookPath: "/Stuff"},
+					},
+				},
+			},
+			err: "task tables has `for_each_task` with a nested task with the same task_key",
+		},
+		{
+			name: "ForEachTaskNestedPipelineTaskWithCluster",
+			task: JobTaskSettings{
+				TaskKey: "tables",
+				ForEachTask: &ForEachTask{
+					Inputs: "[1, 2]",
+					Task: ForEachNestedTask{
+						TaskKey:           "table",
+						ExistingClusterID: "abc",
+						PipelineTask:      &PipelineTask{PipelineID: "abc"},
+					},
+				},
+			},
+			err: "task table has `pipeline_task`, that runs on clusters of the pipeline, " +
+				"so `existing_cluster_id`, `new_cluster`, `job_cluster_key` and `compute_key` can't be specified",
+		},
+		{
+			name: "ForEachTaskNestedTaskWithUnknownWarehouse",
+			task: JobTaskSettings{
+				TaskKey: "tables",
+				ForEachTask: &ForEachTask{
+					Inputs: "[1, 2]",
+					Task: ForEachNestedTask{
+						TaskKey: "table",
+						SqlTask: &SqlTask{Query: &SqlQueryTask{QueryID: "abc"}},
+					},
+				},
+			},
+			unknown: "for_each_task.0.task.0.sql_task.0.warehouse_id",
+		},
+		{
+			name: "TaskHealthDuplicateMetric",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				NotebookTask:      &NotebookTask{NotebookPath: "/Ingest"},
+				Health: &JobHealth{
+					Rules: []JobHealthRule{
+						{Metric: "RUN_DURATION_SECONDS", Operation: "GREATER_THAN", Value: 3600},
+						{Metric: "RUN_DURATION_SECONDS", Operation: "GREATER_THAN", Value: 7200},
+					},
+				},
+			},
+			err: "health of task a has more than one rule for RUN_DURATION_SECONDS",
+		},
+		{
+			name: "EnvironmentWithCluster",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				EnvironmentKey:    "default",
+				ExistingClusterID: "abc",
+				SparkPythonTask:   &SparkPythonTask{PythonFile: "/Workspace/a.py"},
+			},
+			err: "task a has `environment_key`, so it runs on serverless compute, " +
+				"and `existing_cluster_id`, `new_cluster`, `job_cluster_key` and `compute_key` can't be specified",
+		},
+		{
+			name: "EnvironmentWithLibrary",
+			task: JobTaskSettings{
+				TaskKey:         "a",
+				EnvironmentKey:  "default",
+				Libraries:       pandas,
+				SparkPythonTask: &SparkPythonTask{PythonFile: "/Workspace/a.py"},
+			},
+			err: "task a has `environment_key` with `library`, " +
+				"specify libraries in `dependencies` of the environment instead",
+		},
+		{
+			name: "PipelineTaskWithCluster",
+			task: JobTaskSettings{
+				TaskKey:           "ingest",
+				ExistingClusterID: "abc",
+				PipelineTask:      &PipelineTask{PipelineID: "123"},
+			},
+			err: "task ingest has `pipeline_task`, that runs on clusters of the pipeline, " +
+				"so `existing_cluster_id`, `new_cluster`, `job_cluster_key` and `compute_key` can't be specified",
+		},
+		{
+			name: "PipelineTaskWithLibraries",
+			task: JobTaskSettings{
+				TaskKey:      "ingest",
+				Libraries:    pandas,
+				PipelineTask: &PipelineTask{PipelineID: "123"},
+			},
+			err: "task ingest has `pipeline_task` with `library`, specify libraries in the pipeline instead",
+		},
+		{
+			name: "RunJobTaskWithCluster",
+			task: JobTaskSettings{
+				TaskKey:       "sales",
+				JobClusterKey: "j",
+				RunJobTask:    &RunJobTask{JobID: 123},
+			},
+			err: "task sales has `run_job_task`, that runs on clusters of the triggered job, " +
+				"so `existing_cluster_id`, `new_cluster`, `job_cluster_key` and `compute_key` can't be specified",
+		},
+		{
+			name: "DbtTaskWithoutGitSource",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				DbtTask:           &DbtTask{Commands: []string{"dbt run"}, Source: "GIT"},
+			},
+			err: "task a has `dbt_task` with `source = \"GIT\"`, but `git_source` isn't specified",
+		},
+		{
+			name: "DbtTaskWithGitSource",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				DbtTask:           &DbtTask{Commands: []string{"dbt run"}, Source: "GIT"},
+			},
+			gitSource: gitSource,
+		},
+		{
+			name: "DbtTaskWorkspaceWithoutProjectDirectory",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				DbtTask:           &DbtTask{Commands: []string{"dbt run"}, Source: "WORKSPACE"},
+			},
+			err: "task a has `dbt_task` with `source = \"WORKSPACE\"`, but `project_directory` isn't specified",
+		},
+		{
+			name: "DbtTaskWorkspaceWithUnknownProjectDirectory",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				DbtTask:           &DbtTask{Commands: []string{"dbt run"}, Source: "WORKSPACE"},
+			},
+			unknown: "dbt_task.0.project_directory",
+		},
+		{
+			name: "SqlTaskWithSeveralKinds",
+			task: JobTaskSettings{
+				TaskKey: "a",
+				SqlTask: &SqlTask{
+					WarehouseID: "abc",
+					Query:       &SqlQueryTask{QueryID: "q"},
+					File:        &SqlFileTask{Path: "/Users/me/report.sql"},
+				},
+			},
+			err: "task a has `sql_task` that must have exactly one of `query`, `dashboard`, " +
+				"`alert` or `file` blocks, but has 2",
+		},
+		{
+			name: "SqlTaskWithoutWarehouse",
+			task: JobTaskSettings{
+				TaskKey: "a",
+				SqlTask: &SqlTask{
+					Alert: &SqlAlertTask{
+						AlertID:       "x",
+						Subscriptions: []SqlSubscription{{UserName: "me@example.com"}},
+					},
+				},
+			},
+			err: "task a has `sql_task` without `warehouse_id`",
+		},
+		{
+			name: "SqlTaskFileFromGitWithoutGitSource",
+			task: JobTaskSettings{
+				TaskKey: "a",
+				SqlTask: &SqlTask{
+					WarehouseID: "abc",
+					File:        &SqlFileTask{Path: "queries/report.sql", Source: "GIT"},
+				},
+			},
+			err: "task a has `sql_task` with a `file` from `source = \"GIT\"`, but `git_source` isn't specified",
+		},
+		{
+			name: "NotebookTaskFromGitWithoutGitSource",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				NotebookTask:      &NotebookTask{NotebookPath: "notebooks/ingest", Source: "GIT"},
+			},
+			err: "task a has `notebook_task` with `source = \"GIT\"`, but `git_source` isn't specified",
+		},
+		{
+			name: "SparkPythonTaskFromGitWithoutGitSource",
+			task: JobTaskSettings{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				SparkPythonTask:   &SparkPythonTask{PythonFile: "jobs/ingest.py", Source: "GIT"},
+			},
+			err: "task a has `spark_python_task` with `source = \"GIT\"`, but `git_source` isn't specified",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.task.validate(func(path string) bool {
+				return path != tc.unknown
+			}, tc.gitSource)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestJobSettingsValidate(t *testing.T) {
+	notebook := func(key string, dependsOn ...string) JobTaskSettings {
+		task := JobTaskSettings{
+			TaskKey:           key,
+			ExistingClusterID: "abc",
+			NotebookTask:      &NotebookTask{NotebookPath: "/" + key},
+		}
+		for _, dep := range dependsOn {
+			task.DependsOn = append(task.DependsOn, jobs.TaskDependency{TaskKey: dep})
+		}
+		return task
+	}
+	jobCluster := JobCluster{
+		JobClusterKey: "j",
+		NewCluster: &clusters.Cluster{
+			SparkVersion: "a",
+			NodeTypeID:   "b",
+			NumWorkers:   1,
+		},
+	}
+	environment := JobEnvironment{
+		EnvironmentKey: "default",
+		Spec:           &JobEnvironmentSpec{Client: "1"},
+	}
+	for _, tc := range []struct {
+		name string
+		js   JobSettings
+		err  string
+	}{
+		{
+			name: "Tasks",
+			js: JobSettings{
+				MaxConcurrentRuns: 1,
+				Tasks:             []JobTaskSettings{notebook("a"), notebook("b", "a"), notebook("c", "a", "b")},
+			},
+		},
+		{
+			name: "JobParameters_UndefinedReference",
+			js: JobSettings{
+				Parameters: []jobs.JobParameterDefinition{{Name: "env", Default: "dev"}},
+				Tasks: []JobTaskSettings{{
+					TaskKey:           "a",
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Ingest",
+						BaseParameters: map[string]string{
+							"env":  "{{job.parameters.env}}",
+							"date": "{{ job.parameters.run_date }}",
+						},
+					},
+				}},
+			},
+			err: "task a refers to job parameter run_date, that isn't defined in a `parameter` block",
+		},
+		{
+			name: "JobParameters_Duplicate",
+			js: JobSettings{
+				Parameters: []jobs.JobParameterDefinition{
+					{Name: "env", Default: "dev"},
+					{Name: "env", Default: "prod"},
+				},
+			},
+			err: "job parameter env is defined more than once",
+		},
+		{
+			name: "JobHealthDuplicateMetric",
+			js: JobSettings{
+				Health: &JobHealth{
+					Rules: []JobHealthRule{
+						{Metric: "RUN_DURATION_SECONDS", Operation: "GREATER_THAN", Value: 3600},
+						{Metric: "RUN_DURATION_SECONDS", Operation: "GREATER_THAN", Value: 7200},
+					},
+				},
+			},
+			err: "health of job has more than one rule for RUN_DURATION_SECONDS",
+		},
+		{
+			name: "UnknownEnvironment",
+			js: JobSettings{
+				Environments: []JobEnvironment{environment},
+				Tasks: []JobTaskSettings{{
+					TaskKey:         "a",
+					EnvironmentKey:  "ml",
+					SparkPythonTask: &SparkPythonTask{PythonFile: "/Workspace/a.py"},
+				}},
+			},
+			err: "task a refers to unknown environment_key ml",
+		},
+		{
+			name: "DuplicateEnvironment",
+			js: JobSettings{
+				Environments: []JobEnvironment{environment, environment},
+			},
+			err: "environment_key default is used by more than one environment",
+		},
+		{
+			name: "ContinuousWithConcurrentRuns",
+			js: JobSettings{
+				MaxConcurrentRuns: 2,
+				Continuous:        &ContinuousConf{PauseStatus: "UNPAUSED"},
+			},
+			err: "`continuous` must be specified only with `max_concurrent_runs = 1`",
+		},
+		{
+			name: "QueueWithoutConcurrentRuns",
+			js: JobSettings{
+				Queue: &jobs.QueueSettings{Enabled: true},
+			},
+			err: "`queue` must be specified only with `max_concurrent_runs` of at least 1, " +
+				"otherwise no queued run ever starts",
+		},
+		{
+			name: "DuplicateTaskKey",
+			js: JobSettings{
+				Tasks: []JobTaskSettings{notebook("a"), notebook("a")},
+			},
+			err: "task_key a is used by more than one task",
+		},
+		{
+			name: "UnknownDependency",
+			js: JobSettings{
+				Tasks: []JobTaskSettings{notebook("a", "b")},
+			},
+			err: "task a depends on unknown task b",
+		},
+		{
+			name: "UnknownJobCluster",
+			js: JobSettings{
+				JobClusters: []JobCluster{jobCluster},
+				Tasks: []JobTaskSettings{{
+					TaskKey:       "a",
+					JobClusterKey: "k",
+					NotebookTask:  &NotebookTask{NotebookPath: "/a"},
+				}},
+			},
+			err: "task a refers to unknown job_cluster_key k",
+		},
+		{
+			name: "DuplicateJobCluster",
+			js: JobSettings{
+				JobClusters: []JobCluster{jobCluster, jobCluster},
+			},
+			err: "job_cluster_key j is used by more than one job_cluster",
+		},
+		{
+			name: "ForEachTaskUnknownJobCluster",
+			js: JobSettings{
+				JobClusters: []JobCluster{jobCluster},
+				Tasks: []JobTaskSettings{{
+					TaskKey: "a",
+					ForEachTask: &ForEachTask{
+						Inputs: "[1, 2]",
+						Task: ForEachNestedTask{
+							TaskKey:       "a_iteration",
+							JobClusterKey: "k",
+							NotebookTask:  &NotebookTask{NotebookPath: "/a"},
+						},
+					},
+				}},
+			},
+			err: "task a_iteration refers to unknown job_cluster_key k",
+		},
+		{
+			name: "DependencyCycle",
+			js: JobSettings{
+				Tasks: []JobTaskSettings{notebook("a"), notebook("b", "a", "c"), notebook("c", "b")},
+			},
+			err: "tasks form a dependency cycle: b -> c -> b",
+		},
+		{
+			name: "TaskValidation",
+			js: JobSettings{
+				Tasks: []JobTaskSettings{notebook("a"), {
+					TaskKey: "b",
+					SqlTask: &SqlTask{Query: &SqlQueryTask{QueryID: "q"}},
+				}},
+			},
+			err: "task b has `sql_task` without `warehouse_id`",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.js.validate(func(path string) bool {
+				return true
+			})
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestResourceJobCreate_InvalidConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		hcl  string
+		err  string
+	}{
+		{
+			name: "ForEachTaskInvalidConcurrency",
+			hcl: `
+			task {
+				task_key = "tables"
+				for_each_task {
+					inputs = "{{job.parameters.tables}}"
+					concurrency = 101
+					task {
+						task_key = "table"
+						existing_cluster_id = "abc"
+						notebook_task {
+							notebook_path = "/Stuff"
+						}
+					}
+				}
+			}`,
+			err: "invalid config supplied. [task.#.for_each_task.#.concurrency] " +
+				"expected concurrency to be in the range (1 - 100), got 101",
+		},
+		{
+			name: "ForEachTaskInvalidInputs",
+			hcl: `
+			task {
+				task_key = "tables"
+				for_each_task {
+					inputs = "a, b"
+					task {
+						task_key = "table"
+						existing_cluster_id = "abc"
+						notebook_task {
+							notebook_path = "/Stuff"
+						}
+					}
+				}
+			}`,
+			err: "invalid config supplied. [task.#.for_each_task.#.inputs] task.0.for_each_task.0.inputs must be a JSON array, " +
+				"like [a, b], or a reference, like {{job.parameters.tables}}, got: a, b",
+		},
+		{
+			name: "ForEachTaskNestedDbtTaskInvalidCommand",
+			hcl: `
+			task {
+				task_key = "tables"
+				for_each_task {
+					inputs = "[1, 2]"
+					task {
+						task_key = "table"
+						existing_cluster_id = "abc"
+						dbt_task {
+							commands = ["run"]
+						}
+					}
+				}
+			}`,
+			err: "invalid config supplied. [task.#.for_each_task.#.task.#.dbt_task.#.commands.#] invalid value for " +
+				"task.0.for_each_task.0.task.0.dbt_task.0.commands.0 (dbt commands must start with `dbt`, like `dbt run`)",
+		},
+		{
+			name: "ForEachTaskNestedRunJobTaskInvalidJobID",
+			hcl: `
+			task {
+				task_key = "tables"
+				for_each_task {
+					inputs = "[1, 2]"
+					task {
+						task_key = "table"
+						run_job_task {
+							job_id = 0
+						}
+					}
+				}
+			}`,
+			err: "invalid config supplied. [task.#.for_each_task.#.task.#.run_job_task.#.job_id] " +
+				"expected job_id to be at least (1), got 0",
+		},
+		{
+			name: "JobParameters_InvalidName",
+			hcl: `
+			parameter {
+				name = "run date"
+				default = "today"
+			}`,
+			err: "invalid config supplied. [parameter.#.name] invalid value for parameter.0.name " +
+				"(may only contain alphanumeric characters, `_`, `-` and `.`)",
+		},
+		{
+			name: "HealthRuleMissingValue",
+			hcl: `
+			health {
+				rules {
+					metric = "RUN_DURATION_SECONDS"
+					op = "GREATER_THAN"
+				}
+			}`,
+			err: "invalid config supplied. [health.#.rules.#.value] Missing required argument",
+		},
+		{
+			name: "HealthRuleInvalidOperation",
+			hcl: `
+			health {
+				rules {
+					metric = "RUN_DURATION_SECONDS"
+					op = "LESS_THAN"
+					value = 3600
+				}
+			}`,
+			err: "invalid config supplied. [health.#.rules.#.op] " +
+				"expected health.0.rules.0.op to be one of [GREATER_THAN], got LESS_THAN",
+		},
+		{
+			name: "TooManyWebhooks",
+			hcl: `
+			webhook_notifications {
+				on_failure {
+					id = "a"
+				}
+				on_failure {
+					id = "b"
+				}
+				on_failure {
+					id = "c"
+				}
+				on_failure {
+					id = "d"
+				}
+			}`,
+			err: "invalid config supplied. [webhook_notifications.#.on_failure] Too many list items",
+		},
+		{
+			name: "FileArrivalTriggerTooOften",
+			hcl: `
+			trigger {
+				file_arrival {
+					url = "s3://landing/orders/"
+					min_time_between_triggers_seconds = 30
+				}
+			}`,
+			err: "invalid config supplied. [trigger.#.file_arrival.#.min_time_between_triggers_seconds] " +
+				"trigger.0.file_arrival.0.min_time_between_triggers_seconds must be at least 60 seconds, got: 30",
+		},
+		{
+			name: "TableUpdateTriggerWithFileArrival",
+			hcl: `
+			trigger {
+				file_arrival {
+					url = "s3://landing/orders/"
+				}
+				table_update {
+					table_names = ["main.sales.orders"]
+				}
+			}`,
+			err: "invalid config supplied. [trigger.#.file_arrival] Invalid combination of arguments. " +
+				"[trigger.#.table_update] Invalid combination of arguments",
+		},
+		{
+			name: "TableUpdateTriggerInvalidCondition",
+			hcl: `
+			trigger {
+				table_update {
+					table_names = ["main.sales.orders"]
+					condition = "SOME_UPDATED"
+				}
+			}`,
+			err: "invalid config supplied. [trigger.#.table_update.#.condition] " +
+				"expected trigger.0.table_update.0.condition to be one of [ANY_UPDATED ALL_UPDATED], got SOME_UPDATED",
+		},
+		{
+			name: "RunJobTaskInvalidJobID",
+			hcl: `
+			task {
+				task_key = "sales"
+				run_job_task {
+					job_id = 0
+				}
+			}`,
+			err: "invalid config supplied. [task.#.run_job_task.#.job_id] expected job_id to be at least (1), got 0",
+		},
+		{
+			name: "DbtTaskInvalidCommand",
+			hcl: `
+			task {
+				task_key = "a"
+				existing_cluster_id = "abc"
+				dbt_task {
+					commands = ["run"]
+				}
+			}`,
+			err: "invalid config supplied. [task.#.dbt_task.#.commands.#] " +
+				"invalid value for task.0.dbt_task.0.commands.0 (dbt commands must start with `dbt`, like `dbt run`)",
+		},
+		{
+			name: "GitSourceWithoutRef",
+			hcl: `
+			git_source {
+				url = "https://github.com/databricks/terraform-provider-databricks"
+			}
+			task {
+				task_key = "b"
+				existing_cluster_id = "abc"
+				notebook_task {
+					notebook_path = "notebooks/ingest"
+				}
+			}`,
+			err: "invalid config supplied. " +
+				"[git_source.#.branch] Invalid combination of arguments. " +
+				"[git_source.#.commit] Invalid combination of arguments. " +
+				"[git_source.#.tag] Invalid combination of arguments",
+		},
+		{
+			name: "ClusterLogConfOutsideOfVolume",
+			hcl: `
+			task {
+				task_key = "a"
+				new_cluster {
+					spark_version = "a"
+					node_type_id = "b"
+					num_workers = 1
+					cluster_log_conf {
+						volumes {
+							destination = "/Volumes/main"
+						}
+					}
+				}
+			}`,
+			err: "invalid config supplied. " +
+				"[task.#.new_cluster.#.cluster_log_conf.#.volumes.#.destination] " +
+				"invalid value for task.0.new_cluster.0.cluster_log_conf.0.volumes.0.destination " +
+				"(cluster logs must be delivered to a Unity Catalog volume path, like /Volumes/main/default/logs)",
+		},
+		{
+			// checks of JobSettings.validate run in CustomizeDiff, with paths of nested tasks
+			name: "ForEachTaskNestedTaskValidation",
+			hcl: `
+			task {
+				task_key = "tables"
+				for_each_task {
+					inputs = "[1, 2]"
+					task {
+						task_key = "table"
+						sql_task {
+							query {
+								query_id = "abc"
+							}
+						}
+					}
+				}
+			}`,
+			err: "task table has `sql_task` without `warehouse_id`",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			qa.ResourceFixture{
+				Create:   true,
+				Resource: ResourceJob(),
+				HCL:      tc.hcl,
+			}.ExpectError(t, tc.err)
+		})
+	}
+}
+
 func TestResourceJobCreate_JobParameters(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -669,6 +1412,11 @@ func TestResourceJobCreate_JobParameters(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=231",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -741,9 +1489,14 @@ func TestResourceJobCreate_JobParameters_EmptyDefault(t *testing.T) {
 					},
 				},
 			},
-		},
-		Create:   true,
-		Resource: ResourceJob(),
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=231",
+				Response: JobRunsList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
 		HCL: `
 		name = "JobParameterTesting"
 
@@ -848,6 +1601,11 @@ func TestResourceJobCreate_JobClusters(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=17",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -944,6 +1702,11 @@ func TestResourceJobCreate_JobCompute(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=18",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -971,6 +1734,101 @@ func TestResourceJobCreate_JobCompute(t *testing.T) {
 	assert.Equal(t, "18", d.Id())
 }
 
+func TestResourceJobCreate_Environments(t *testing.T) {
+	serverlessSettings := JobSettings{
+		Name:              "serverless",
+		MaxConcurrentRuns: 1,
+		Environments: []JobEnvironment{
+			{
+				EnvironmentKey: "default",
+				Spec: &JobEnvironmentSpec{
+					Client:       "1",
+					Dependencies: []string{"pandas==2.2.2", "/Workspace/Shared/libs/etl-0.1-py3-none-any.whl"},
+				},
+			},
+		},
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey:        "ingest",
+				EnvironmentKey: "default",
+				SparkPythonTask: &SparkPythonTask{
+					PythonFile: "/Workspace/Shared/ingest.py",
+				},
+			},
+			{
+				TaskKey:        "load",
+				EnvironmentKey: "default",
+				DependsOn:      []jobs.TaskDependency{{TaskKey: "ingest"}},
+				PythonWheelTask: &PythonWheelTask{
+					PackageName: "etl",
+					EntryPoint:  "load",
+				},
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.1/jobs/create",
+				ExpectedRequest: serverlessSettings,
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID:    789,
+					Settings: &serverlessSettings,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "serverless"
+		environment {
+			environment_key = "default"
+			spec {
+				client = "1"
+				dependencies = ["pandas==2.2.2", "/Workspace/Shared/libs/etl-0.1-py3-none-any.whl"]
+			}
+		}
+		task {
+			task_key = "ingest"
+			environment_key = "default"
+			spark_python_task {
+				python_file = "/Workspace/Shared/ingest.py"
+			}
+		}
+		task {
+			task_key = "load"
+			environment_key = "default"
+			depends_on {
+				task_key = "ingest"
+			}
+			python_wheel_task {
+				package_name = "etl"
+				entry_point = "load"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                  "789",
+		"environment.0.environment_key":       "default",
+		"environment.0.spec.0.client":         "1",
+		"environment.0.spec.0.dependencies.#": 2,
+		"task.0.environment_key":              "default",
+		"task.1.environment_key":              "default",
+	})
+}
+
 func TestResourceJobCreate_SqlSubscriptions(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -1055,6 +1913,11 @@ func TestResourceJobCreate_SqlSubscriptions(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -1141,6 +2004,11 @@ func TestResourceJobCreate_RunJobTask(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=123",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -1192,6 +2060,11 @@ func TestResourceJobCreate_AlwaysRunning(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/jobs/run-now",
@@ -1250,180 +2123,708 @@ func TestResourceJobCreate_ControlRunState_AlwaysRunningConflict(t *testing.T) {
 	}.ExpectError(t, "invalid config supplied. [always_running] Conflicting configuration arguments. [control_run_state] Conflicting configuration arguments")
 }
 
-func TestResourceJobCreate_ControlRunState_NoContinuous(t *testing.T) {
-	qa.ResourceFixture{
-		Create:   true,
-		Resource: ResourceJob(),
-		HCL:      `control_run_state = true`,
-	}.ExpectError(t, "`control_run_state` must be specified only with `continuous`")
-}
-
-func TestResourceJobCreate_ControlRunState_ContinuousCreate(t *testing.T) {
+func TestResourceJobUpdate_ContinuousPause(t *testing.T) {
+	settings := JobSettings{
+		Name:              "Streaming",
+		MaxConcurrentRuns: 1,
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey:           "stream",
+				ExistingClusterID: "abc",
+				NotebookTask: &NotebookTask{
+					NotebookPath: "/Stream",
+				},
+			},
+		},
+		Continuous: &ContinuousConf{
+			PauseStatus: "PAUSED",
+		},
+	}
 	qa.ResourceFixture{
-		Create:   true,
-		Resource: ResourceJob(),
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "POST",
-				Resource: "/api/2.0/jobs/create",
-				ExpectedRequest: JobSettings{
-					MaxConcurrentRuns: 1,
-					Name:              "Test",
-					Continuous: &ContinuousConf{
-						PauseStatus: "UNPAUSED",
-					},
-				},
-				Response: Job{
-					JobID: 789,
+				Resource: "/api/2.1/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID:       789,
+					NewSettings: &settings,
 				},
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/jobs/get?job_id=789",
+				Resource: "/api/2.1/jobs/get?job_id=789",
 				Response: Job{
-					JobID: 789,
-					Settings: &JobSettings{
-						MaxConcurrentRuns: 1,
-						Name:              "Test",
-						Continuous: &ContinuousConf{
-							PauseStatus: "UNPAUSED",
-						},
-					},
+					JobID:    789,
+					Settings: &settings,
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		InstanceState: map[string]string{
+			"name":                                 "Streaming",
+			"max_concurrent_runs":                  "1",
+			"continuous.#":                         "1",
+			"continuous.0.pause_status":            "UNPAUSED",
+			"task.#":                               "1",
+			"task.0.task_key":                      "stream",
+			"task.0.existing_cluster_id":           "abc",
+			"task.0.notebook_task.#":               "1",
+			"task.0.notebook_task.0.notebook_path": "/Stream",
 		},
 		HCL: `
-		continuous {
-			pause_status = "UNPAUSED"
+		name = "Streaming"
+		task {
+			task_key = "stream"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stream"
+			}
 		}
-		control_run_state = true
-		max_concurrent_runs = 1
-		name = "Test"
-		`,
-	}.Apply(t)
+		continuous {
+			pause_status = "PAUSED"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"continuous.0.pause_status": "PAUSED",
+	})
 }
 
-func TestResourceJobCreate_ControlRunState_ContinuousUpdateRunNow(t *testing.T) {
+func TestResourceJobUpdate_KeepsQueueFromUI(t *testing.T) {
+	settings := JobSettings{
+		Name:              "Nightly",
+		MaxConcurrentRuns: 1,
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey:           "report",
+				ExistingClusterID: "abc",
+				NotebookTask: &NotebookTask{
+					NotebookPath: "/Report",
+				},
+			},
+		},
+		Queue: &jobs.QueueSettings{
+			Enabled: true,
+		},
+	}
 	qa.ResourceFixture{
-		Update:   true,
-		ID:       "789",
-		Resource: ResourceJob(),
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "POST",
-				Resource: "/api/2.0/jobs/reset",
+				Resource: "/api/2.1/jobs/reset",
 				ExpectedRequest: UpdateJobRequest{
-					JobID: 789,
-					NewSettings: &JobSettings{
-						MaxConcurrentRuns: 1,
-						Name:              "Test",
-						Continuous: &ContinuousConf{
-							PauseStatus: "UNPAUSED",
-						},
-					},
+					JobID:       789,
+					NewSettings: &settings,
 				},
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/jobs/get?job_id=789",
+				Resource: "/api/2.1/jobs/get?job_id=789",
 				Response: Job{
-					JobID: 789,
-					Settings: &JobSettings{
-						MaxConcurrentRuns: 1,
-						Name:              "Test",
-						Continuous: &ContinuousConf{
-							PauseStatus: "UNPAUSED",
-						},
-					},
+					JobID:    789,
+					Settings: &settings,
 				},
 			},
 			{
-				Method:   "POST",
-				Resource: "/api/2.0/jobs/run-now",
-				ExpectedRequest: RunParameters{
-					JobID: 789,
-				},
-				Response: JobRun{},
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
 			},
 		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		InstanceState: map[string]string{
+			"name":                                 "Daily",
+			"max_concurrent_runs":                  "1",
+			"queue.#":                              "1",
+			"queue.0.enabled":                      "true",
+			"task.#":                               "1",
+			"task.0.task_key":                      "report",
+			"task.0.existing_cluster_id":           "abc",
+			"task.0.notebook_task.#":               "1",
+			"task.0.notebook_task.0.notebook_path": "/Report",
+		},
 		HCL: `
-		continuous {
-			pause_status = "UNPAUSED"
-		}
-		control_run_state = true
-		max_concurrent_runs = 1
-		name = "Test"
-		`,
-	}.Apply(t)
+		name = "Nightly"
+		task {
+			task_key = "report"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Report"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"queue.0.enabled": true,
+	})
 }
 
-func TestResourceJobCreate_ControlRunState_ContinuousUpdateCancel(t *testing.T) {
-	qa.ResourceFixture{
-		Update:   true,
-		ID:       "789",
-		Resource: ResourceJob(),
-		Fixtures: []qa.HTTPFixture{
+func TestResourceJobCreate_FileArrivalTrigger(t *testing.T) {
+	settings := JobSettings{
+		Name:              "Ingest",
+		MaxConcurrentRuns: 1,
+		Tasks: []JobTaskSettings{
 			{
-				Method:   "POST",
-				Resource: "/api/2.0/jobs/reset",
-				ExpectedRequest: UpdateJobRequest{
-					JobID: 789,
-					NewSettings: &JobSettings{
-						MaxConcurrentRuns: 1,
-						Name:              "Test",
-						Continuous: &ContinuousConf{
-							PauseStatus: "UNPAUSED",
-						},
-					},
+				TaskKey:           "ingest",
+				ExistingClusterID: "abc",
+				NotebookTask: &NotebookTask{
+					NotebookPath: "/Ingest",
 				},
 			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/jobs/get?job_id=789",
-				Response: Job{
-					JobID: 789,
-					Settings: &JobSettings{
-						MaxConcurrentRuns: 1,
-						Name:              "Test",
-						Continuous: &ContinuousConf{
-							PauseStatus: "UNPAUSED",
-						},
-					},
-				},
+		},
+		Trigger: &Trigger{
+			FileArrival: &FileArrival{
+				URL:                           "s3://landing/orders/",
+				MinTimeBetweenTriggersSeconds: 300,
+				WaitAfterLastChangeSeconds:    60,
 			},
+			PauseStatus: "UNPAUSED",
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
 			{
-				Method:   "POST",
-				Resource: "/api/2.0/jobs/run-now",
-				ExpectedRequest: RunParameters{
+				Method:          "POST",
+				Resource:        "/api/2.1/jobs/create",
+				ExpectedRequest: settings,
+				Response: Job{
 					JobID: 789,
 				},
-				Response: apierr.APIError{StatusCode: 404},
-				Status:   404,
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
-				Response: JobRunsList{
-					Runs: []JobRun{
-						{
-							RunID: 567,
-						},
-					},
-				},
-			},
-			{
-				Method:   "POST",
-				Resource: "/api/2.0/jobs/runs/cancel",
-				ExpectedRequest: map[string]any{
-					"run_id": 567,
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID:    789,
+					Settings: &settings,
 				},
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/jobs/runs/get?run_id=567",
-				Response: JobRun{
-					RunID: 567,
-					State: RunState{
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Ingest"
+		task {
+			task_key = "ingest"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Ingest"
+			}
+		}
+		trigger {
+			file_arrival {
+				url = "s3://landing/orders/"
+				min_time_between_triggers_seconds = 300
+				wait_after_last_change_seconds = 60
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"trigger.0.pause_status":                                     "UNPAUSED",
+		"trigger.0.file_arrival.0.url":                               "s3://landing/orders/",
+		"trigger.0.file_arrival.0.min_time_between_triggers_seconds": 300,
+		"trigger.0.file_arrival.0.wait_after_last_change_seconds":    60,
+	})
+}
+
+func TestResourceJobCreate_TableUpdateTrigger(t *testing.T) {
+	settings := JobSettings{
+		Name:              "Aggregate",
+		MaxConcurrentRuns: 1,
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey:           "aggregate",
+				ExistingClusterID: "abc",
+				NotebookTask: &NotebookTask{
+					NotebookPath: "/Aggregate",
+				},
+			},
+		},
+		Trigger: &Trigger{
+			TableUpdate: &TableUpdate{
+				TableNames:                    []string{"main.sales.orders", "main.sales.customers"},
+				Condition:                     "ALL_UPDATED",
+				MinTimeBetweenTriggersSeconds: 600,
+			},
+			PauseStatus: "UNPAUSED",
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.1/jobs/create",
+				ExpectedRequest: settings,
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID:    789,
+					Settings: &settings,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Aggregate"
+		task {
+			task_key = "aggregate"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Aggregate"
+			}
+		}
+		trigger {
+			table_update {
+				table_names = ["main.sales.orders", "main.sales.customers"]
+				condition = "ALL_UPDATED"
+				min_time_between_triggers_seconds = 600
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"trigger.0.table_update.0.table_names.#":                     2,
+		"trigger.0.table_update.0.table_names.1":                     "main.sales.customers",
+		"trigger.0.table_update.0.condition":                         "ALL_UPDATED",
+		"trigger.0.table_update.0.min_time_between_triggers_seconds": 600,
+	})
+}
+
+func TestResourceJobCreate_ControlRunState_NoContinuous(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL:      `control_run_state = true`,
+	}.ExpectError(t, "`control_run_state` must be specified only with `continuous`")
+}
+
+func TestResourceJobCreate_DbtTask(t *testing.T) {
+	dbtSettings := JobSettings{
+		Name:              "dbt",
+		MaxConcurrentRuns: 1,
+		GitSource: &GitSource{
+			Url:      "https://github.com/example/analytics",
+			Provider: "gitHub",
+			Branch:   "main",
+		},
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey:           "a",
+				ExistingClusterID: "abc",
+				DbtTask: &DbtTask{
+					Commands:         []string{"dbt deps", "dbt run"},
+					ProjectDirectory: "analytics",
+					Catalog:          "main",
+					Schema:           "marts",
+					WarehouseId:      "def",
+					Source:           "GIT",
+				},
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.1/jobs/create",
+				ExpectedRequest: dbtSettings,
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID:    789,
+					Settings: &dbtSettings,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "dbt"
+		git_source {
+			url = "https://github.com/example/analytics"
+			provider = "gitHub"
+			branch = "main"
+		}
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			dbt_task {
+				commands = ["dbt deps", "dbt run"]
+				project_directory = "analytics"
+				catalog = "main"
+				schema = "marts"
+				warehouse_id = "def"
+				source = "GIT"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                           "789",
+		"task.0.dbt_task.0.commands.#": 2,
+		"task.0.dbt_task.0.source":     "GIT",
+	})
+}
+
+func TestResourceJobCreate_PipelineTask(t *testing.T) {
+	pipelineSettings := JobSettings{
+		Name:              "ingestion",
+		MaxConcurrentRuns: 1,
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey: "ingest",
+				PipelineTask: &PipelineTask{
+					PipelineID:  "123",
+					FullRefresh: true,
+				},
+			},
+			{
+				TaskKey:           "report",
+				ExistingClusterID: "abc",
+				DependsOn:         []jobs.TaskDependency{{TaskKey: "ingest"}},
+				NotebookTask: &NotebookTask{
+					NotebookPath: "/Shared/report",
+				},
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.1/jobs/create",
+				ExpectedRequest: pipelineSettings,
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID:    789,
+					Settings: &pipelineSettings,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "ingestion"
+		task {
+			task_key = "ingest"
+			pipeline_task {
+				pipeline_id = "123"
+				full_refresh = true
+			}
+		}
+		task {
+			task_key = "report"
+			existing_cluster_id = "abc"
+			depends_on {
+				task_key = "ingest"
+			}
+			notebook_task {
+				notebook_path = "/Shared/report"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                  "789",
+		"task.0.pipeline_task.0.pipeline_id":  "123",
+		"task.0.pipeline_task.0.full_refresh": true,
+		"task.1.depends_on.0.task_key":        "ingest",
+	})
+}
+
+func TestResourceJobCreate_RunJobTaskWithParameters(t *testing.T) {
+	orchestrationSettings := JobSettings{
+		Name:              "orchestration",
+		MaxConcurrentRuns: 1,
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey: "domain_a",
+				RunJobTask: &RunJobTask{
+					JobID: 123,
+					JobParameters: map[string]string{
+						"date": "{{job.start_time.iso_date}}",
+					},
+				},
+			},
+			{
+				TaskKey:   "domain_b",
+				DependsOn: []jobs.TaskDependency{{TaskKey: "domain_a"}},
+				RunJobTask: &RunJobTask{
+					JobID: 456,
+				},
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.1/jobs/create",
+				ExpectedRequest: orchestrationSettings,
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID:    789,
+					Settings: &orchestrationSettings,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "orchestration"
+		task {
+			task_key = "domain_a"
+			run_job_task {
+				job_id = 123
+				job_parameters = {
+					date = "{{job.start_time.iso_date}}"
+				}
+			}
+		}
+		task {
+			task_key = "domain_b"
+			depends_on {
+				task_key = "domain_a"
+			}
+			run_job_task {
+				job_id = 456
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                           "789",
+		"task.0.run_job_task.0.job_id": 123,
+		"task.0.run_job_task.0.job_parameters.date": "{{job.start_time.iso_date}}",
+		"task.1.run_job_task.0.job_id":              456,
+	})
+}
+
+func TestResourceJobCreate_ControlRunState_ContinuousCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/create",
+				ExpectedRequest: JobSettings{
+					MaxConcurrentRuns: 1,
+					Name:              "Test",
+					Continuous: &ContinuousConf{
+						PauseStatus: "UNPAUSED",
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						MaxConcurrentRuns: 1,
+						Name:              "Test",
+						Continuous: &ContinuousConf{
+							PauseStatus: "UNPAUSED",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		HCL: `
+		continuous {
+			pause_status = "UNPAUSED"
+		}
+		control_run_state = true
+		max_concurrent_runs = 1
+		name = "Test"
+		`,
+	}.Apply(t)
+}
+
+func TestResourceJobCreate_ControlRunState_ContinuousUpdateRunNow(t *testing.T) {
+	qa.ResourceFixture{
+		Update:   true,
+		ID:       "789",
+		Resource: ResourceJob(),
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 789,
+					NewSettings: &JobSettings{
+						MaxConcurrentRuns: 1,
+						Name:              "Test",
+						Continuous: &ContinuousConf{
+							PauseStatus: "UNPAUSED",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						MaxConcurrentRuns: 1,
+						Name:              "Test",
+						Continuous: &ContinuousConf{
+							PauseStatus: "UNPAUSED",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/run-now",
+				ExpectedRequest: RunParameters{
+					JobID: 789,
+				},
+				Response: JobRun{},
+			},
+		},
+		HCL: `
+		continuous {
+			pause_status = "UNPAUSED"
+		}
+		control_run_state = true
+		max_concurrent_runs = 1
+		name = "Test"
+		`,
+	}.Apply(t)
+}
+
+func TestResourceJobCreate_ControlRunState_ContinuousUpdateCancel(t *testing.T) {
+	qa.ResourceFixture{
+		Update:   true,
+		ID:       "789",
+		Resource: ResourceJob(),
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 789,
+					NewSettings: &JobSettings{
+						MaxConcurrentRuns: 1,
+						Name:              "Test",
+						Continuous: &ContinuousConf{
+							PauseStatus: "UNPAUSED",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						MaxConcurrentRuns: 1,
+						Name:              "Test",
+						Continuous: &ContinuousConf{
+							PauseStatus: "UNPAUSED",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/run-now",
+				ExpectedRequest: RunParameters{
+					JobID: 789,
+				},
+				Response: apierr.APIError{StatusCode: 404},
+				Status:   404,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{
+					Runs: []JobRun{
+						{
+							RunID: 567,
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/runs/cancel",
+				ExpectedRequest: map[string]any{
+					"run_id": 567,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/get?run_id=567",
+				Response: JobRun{
+					RunID: 567,
+					State: RunState{
 						LifeCycleState: "TERMINATED",
 					},
 				},
@@ -1489,6 +2890,11 @@ func TestResourceJobCreateSingleNode(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -1560,6 +2966,11 @@ func TestResourceJobCreateNWorkers(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -1643,6 +3054,11 @@ func TestResourceJobCreateWithWebhooks(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -1733,6 +3149,11 @@ func TestResourceJobCreateFromGitSource(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		Create:   true,
 		Resource: ResourceJob(),
@@ -1869,6 +3290,74 @@ func TestResourceJobCreateSingleNode_Fail(t *testing.T) {
 	require.Equal(t, true, strings.Contains(err.Error(), "NumWorkers could be 0 only for SingleNode clusters"))
 }
 
+func TestResourceJobCreate_TagsAndBudgetPolicy(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Chargeback",
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Stuff",
+					},
+					MaxConcurrentRuns: 1,
+					Tags: map[string]string{
+						"team": "finance",
+					},
+					BudgetPolicyID: "policy-123",
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Chargeback",
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
+						},
+						MaxConcurrentRuns: 1,
+						Tags: map[string]string{
+							"team": "finance",
+						},
+						BudgetPolicyID: "policy-123",
+					},
+					EffectiveBudgetPolicyID: "policy-123",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Chargeback"
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stuff"
+		}
+		tags = {
+			team = "finance"
+		}
+		budget_policy_id = "policy-123"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "789",
+		"tags.team":                  "finance",
+		"budget_policy_id":           "policy-123",
+		"effective_budget_policy_id": "policy-123",
+	})
+}
+
 func TestResourceJobRead(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -1899,6 +3388,13 @@ func TestResourceJobRead(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{
+					Runs: []JobRun{{RunID: 3}},
+				},
+			},
 		},
 		Resource: ResourceJob(),
 		Read:     true,
@@ -1908,6 +3404,7 @@ func TestResourceJobRead(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, "Featurizer", d.Get("name"))
+	assert.Equal(t, 1, d.Get("active_runs_count"))
 	assert.Equal(t, 2, d.Get("library.#"))
 	assert.Equal(t, "dbfs://ff/gg/hh.jar", d.Get("library.1850263921.jar"))
 	assert.Equal(t, "dbfs://aa/bb/cc.jar", d.Get("library.587400796.jar"))
@@ -1925,6 +3422,62 @@ func TestResourceJobRead(t *testing.T) {
 	assert.Equal(t, "abc", d.Get("existing_cluster_id"))
 }
 
+func TestResourceJobReadNotebookTaskTypedBaseParameters(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: json.RawMessage(`{
+					"job_id": 789,
+					"settings": {
+						"name": "Featurizer",
+						"existing_cluster_id": "abc",
+						"notebook_task": {
+							"notebook_path": "/Stuff",
+							"base_parameters": {
+								"env": "prod",
+								"batch_size": 100,
+								"ratio": 0.5,
+								"dry_run": false,
+								"empty": null
+							}
+						}
+					}
+				}`),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		Resource: ResourceJob(),
+		Read:     true,
+		New:      true,
+		ID:       "789",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", d.Get("notebook_task.0.base_parameters.env"))
+	assert.Equal(t, "100", d.Get("notebook_task.0.base_parameters.batch_size"))
+	assert.Equal(t, "0.5", d.Get("notebook_task.0.base_parameters.ratio"))
+	assert.Equal(t, "false", d.Get("notebook_task.0.base_parameters.dry_run"))
+	assert.Equal(t, "", d.Get("notebook_task.0.base_parameters.empty"))
+}
+
+func TestNotebookParametersUnmarshalJSON(t *testing.T) {
+	var task NotebookTask
+	err := json.Unmarshal([]byte(`{"notebook_path": "/a", "base_parameters": {"a": 1.0, "b": [1, 2], "c": "x"}}`), &task)
+	assert.NoError(t, err)
+	assert.Equal(t, NotebookParameters{"a": "1.0", "b": "[1,2]", "c": "x"}, task.BaseParameters)
+
+	err = json.Unmarshal([]byte(`{"notebook_path": "/a"}`), &task)
+	assert.NoError(t, err)
+
+	err = json.Unmarshal([]byte(`{"notebook_path": "/a", "base_parameters": "nope"}`), &task)
+	assert.Error(t, err)
+}
+
 func TestResourceJobRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -2025,6 +3578,11 @@ func TestResourceJobUpdate(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		ID:       "789",
 		Update:   true,
@@ -2113,6 +3671,11 @@ func TestResourceJobUpdate_NodeTypeToInstancePool(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		ID:       "789",
 		Update:   true,
@@ -2219,6 +3782,11 @@ func TestResourceJobUpdate_InstancePoolToNodeType(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		ID:       "789",
 		Update:   true,
@@ -2253,72 +3821,384 @@ func TestResourceJobUpdate_InstancePoolToNodeType(t *testing.T) {
 				spark_version = "spark-3"
 				num_workers = 3
 			}
-		}
-		max_concurrent_runs = 1
-		max_retries = 3
-		min_retry_interval_millis = 5000
-		name = "Featurizer New"
-		retry_on_timeout = true`,
-	}.Apply(t)
+		}
+		max_concurrent_runs = 1
+		max_retries = 3
+		min_retry_interval_millis = 5000
+		name = "Featurizer New"
+		retry_on_timeout = true`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "789", d.Id(), "Id should be the same as in reading")
+	assert.Equal(t, "Featurizer New", d.Get("name"))
+}
+
+func TestResourceJobUpdate_Tasks(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 789,
+					NewSettings: &JobSettings{
+						Name: "Featurizer New",
+						Tasks: []JobTaskSettings{
+							{
+								ExistingClusterID: "abc",
+								SparkJarTask: &SparkJarTask{
+									MainClassName: "com.labs.BarMain",
+								},
+							},
+						},
+						MaxConcurrentRuns: 1,
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								ExistingClusterID: "abc",
+								SparkJarTask: &SparkJarTask{
+									MainClassName: "com.labs.BarMain",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer New"
+		task {
+			existing_cluster_id = "abc"
+			spark_jar_task {
+				main_class_name = "com.labs.BarMain"
+			}
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceJobUpdate_SingleTaskInMultiTaskFormat(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 789,
+					NewSettings: &JobSettings{
+						Name:   "Featurizer",
+						Format: "MULTI_TASK",
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "main",
+								ExistingClusterID: "abc",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
+								},
+								MaxRetries: 3,
+							},
+						},
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:   "Featurizer",
+						Format: "MULTI_TASK",
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "main",
+								ExistingClusterID: "abc",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
+								},
+								MaxRetries: 3,
+							},
+						},
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		InstanceState: map[string]string{
+			"format":                     "MULTI_TASK",
+			"task.#":                     "1",
+			"task.0.task_key":            "main",
+			"task.0.existing_cluster_id": "abc",
+		},
+		HCL: `name = "Featurizer"
+		existing_cluster_id = "abc"
+		max_retries = 3
+		notebook_task {
+			notebook_path = "/Stuff"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "/Stuff", d.Get("notebook_task.0.notebook_path"))
+	assert.Equal(t, "abc", d.Get("existing_cluster_id"))
+	assert.Equal(t, 3, d.Get("max_retries"))
+	assert.Equal(t, 0, d.Get("task.#"))
+}
+
+func TestResourceJobUpdate_UpgradedSingleTask(t *testing.T) {
+	settings := JobSettings{
+		Name:   "Featurizer",
+		Format: "MULTI_TASK",
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey:           "main",
+				ExistingClusterID: "abc",
+				NotebookTask: &NotebookTask{
+					NotebookPath: "/Other",
+				},
+			},
+		},
+		MaxConcurrentRuns: 1,
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID:       789,
+					NewSettings: &settings,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID:    789,
+					Settings: &settings,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		InstanceState: map[string]string{
+			"name":                       "Featurizer",
+			"format":                     "SINGLE_TASK",
+			"task.#":                     "1",
+			"task.0.task_key":            "main",
+			"task.0.existing_cluster_id": "abc",
+		},
+		HCL: `name = "Featurizer"
+		task {
+			task_key = "main"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Other"
+			}
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestJobMigrateV2(t *testing.T) {
+	state, err := jobMigrateV2(context.Background(), map[string]any{
+		"id":                  "789",
+		"name":                "Featurizer",
+		"existing_cluster_id": "abc",
+		"max_retries":         3,
+		"timeout_seconds":     3600,
+		"notebook_task": []any{
+			map[string]any{"notebook_path": "/Stuff"},
+		},
+		"spark_jar_task": []any{},
+		"task":           []any{},
+	}, nil)
 	assert.NoError(t, err)
-	assert.Equal(t, "789", d.Id(), "Id should be the same as in reading")
-	assert.Equal(t, "Featurizer New", d.Get("name"))
+	assert.Equal(t, map[string]any{
+		"id":              "789",
+		"name":            "Featurizer",
+		"timeout_seconds": 3600,
+		"format":          "MULTI_TASK",
+		"task": []any{
+			map[string]any{
+				"task_key":            "main",
+				"existing_cluster_id": "abc",
+				"max_retries":         3,
+				"notebook_task": []any{
+					map[string]any{"notebook_path": "/Stuff"},
+				},
+				"spark_jar_task": []any{},
+			},
+		},
+	}, state)
 }
 
-func TestResourceJobUpdate_Tasks(t *testing.T) {
-	qa.ResourceFixture{
+func TestResourceJobRead_UpgradedSingleTask(t *testing.T) {
+	hcl := `name = "Featurizer"
+	task {
+		task_key = "main"
+		existing_cluster_id = "abc"
+		max_retries = 3
+		notebook_task {
+			notebook_path = "/Stuff"
+		}
+	}`
+	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			{
-				Method:   "POST",
-				Resource: "/api/2.1/jobs/reset",
-				ExpectedRequest: UpdateJobRequest{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
 					JobID: 789,
-					NewSettings: &JobSettings{
-						Name: "Featurizer New",
+					Settings: &JobSettings{
+						Name:   "Featurizer",
+						Format: "SINGLE_TASK",
 						Tasks: []JobTaskSettings{
 							{
+								TaskKey:           "Featurizer",
 								ExistingClusterID: "abc",
-								SparkJarTask: &SparkJarTask{
-									MainClassName: "com.labs.BarMain",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
 								},
+								MaxRetries: 3,
 							},
 						},
 						MaxConcurrentRuns: 1,
 					},
 				},
-				Response: Job{
-					JobID: 789,
-				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
+		},
+		ID:       "789",
+		Read:     true,
+		Resource: ResourceJob(),
+		// state after jobMigrateV2
+		InstanceState: map[string]string{
+			"name":                                 "Featurizer",
+			"format":                               "MULTI_TASK",
+			"task.#":                               "1",
+			"task.0.task_key":                      "main",
+			"task.0.existing_cluster_id":           "abc",
+			"task.0.max_retries":                   "3",
+			"task.0.notebook_task.#":               "1",
+			"task.0.notebook_task.0.notebook_path": "/Stuff",
+		},
+		HCL: hcl,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "main", d.Get("task.0.task_key"))
+	assert.Equal(t, "SINGLE_TASK", d.Get("format"))
+
+	// the migrated configuration plans without changes to the job, only the computed blocks, that
+	// the API didn't return, stay unknown
+	qa.ResourceFixture{
+		Resource:      ResourceJob(),
+		ID:            "789",
+		InstanceState: d.State().Attributes,
+		HCL:           hcl,
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"queue.#":  {NewComputed: true},
+			"run_as.#": {NewComputed: true},
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceJobRead_UpgradedSingleTaskTopLevel(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
 				Resource: "/api/2.1/jobs/get?job_id=789",
 				Response: Job{
+					JobID: 789,
 					Settings: &JobSettings{
-						Tasks: []JobTaskSettings{
-							{
-								ExistingClusterID: "abc",
-								SparkJarTask: &SparkJarTask{
-									MainClassName: "com.labs.BarMain",
-								},
-							},
+						Name:              "Featurizer",
+						Format:            "SINGLE_TASK",
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
 						},
+						MaxConcurrentRuns: 1,
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		ID:       "789",
-		Update:   true,
+		Read:     true,
 		Resource: ResourceJob(),
-		HCL: `
-		name = "Featurizer New"
+		InstanceState: map[string]string{
+			"name":                       "Featurizer",
+			"format":                     "MULTI_TASK",
+			"task.#":                     "1",
+			"task.0.task_key":            "main",
+			"task.0.existing_cluster_id": "abc",
+		},
+		HCL: `name = "Featurizer"
 		task {
+			task_key = "main"
 			existing_cluster_id = "abc"
-			spark_jar_task {
-				main_class_name = "com.labs.BarMain"
+			notebook_task {
+				notebook_path = "/Stuff"
 			}
 		}`,
-	}.ApplyNoError(t)
+	}.ApplyAndExpectData(t, map[string]any{
+		"format":                               "SINGLE_TASK",
+		"task.0.task_key":                      "main",
+		"task.0.existing_cluster_id":           "abc",
+		"task.0.notebook_task.0.notebook_path": "/Stuff",
+		"existing_cluster_id":                  "",
+	})
+}
+
+func TestJobMigrateV2_MultiTask(t *testing.T) {
+	raw := map[string]any{
+		"name": "Featurizer",
+		"task": []any{
+			map[string]any{"task_key": "a"},
+		},
+	}
+	state, err := jobMigrateV2(context.Background(), raw, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, raw, state)
 }
 
 func TestResourceJobUpdate_Restart(t *testing.T) {
@@ -2346,9 +4226,10 @@ func TestResourceJobUpdate_Restart(t *testing.T) {
 				},
 			},
 			{
-				Method:   "GET",
-				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
-				Response: JobRunsList{},
+				Method:       "GET",
+				Resource:     "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response:     JobRunsList{},
+				ReuseRequest: true,
 			},
 			{
 				Method:   "POST",
@@ -2383,6 +4264,120 @@ func TestResourceJobUpdate_Restart(t *testing.T) {
 	assert.Equal(t, "Featurizer New", d.Get("name"))
 }
 
+func TestResourceJobUpdate_DrainActiveRuns(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 789,
+					NewSettings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{
+					Runs:    []JobRun{{RunID: 1}, {RunID: 2}},
+					HasMore: true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789&offset=2",
+				Response: JobRunsList{
+					Runs: []JobRun{{RunID: 3}},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{
+					Runs: []JobRun{{RunID: 3}},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{
+					Runs: []JobRun{{RunID: 3}},
+				},
+			},
+		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		InstanceState: map[string]string{
+			"name":                "Featurizer",
+			"max_concurrent_runs": "3",
+			"drain_active_runs":   "true",
+		},
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		drain_active_runs = true
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, d.Get("max_concurrent_runs"))
+	assert.Equal(t, 1, d.Get("active_runs_count"))
+}
+
+func TestResourceJobUpdate_DrainActiveRunsError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 789,
+					NewSettings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=789",
+				Status:   400,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "INVALID_REQUEST",
+					Message:   "nope",
+				},
+			},
+		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		InstanceState: map[string]string{
+			"name":                "Featurizer",
+			"max_concurrent_runs": "3",
+			"drain_active_runs":   "true",
+		},
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		drain_active_runs = true
+		`,
+	}.ExpectError(t, "cannot drain active runs: nope")
+}
+
 func TestJobRestarts(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
@@ -2679,6 +4674,102 @@ func TestJobsAPIListByName(t *testing.T) {
 	})
 }
 
+func TestJobsAPIReadMultiplePages(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/get?job_id=789",
+			Response: map[string]any{
+				"job_id": 789,
+				"settings": map[string]any{
+					"name": "Large",
+					"tasks": []map[string]any{
+						{"task_key": "c"},
+						{"task_key": "a"},
+					},
+					"job_clusters": []map[string]any{
+						{"job_cluster_key": "x"},
+					},
+					"environments": []map[string]any{
+						{"environment_key": "default"},
+					},
+				},
+				"next_page_token": "bbbb",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/get?job_id=789&page_token=bbbb",
+			Response: map[string]any{
+				"job_id": 789,
+				"settings": map[string]any{
+					"name": "Large",
+					"tasks": []map[string]any{
+						{"task_key": "b"},
+					},
+					"job_clusters": []map[string]any{
+						{"job_cluster_key": "y"},
+					},
+					"environments": []map[string]any{
+						{"environment_key": "ml"},
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		a := NewJobsAPI(ctx, client)
+		job, err := a.Read("789")
+		require.NoError(t, err)
+		assert.Equal(t, "Large", job.Settings.Name)
+		keys := []string{}
+		for _, task := range job.Settings.Tasks {
+			keys = append(keys, task.TaskKey)
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, keys)
+		assert.Len(t, job.Settings.JobClusters, 2)
+		require.Len(t, job.Settings.Environments, 2)
+		assert.Equal(t, "ml", job.Settings.Environments[1].EnvironmentKey)
+	})
+}
+
+func TestJobsAPIListByNameTruncatedTasks(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/list?expand_tasks=true&limit=25&name=test",
+			Response: map[string]any{
+				"jobs": []map[string]any{
+					{
+						"job_id": 1,
+						"settings": map[string]any{
+							"name":  "test",
+							"tasks": []map[string]any{{"task_key": "a"}},
+						},
+						"has_more": true,
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/get?job_id=1",
+			Response: map[string]any{
+				"job_id": 1,
+				"settings": map[string]any{
+					"name":  "test",
+					"tasks": []map[string]any{{"task_key": "a"}, {"task_key": "b"}},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		a := NewJobsAPI(ctx, client)
+		l, err := a.ListByName("test", true)
+		require.NoError(t, err)
+		require.Len(t, l, 1)
+		assert.Len(t, l[0].Settings.Tasks, 2)
+	})
+}
+
 func TestJobsAPIRunsList(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
@@ -2725,3 +4816,45 @@ func TestJobResource_SparkConfDiffSuppress(t *testing.T) {
 	assert.True(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "0", nil))
 	assert.False(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "1", nil))
 }
+
+func TestResourceJobCreate_InvalidInitScripts(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Init scripts"
+		job_cluster {
+			job_cluster_key = "j"
+			new_cluster {
+				spark_version = "a"
+				node_type_id = "b"
+				num_workers = 1
+				init_scripts {
+					volumes {
+						destination = "/Volumes/main/init.sh"
+					}
+				}
+			}
+		}
+		task {
+			task_key = "a"
+			new_cluster {
+				spark_version = "a"
+				node_type_id = "b"
+				num_workers = 1
+				init_scripts {
+					workspace {
+						destination = "Users/me/init.sh"
+					}
+				}
+			}
+		}`,
+	}.Apply(t)
+	// errors of different blocks are reported in no particular order
+	assert.ErrorContains(t, err, "[job_cluster.#.new_cluster.#.init_scripts.#.volumes.#.destination] "+
+		"invalid value for job_cluster.0.new_cluster.0.init_scripts.0.volumes.0.destination "+
+		"(volumes init script must be a file in a Unity Catalog volume, like /Volumes/main/default/scripts/init.sh)")
+	assert.ErrorContains(t, err, "[task.#.new_cluster.#.init_scripts.#.workspace.#.destination] "+
+		"invalid value for task.0.new_cluster.0.init_scripts.0.workspace.0.destination "+
+		"(workspace init script must be an absolute path, like /Users/me@example.com/init.sh)")
+}
@@ -0,0 +1,936 @@
+package jobs
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// jobSchemaV2 is the type of databricks_job state with schema version 2, before single-task jobs were moved into
+// a task block. It's frozen, so that changes to jobSchema don't change how older states are read.
+func jobSchemaV2() cty.Type {
+	return cty.Object(map[string]cty.Type{
+		"active_runs_count": cty.Number,
+		"always_running":    cty.Bool,
+		"budget_policy_id":  cty.String,
+		"compute": cty.List(cty.Object(map[string]cty.Type{
+			"compute_key": cty.String,
+			"spec": cty.List(cty.Object(map[string]cty.Type{
+				"kind": cty.String,
+			})),
+		})),
+		"continuous": cty.List(cty.Object(map[string]cty.Type{
+			"pause_status": cty.String,
+		})),
+		"control_run_state": cty.Bool,
+		"dbt_task": cty.List(cty.Object(map[string]cty.Type{
+			"catalog":            cty.String,
+			"commands":           cty.List(cty.String),
+			"profiles_directory": cty.String,
+			"project_directory":  cty.String,
+			"schema":             cty.String,
+			"source":             cty.String,
+			"warehouse_id":       cty.String,
+		})),
+		"deployment": cty.List(cty.Object(map[string]cty.Type{
+			"kind":               cty.String,
+			"metadata_file_path": cty.String,
+		})),
+		"description":                cty.String,
+		"drain_active_runs":          cty.Bool,
+		"edit_mode":                  cty.String,
+		"effective_budget_policy_id": cty.String,
+		"email_notifications": cty.List(cty.Object(map[string]cty.Type{
+			"no_alert_for_skipped_runs":              cty.Bool,
+			"on_duration_warning_threshold_exceeded": cty.List(cty.String),
+			"on_failure":                             cty.List(cty.String),
+			"on_start":                               cty.List(cty.String),
+			"on_success":                             cty.List(cty.String),
+		})),
+		"existing_cluster_id": cty.String,
+		"format":              cty.String,
+		"git_source": cty.List(cty.Object(map[string]cty.Type{
+			"branch": cty.String,
+			"commit": cty.String,
+			"job_source": cty.List(cty.Object(map[string]cty.Type{
+				"dirty_state":            cty.String,
+				"import_from_git_branch": cty.String,
+				"job_config_path":        cty.String,
+			})),
+			"provider": cty.String,
+			"tag":      cty.String,
+			"url":      cty.String,
+		})),
+		"health": cty.List(cty.Object(map[string]cty.Type{
+			"rules": cty.List(cty.Object(map[string]cty.Type{
+				"metric": cty.String,
+				"op":     cty.String,
+				"value":  cty.Number,
+			})),
+		})),
+		"id": cty.String,
+		"job_cluster": cty.List(cty.Object(map[string]cty.Type{
+			"job_cluster_key": cty.String,
+			"new_cluster": cty.List(cty.Object(map[string]cty.Type{
+				"apply_policy_default_values": cty.Bool,
+				"autoscale": cty.List(cty.Object(map[string]cty.Type{
+					"max_workers": cty.Number,
+					"min_workers": cty.Number,
+				})),
+				"autotermination_minutes": cty.Number,
+				"aws_attributes": cty.List(cty.Object(map[string]cty.Type{
+					"availability":           cty.String,
+					"ebs_volume_count":       cty.Number,
+					"ebs_volume_size":        cty.Number,
+					"ebs_volume_type":        cty.String,
+					"first_on_demand":        cty.Number,
+					"instance_profile_arn":   cty.String,
+					"spot_bid_price_percent": cty.Number,
+					"zone_id":                cty.String,
+				})),
+				"azure_attributes": cty.List(cty.Object(map[string]cty.Type{
+					"availability":       cty.String,
+					"first_on_demand":    cty.Number,
+					"spot_bid_max_price": cty.Number,
+				})),
+				"cluster_id": cty.String,
+				"cluster_log_conf": cty.List(cty.Object(map[string]cty.Type{
+					"dbfs": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"s3": cty.List(cty.Object(map[string]cty.Type{
+						"canned_acl":        cty.String,
+						"destination":       cty.String,
+						"enable_encryption": cty.Bool,
+						"encryption_type":   cty.String,
+						"endpoint":          cty.String,
+						"kms_key":           cty.String,
+						"region":            cty.String,
+					})),
+					"volumes": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+				})),
+				"cluster_mount_info": cty.List(cty.Object(map[string]cty.Type{
+					"local_mount_dir_path": cty.String,
+					"network_filesystem_info": cty.List(cty.Object(map[string]cty.Type{
+						"mount_options":  cty.String,
+						"server_address": cty.String,
+					})),
+					"remote_mount_dir_path": cty.String,
+				})),
+				"cluster_name":       cty.String,
+				"custom_tags":        cty.Map(cty.String),
+				"data_security_mode": cty.String,
+				"docker_image": cty.List(cty.Object(map[string]cty.Type{
+					"basic_auth": cty.List(cty.Object(map[string]cty.Type{
+						"password": cty.String,
+						"username": cty.String,
+					})),
+					"url": cty.String,
+				})),
+				"driver_instance_pool_id":      cty.String,
+				"driver_node_type_id":          cty.String,
+				"enable_elastic_disk":          cty.Bool,
+				"enable_local_disk_encryption": cty.Bool,
+				"gcp_attributes": cty.List(cty.Object(map[string]cty.Type{
+					"availability":              cty.String,
+					"boot_disk_size":            cty.Number,
+					"google_service_account":    cty.String,
+					"local_ssd_count":           cty.Number,
+					"use_preemptible_executors": cty.Bool,
+					"zone_id":                   cty.String,
+				})),
+				"idempotency_token": cty.String,
+				"init_scripts": cty.List(cty.Object(map[string]cty.Type{
+					"abfss": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"dbfs": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"file": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"gcs": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"s3": cty.List(cty.Object(map[string]cty.Type{
+						"canned_acl":        cty.String,
+						"destination":       cty.String,
+						"enable_encryption": cty.Bool,
+						"encryption_type":   cty.String,
+						"endpoint":          cty.String,
+						"kms_key":           cty.String,
+						"region":            cty.String,
+					})),
+					"volumes": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"workspace": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+				})),
+				"instance_pool_id": cty.String,
+				"node_type_id":     cty.String,
+				"num_workers":      cty.Number,
+				"policy_id":        cty.String,
+				"runtime_engine":   cty.String,
+				"single_user_name": cty.String,
+				"spark_conf":       cty.Map(cty.String),
+				"spark_env_vars":   cty.Map(cty.String),
+				"spark_version":    cty.String,
+				"ssh_public_keys":  cty.List(cty.String),
+				"workload_type": cty.List(cty.Object(map[string]cty.Type{
+					"clients": cty.List(cty.Object(map[string]cty.Type{
+						"jobs":      cty.Bool,
+						"notebooks": cty.Bool,
+					})),
+				})),
+			})),
+		})),
+		"library": cty.Set(cty.Object(map[string]cty.Type{
+			"cran": cty.List(cty.Object(map[string]cty.Type{
+				"package": cty.String,
+				"repo":    cty.String,
+			})),
+			"egg": cty.String,
+			"jar": cty.String,
+			"maven": cty.List(cty.Object(map[string]cty.Type{
+				"coordinates": cty.String,
+				"exclusions":  cty.List(cty.String),
+				"repo":        cty.String,
+			})),
+			"pypi": cty.List(cty.Object(map[string]cty.Type{
+				"package": cty.String,
+				"repo":    cty.String,
+			})),
+			"whl": cty.String,
+		})),
+		"max_concurrent_runs":       cty.Number,
+		"max_retries":               cty.Number,
+		"min_retry_interval_millis": cty.Number,
+		"name":                      cty.String,
+		"new_cluster": cty.List(cty.Object(map[string]cty.Type{
+			"apply_policy_default_values": cty.Bool,
+			"autoscale": cty.List(cty.Object(map[string]cty.Type{
+				"max_workers": cty.Number,
+				"min_workers": cty.Number,
+			})),
+			"autotermination_minutes": cty.Number,
+			"aws_attributes": cty.List(cty.Object(map[string]cty.Type{
+				"availability":           cty.String,
+				"ebs_volume_count":       cty.Number,
+				"ebs_volume_size":        cty.Number,
+				"ebs_volume_type":        cty.String,
+				"first_on_demand":        cty.Number,
+				"instance_profile_arn":   cty.String,
+				"spot_bid_price_percent": cty.Number,
+				"zone_id":                cty.String,
+			})),
+			"azure_attributes": cty.List(cty.Object(map[string]cty.Type{
+				"availability":       cty.String,
+				"first_on_demand":    cty.Number,
+				"spot_bid_max_price": cty.Number,
+			})),
+			"cluster_id": cty.String,
+			"cluster_log_conf": cty.List(cty.Object(map[string]cty.Type{
+				"dbfs": cty.List(cty.Object(map[string]cty.Type{
+					"destination": cty.String,
+				})),
+				"s3": cty.List(cty.Object(map[string]cty.Type{
+					"canned_acl":        cty.String,
+					"destination":       cty.String,
+					"enable_encryption": cty.Bool,
+					"encryption_type":   cty.String,
+					"endpoint":          cty.String,
+					"kms_key":           cty.String,
+					"region":            cty.String,
+				})),
+				"volumes": cty.List(cty.Object(map[string]cty.Type{
+					"destination": cty.String,
+				})),
+			})),
+			"cluster_mount_info": cty.List(cty.Object(map[string]cty.Type{
+				"local_mount_dir_path": cty.String,
+				"network_filesystem_info": cty.List(cty.Object(map[string]cty.Type{
+					"mount_options":  cty.String,
+					"server_address": cty.String,
+				})),
+				"remote_mount_dir_path": cty.String,
+			})),
+			"cluster_name":       cty.String,
+			"custom_tags":        cty.Map(cty.String),
+			"data_security_mode": cty.String,
+			"docker_image": cty.List(cty.Object(map[string]cty.Type{
+				"basic_auth": cty.List(cty.Object(map[string]cty.Type{
+					"password": cty.String,
+					"username": cty.String,
+				})),
+				"url": cty.String,
+			})),
+			"driver_instance_pool_id":      cty.String,
+			"driver_node_type_id":          cty.String,
+			"enable_elastic_disk":          cty.Bool,
+			"enable_local_disk_encryption": cty.Bool,
+			"gcp_attributes": cty.List(cty.Object(map[string]cty.Type{
+				"availability":              cty.String,
+				"boot_disk_size":            cty.Number,
+				"google_service_account":    cty.String,
+				"local_ssd_count":           cty.Number,
+				"use_preemptible_executors": cty.Bool,
+				"zone_id":                   cty.String,
+			})),
+			"idempotency_token": cty.String,
+			"init_scripts": cty.List(cty.Object(map[string]cty.Type{
+				"abfss": cty.List(cty.Object(map[string]cty.Type{
+					"destination": cty.String,
+				})),
+				"dbfs": cty.List(cty.Object(map[string]cty.Type{
+					"destination": cty.String,
+				})),
+				"file": cty.List(cty.Object(map[string]cty.Type{
+					"destination": cty.String,
+				})),
+				"gcs": cty.List(cty.Object(map[string]cty.Type{
+					"destination": cty.String,
+				})),
+				"s3": cty.List(cty.Object(map[string]cty.Type{
+					"canned_acl":        cty.String,
+					"destination":       cty.String,
+					"enable_encryption": cty.Bool,
+					"encryption_type":   cty.String,
+					"endpoint":          cty.String,
+					"kms_key":           cty.String,
+					"region":            cty.String,
+				})),
+				"volumes": cty.List(cty.Object(map[string]cty.Type{
+					"destination": cty.String,
+				})),
+				"workspace": cty.List(cty.Object(map[string]cty.Type{
+					"destination": cty.String,
+				})),
+			})),
+			"instance_pool_id": cty.String,
+			"node_type_id":     cty.String,
+			"num_workers":      cty.Number,
+			"policy_id":        cty.String,
+			"runtime_engine":   cty.String,
+			"single_user_name": cty.String,
+			"spark_conf":       cty.Map(cty.String),
+			"spark_env_vars":   cty.Map(cty.String),
+			"spark_version":    cty.String,
+			"ssh_public_keys":  cty.List(cty.String),
+			"workload_type": cty.List(cty.Object(map[string]cty.Type{
+				"clients": cty.List(cty.Object(map[string]cty.Type{
+					"jobs":      cty.Bool,
+					"notebooks": cty.Bool,
+				})),
+			})),
+		})),
+		"notebook_task": cty.List(cty.Object(map[string]cty.Type{
+			"base_parameters": cty.Map(cty.String),
+			"notebook_path":   cty.String,
+			"source":          cty.String,
+		})),
+		"notification_settings": cty.List(cty.Object(map[string]cty.Type{
+			"no_alert_for_canceled_runs": cty.Bool,
+			"no_alert_for_skipped_runs":  cty.Bool,
+		})),
+		"parameter": cty.List(cty.Object(map[string]cty.Type{
+			"default": cty.String,
+			"name":    cty.String,
+		})),
+		"pipeline_task": cty.List(cty.Object(map[string]cty.Type{
+			"full_refresh": cty.Bool,
+			"pipeline_id":  cty.String,
+		})),
+		"python_wheel_task": cty.List(cty.Object(map[string]cty.Type{
+			"entry_point":      cty.String,
+			"named_parameters": cty.Map(cty.String),
+			"package_name":     cty.String,
+			"parameters":       cty.List(cty.String),
+		})),
+		"queue": cty.List(cty.Object(map[string]cty.Type{
+			"enabled": cty.Bool,
+		})),
+		"retry_on_timeout": cty.Bool,
+		"run_as": cty.List(cty.Object(map[string]cty.Type{
+			"service_principal_name": cty.String,
+			"user_name":              cty.String,
+		})),
+		"run_job_task": cty.List(cty.Object(map[string]cty.Type{
+			"job_id":         cty.Number,
+			"job_parameters": cty.Map(cty.String),
+		})),
+		"schedule": cty.List(cty.Object(map[string]cty.Type{
+			"pause_status":           cty.String,
+			"quartz_cron_expression": cty.String,
+			"timezone_id":            cty.String,
+		})),
+		"spark_jar_task": cty.List(cty.Object(map[string]cty.Type{
+			"jar_uri":         cty.String,
+			"main_class_name": cty.String,
+			"parameters":      cty.List(cty.String),
+		})),
+		"spark_python_task": cty.List(cty.Object(map[string]cty.Type{
+			"parameters":  cty.List(cty.String),
+			"python_file": cty.String,
+			"source":      cty.String,
+		})),
+		"spark_submit_task": cty.List(cty.Object(map[string]cty.Type{
+			"parameters": cty.List(cty.String),
+		})),
+		"tags": cty.Map(cty.String),
+		"task": cty.List(cty.Object(map[string]cty.Type{
+			"compute_key": cty.String,
+			"condition_task": cty.List(cty.Object(map[string]cty.Type{
+				"left":  cty.String,
+				"op":    cty.String,
+				"right": cty.String,
+			})),
+			"dbt_task": cty.List(cty.Object(map[string]cty.Type{
+				"catalog":            cty.String,
+				"commands":           cty.List(cty.String),
+				"profiles_directory": cty.String,
+				"project_directory":  cty.String,
+				"schema":             cty.String,
+				"source":             cty.String,
+				"warehouse_id":       cty.String,
+			})),
+			"depends_on": cty.List(cty.Object(map[string]cty.Type{
+				"outcome":  cty.String,
+				"task_key": cty.String,
+			})),
+			"description": cty.String,
+			"email_notifications": cty.List(cty.Object(map[string]cty.Type{
+				"on_duration_warning_threshold_exceeded": cty.List(cty.String),
+				"on_failure":                             cty.List(cty.String),
+				"on_start":                               cty.List(cty.String),
+				"on_success":                             cty.List(cty.String),
+			})),
+			"existing_cluster_id": cty.String,
+			"for_each_task": cty.List(cty.Object(map[string]cty.Type{
+				"concurrency": cty.Number,
+				"inputs":      cty.String,
+				"task": cty.List(cty.Object(map[string]cty.Type{
+					"compute_key": cty.String,
+					"condition_task": cty.List(cty.Object(map[string]cty.Type{
+						"left":  cty.String,
+						"op":    cty.String,
+						"right": cty.String,
+					})),
+					"dbt_task": cty.List(cty.Object(map[string]cty.Type{
+						"catalog":            cty.String,
+						"commands":           cty.List(cty.String),
+						"profiles_directory": cty.String,
+						"project_directory":  cty.String,
+						"schema":             cty.String,
+						"source":             cty.String,
+						"warehouse_id":       cty.String,
+					})),
+					"depends_on": cty.List(cty.Object(map[string]cty.Type{
+						"outcome":  cty.String,
+						"task_key": cty.String,
+					})),
+					"description": cty.String,
+					"email_notifications": cty.List(cty.Object(map[string]cty.Type{
+						"on_duration_warning_threshold_exceeded": cty.List(cty.String),
+						"on_failure":                             cty.List(cty.String),
+						"on_start":                               cty.List(cty.String),
+						"on_success":                             cty.List(cty.String),
+					})),
+					"existing_cluster_id": cty.String,
+					"health": cty.List(cty.Object(map[string]cty.Type{
+						"rules": cty.List(cty.Object(map[string]cty.Type{
+							"metric": cty.String,
+							"op":     cty.String,
+							"value":  cty.Number,
+						})),
+					})),
+					"job_cluster_key": cty.String,
+					"library": cty.Set(cty.Object(map[string]cty.Type{
+						"cran": cty.List(cty.Object(map[string]cty.Type{
+							"package": cty.String,
+							"repo":    cty.String,
+						})),
+						"egg": cty.String,
+						"jar": cty.String,
+						"maven": cty.List(cty.Object(map[string]cty.Type{
+							"coordinates": cty.String,
+							"exclusions":  cty.List(cty.String),
+							"repo":        cty.String,
+						})),
+						"pypi": cty.List(cty.Object(map[string]cty.Type{
+							"package": cty.String,
+							"repo":    cty.String,
+						})),
+						"whl": cty.String,
+					})),
+					"max_retries":               cty.Number,
+					"min_retry_interval_millis": cty.Number,
+					"new_cluster": cty.List(cty.Object(map[string]cty.Type{
+						"apply_policy_default_values": cty.Bool,
+						"autoscale": cty.List(cty.Object(map[string]cty.Type{
+							"max_workers": cty.Number,
+							"min_workers": cty.Number,
+						})),
+						"autotermination_minutes": cty.Number,
+						"aws_attributes": cty.List(cty.Object(map[string]cty.Type{
+							"availability":           cty.String,
+							"ebs_volume_count":       cty.Number,
+							"ebs_volume_size":        cty.Number,
+							"ebs_volume_type":        cty.String,
+							"first_on_demand":        cty.Number,
+							"instance_profile_arn":   cty.String,
+							"spot_bid_price_percent": cty.Number,
+							"zone_id":                cty.String,
+						})),
+						"azure_attributes": cty.List(cty.Object(map[string]cty.Type{
+							"availability":       cty.String,
+							"first_on_demand":    cty.Number,
+							"spot_bid_max_price": cty.Number,
+						})),
+						"cluster_id": cty.String,
+						"cluster_log_conf": cty.List(cty.Object(map[string]cty.Type{
+							"dbfs": cty.List(cty.Object(map[string]cty.Type{
+								"destination": cty.String,
+							})),
+							"s3": cty.List(cty.Object(map[string]cty.Type{
+								"canned_acl":        cty.String,
+								"destination":       cty.String,
+								"enable_encryption": cty.Bool,
+								"encryption_type":   cty.String,
+								"endpoint":          cty.String,
+								"kms_key":           cty.String,
+								"region":            cty.String,
+							})),
+							"volumes": cty.List(cty.Object(map[string]cty.Type{
+								"destination": cty.String,
+							})),
+						})),
+						"cluster_mount_info": cty.List(cty.Object(map[string]cty.Type{
+							"local_mount_dir_path": cty.String,
+							"network_filesystem_info": cty.List(cty.Object(map[string]cty.Type{
+								"mount_options":  cty.String,
+								"server_address": cty.String,
+							})),
+							"remote_mount_dir_path": cty.String,
+						})),
+						"cluster_name":       cty.String,
+						"custom_tags":        cty.Map(cty.String),
+						"data_security_mode": cty.String,
+						"docker_image": cty.List(cty.Object(map[string]cty.Type{
+							"basic_auth": cty.List(cty.Object(map[string]cty.Type{
+								"password": cty.String,
+								"username": cty.String,
+							})),
+							"url": cty.String,
+						})),
+						"driver_instance_pool_id":      cty.String,
+						"driver_node_type_id":          cty.String,
+						"enable_elastic_disk":          cty.Bool,
+						"enable_local_disk_encryption": cty.Bool,
+						"gcp_attributes": cty.List(cty.Object(map[string]cty.Type{
+							"availability":              cty.String,
+							"boot_disk_size":            cty.Number,
+							"google_service_account":    cty.String,
+							"local_ssd_count":           cty.Number,
+							"use_preemptible_executors": cty.Bool,
+							"zone_id":                   cty.String,
+						})),
+						"idempotency_token": cty.String,
+						"init_scripts": cty.List(cty.Object(map[string]cty.Type{
+							"abfss": cty.List(cty.Object(map[string]cty.Type{
+								"destination": cty.String,
+							})),
+							"dbfs": cty.List(cty.Object(map[string]cty.Type{
+								"destination": cty.String,
+							})),
+							"file": cty.List(cty.Object(map[string]cty.Type{
+								"destination": cty.String,
+							})),
+							"gcs": cty.List(cty.Object(map[string]cty.Type{
+								"destination": cty.String,
+							})),
+							"s3": cty.List(cty.Object(map[string]cty.Type{
+								"canned_acl":        cty.String,
+								"destination":       cty.String,
+								"enable_encryption": cty.Bool,
+								"encryption_type":   cty.String,
+								"endpoint":          cty.String,
+								"kms_key":           cty.String,
+								"region":            cty.String,
+							})),
+							"volumes": cty.List(cty.Object(map[string]cty.Type{
+								"destination": cty.String,
+							})),
+							"workspace": cty.List(cty.Object(map[string]cty.Type{
+								"destination": cty.String,
+							})),
+						})),
+						"instance_pool_id": cty.String,
+						"node_type_id":     cty.String,
+						"num_workers":      cty.Number,
+						"policy_id":        cty.String,
+						"runtime_engine":   cty.String,
+						"single_user_name": cty.String,
+						"spark_conf":       cty.Map(cty.String),
+						"spark_env_vars":   cty.Map(cty.String),
+						"spark_version":    cty.String,
+						"ssh_public_keys":  cty.List(cty.String),
+						"workload_type": cty.List(cty.Object(map[string]cty.Type{
+							"clients": cty.List(cty.Object(map[string]cty.Type{
+								"jobs":      cty.Bool,
+								"notebooks": cty.Bool,
+							})),
+						})),
+					})),
+					"notebook_task": cty.List(cty.Object(map[string]cty.Type{
+						"base_parameters": cty.Map(cty.String),
+						"notebook_path":   cty.String,
+						"source":          cty.String,
+					})),
+					"notification_settings": cty.List(cty.Object(map[string]cty.Type{
+						"alert_on_last_attempt":      cty.Bool,
+						"no_alert_for_canceled_runs": cty.Bool,
+						"no_alert_for_skipped_runs":  cty.Bool,
+					})),
+					"pipeline_task": cty.List(cty.Object(map[string]cty.Type{
+						"full_refresh": cty.Bool,
+						"pipeline_id":  cty.String,
+					})),
+					"python_wheel_task": cty.List(cty.Object(map[string]cty.Type{
+						"entry_point":      cty.String,
+						"named_parameters": cty.Map(cty.String),
+						"package_name":     cty.String,
+						"parameters":       cty.List(cty.String),
+					})),
+					"retry_on_timeout": cty.Bool,
+					"run_if":           cty.String,
+					"run_job_task": cty.List(cty.Object(map[string]cty.Type{
+						"job_id":         cty.Number,
+						"job_parameters": cty.Map(cty.String),
+					})),
+					"spark_jar_task": cty.List(cty.Object(map[string]cty.Type{
+						"jar_uri":         cty.String,
+						"main_class_name": cty.String,
+						"parameters":      cty.List(cty.String),
+					})),
+					"spark_python_task": cty.List(cty.Object(map[string]cty.Type{
+						"parameters":  cty.List(cty.String),
+						"python_file": cty.String,
+						"source":      cty.String,
+					})),
+					"spark_submit_task": cty.List(cty.Object(map[string]cty.Type{
+						"parameters": cty.List(cty.String),
+					})),
+					"sql_task": cty.List(cty.Object(map[string]cty.Type{
+						"alert": cty.List(cty.Object(map[string]cty.Type{
+							"alert_id":            cty.String,
+							"pause_subscriptions": cty.Bool,
+							"subscriptions": cty.List(cty.Object(map[string]cty.Type{
+								"destination_id": cty.String,
+								"user_name":      cty.String,
+							})),
+						})),
+						"dashboard": cty.List(cty.Object(map[string]cty.Type{
+							"custom_subject":      cty.String,
+							"dashboard_id":        cty.String,
+							"pause_subscriptions": cty.Bool,
+							"subscriptions": cty.List(cty.Object(map[string]cty.Type{
+								"destination_id": cty.String,
+								"user_name":      cty.String,
+							})),
+						})),
+						"file": cty.List(cty.Object(map[string]cty.Type{
+							"path":   cty.String,
+							"source": cty.String,
+						})),
+						"parameters": cty.Map(cty.String),
+						"query": cty.List(cty.Object(map[string]cty.Type{
+							"query_id": cty.String,
+						})),
+						"warehouse_id": cty.String,
+					})),
+					"task_key":        cty.String,
+					"timeout_seconds": cty.Number,
+					"webhook_notifications": cty.List(cty.Object(map[string]cty.Type{
+						"on_duration_warning_threshold_exceeded": cty.List(cty.Object(map[string]cty.Type{
+							"id": cty.String,
+						})),
+						"on_failure": cty.List(cty.Object(map[string]cty.Type{
+							"id": cty.String,
+						})),
+						"on_start": cty.List(cty.Object(map[string]cty.Type{
+							"id": cty.String,
+						})),
+						"on_success": cty.List(cty.Object(map[string]cty.Type{
+							"id": cty.String,
+						})),
+					})),
+				})),
+			})),
+			"health": cty.List(cty.Object(map[string]cty.Type{
+				"rules": cty.List(cty.Object(map[string]cty.Type{
+					"metric": cty.String,
+					"op":     cty.String,
+					"value":  cty.Number,
+				})),
+			})),
+			"job_cluster_key": cty.String,
+			"library": cty.Set(cty.Object(map[string]cty.Type{
+				"cran": cty.List(cty.Object(map[string]cty.Type{
+					"package": cty.String,
+					"repo":    cty.String,
+				})),
+				"egg": cty.String,
+				"jar": cty.String,
+				"maven": cty.List(cty.Object(map[string]cty.Type{
+					"coordinates": cty.String,
+					"exclusions":  cty.List(cty.String),
+					"repo":        cty.String,
+				})),
+				"pypi": cty.List(cty.Object(map[string]cty.Type{
+					"package": cty.String,
+					"repo":    cty.String,
+				})),
+				"whl": cty.String,
+			})),
+			"max_retries":               cty.Number,
+			"min_retry_interval_millis": cty.Number,
+			"new_cluster": cty.List(cty.Object(map[string]cty.Type{
+				"apply_policy_default_values": cty.Bool,
+				"autoscale": cty.List(cty.Object(map[string]cty.Type{
+					"max_workers": cty.Number,
+					"min_workers": cty.Number,
+				})),
+				"autotermination_minutes": cty.Number,
+				"aws_attributes": cty.List(cty.Object(map[string]cty.Type{
+					"availability":           cty.String,
+					"ebs_volume_count":       cty.Number,
+					"ebs_volume_size":        cty.Number,
+					"ebs_volume_type":        cty.String,
+					"first_on_demand":        cty.Number,
+					"instance_profile_arn":   cty.String,
+					"spot_bid_price_percent": cty.Number,
+					"zone_id":                cty.String,
+				})),
+				"azure_attributes": cty.List(cty.Object(map[string]cty.Type{
+					"availability":       cty.String,
+					"first_on_demand":    cty.Number,
+					"spot_bid_max_price": cty.Number,
+				})),
+				"cluster_id": cty.String,
+				"cluster_log_conf": cty.List(cty.Object(map[string]cty.Type{
+					"dbfs": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"s3": cty.List(cty.Object(map[string]cty.Type{
+						"canned_acl":        cty.String,
+						"destination":       cty.String,
+						"enable_encryption": cty.Bool,
+						"encryption_type":   cty.String,
+						"endpoint":          cty.String,
+						"kms_key":           cty.String,
+						"region":            cty.String,
+					})),
+					"volumes": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+				})),
+				"cluster_mount_info": cty.List(cty.Object(map[string]cty.Type{
+					"local_mount_dir_path": cty.String,
+					"network_filesystem_info": cty.List(cty.Object(map[string]cty.Type{
+						"mount_options":  cty.String,
+						"server_address": cty.String,
+					})),
+					"remote_mount_dir_path": cty.String,
+				})),
+				"cluster_name":       cty.String,
+				"custom_tags":        cty.Map(cty.String),
+				"data_security_mode": cty.String,
+				"docker_image": cty.List(cty.Object(map[string]cty.Type{
+					"basic_auth": cty.List(cty.Object(map[string]cty.Type{
+						"password": cty.String,
+						"username": cty.String,
+					})),
+					"url": cty.String,
+				})),
+				"driver_instance_pool_id":      cty.String,
+				"driver_node_type_id":          cty.String,
+				"enable_elastic_disk":          cty.Bool,
+				"enable_local_disk_encryption": cty.Bool,
+				"gcp_attributes": cty.List(cty.Object(map[string]cty.Type{
+					"availability":              cty.String,
+					"boot_disk_size":            cty.Number,
+					"google_service_account":    cty.String,
+					"local_ssd_count":           cty.Number,
+					"use_preemptible_executors": cty.Bool,
+					"zone_id":                   cty.String,
+				})),
+				"idempotency_token": cty.String,
+				"init_scripts": cty.List(cty.Object(map[string]cty.Type{
+					"abfss": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"dbfs": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"file": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"gcs": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"s3": cty.List(cty.Object(map[string]cty.Type{
+						"canned_acl":        cty.String,
+						"destination":       cty.String,
+						"enable_encryption": cty.Bool,
+						"encryption_type":   cty.String,
+						"endpoint":          cty.String,
+						"kms_key":           cty.String,
+						"region":            cty.String,
+					})),
+					"volumes": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+					"workspace": cty.List(cty.Object(map[string]cty.Type{
+						"destination": cty.String,
+					})),
+				})),
+				"instance_pool_id": cty.String,
+				"node_type_id":     cty.String,
+				"num_workers":      cty.Number,
+				"policy_id":        cty.String,
+				"runtime_engine":   cty.String,
+				"single_user_name": cty.String,
+				"spark_conf":       cty.Map(cty.String),
+				"spark_env_vars":   cty.Map(cty.String),
+				"spark_version":    cty.String,
+				"ssh_public_keys":  cty.List(cty.String),
+				"workload_type": cty.List(cty.Object(map[string]cty.Type{
+					"clients": cty.List(cty.Object(map[string]cty.Type{
+						"jobs":      cty.Bool,
+						"notebooks": cty.Bool,
+					})),
+				})),
+			})),
+			"notebook_task": cty.List(cty.Object(map[string]cty.Type{
+				"base_parameters": cty.Map(cty.String),
+				"notebook_path":   cty.String,
+				"source":          cty.String,
+			})),
+			"notification_settings": cty.List(cty.Object(map[string]cty.Type{
+				"alert_on_last_attempt":      cty.Bool,
+				"no_alert_for_canceled_runs": cty.Bool,
+				"no_alert_for_skipped_runs":  cty.Bool,
+			})),
+			"pipeline_task": cty.List(cty.Object(map[string]cty.Type{
+				"full_refresh": cty.Bool,
+				"pipeline_id":  cty.String,
+			})),
+			"python_wheel_task": cty.List(cty.Object(map[string]cty.Type{
+				"entry_point":      cty.String,
+				"named_parameters": cty.Map(cty.String),
+				"package_name":     cty.String,
+				"parameters":       cty.List(cty.String),
+			})),
+			"retry_on_timeout": cty.Bool,
+			"run_if":           cty.String,
+			"run_job_task": cty.List(cty.Object(map[string]cty.Type{
+				"job_id":         cty.Number,
+				"job_parameters": cty.Map(cty.String),
+			})),
+			"spark_jar_task": cty.List(cty.Object(map[string]cty.Type{
+				"jar_uri":         cty.String,
+				"main_class_name": cty.String,
+				"parameters":      cty.List(cty.String),
+			})),
+			"spark_python_task": cty.List(cty.Object(map[string]cty.Type{
+				"parameters":  cty.List(cty.String),
+				"python_file": cty.String,
+				"source":      cty.String,
+			})),
+			"spark_submit_task": cty.List(cty.Object(map[string]cty.Type{
+				"parameters": cty.List(cty.String),
+			})),
+			"sql_task": cty.List(cty.Object(map[string]cty.Type{
+				"alert": cty.List(cty.Object(map[string]cty.Type{
+					"alert_id":            cty.String,
+					"pause_subscriptions": cty.Bool,
+					"subscriptions": cty.List(cty.Object(map[string]cty.Type{
+						"destination_id": cty.String,
+						"user_name":      cty.String,
+					})),
+				})),
+				"dashboard": cty.List(cty.Object(map[string]cty.Type{
+					"custom_subject":      cty.String,
+					"dashboard_id":        cty.String,
+					"pause_subscriptions": cty.Bool,
+					"subscriptions": cty.List(cty.Object(map[string]cty.Type{
+						"destination_id": cty.String,
+						"user_name":      cty.String,
+					})),
+				})),
+				"file": cty.List(cty.Object(map[string]cty.Type{
+					"path":   cty.String,
+					"source": cty.String,
+				})),
+				"parameters": cty.Map(cty.String),
+				"query": cty.List(cty.Object(map[string]cty.Type{
+					"query_id": cty.String,
+				})),
+				"warehouse_id": cty.String,
+			})),
+			"task_key":        cty.String,
+			"timeout_seconds": cty.Number,
+			"webhook_notifications": cty.List(cty.Object(map[string]cty.Type{
+				"on_duration_warning_threshold_exceeded": cty.List(cty.Object(map[string]cty.Type{
+					"id": cty.String,
+				})),
+				"on_failure": cty.List(cty.Object(map[string]cty.Type{
+					"id": cty.String,
+				})),
+				"on_start": cty.List(cty.Object(map[string]cty.Type{
+					"id": cty.String,
+				})),
+				"on_success": cty.List(cty.Object(map[string]cty.Type{
+					"id": cty.String,
+				})),
+			})),
+		})),
+		"timeout_seconds": cty.Number,
+		"timeouts": cty.Object(map[string]cty.Type{
+			"create": cty.String,
+			"update": cty.String,
+		}),
+		"trigger": cty.List(cty.Object(map[string]cty.Type{
+			"file_arrival": cty.List(cty.Object(map[string]cty.Type{
+				"min_time_between_triggers_seconds": cty.Number,
+				"url":                               cty.String,
+				"wait_after_last_change_seconds":    cty.Number,
+			})),
+			"pause_status": cty.String,
+			"table_update": cty.List(cty.Object(map[string]cty.Type{
+				"condition":                         cty.String,
+				"min_time_between_triggers_seconds": cty.Number,
+				"table_names":                       cty.List(cty.String),
+				"wait_after_last_change_seconds":    cty.Number,
+			})),
+		})),
+		"url": cty.String,
+		"webhook_notifications": cty.List(cty.Object(map[string]cty.Type{
+			"on_duration_warning_threshold_exceeded": cty.List(cty.Object(map[string]cty.Type{
+				"id": cty.String,
+			})),
+			"on_failure": cty.List(cty.Object(map[string]cty.Type{
+				"id": cty.String,
+			})),
+			"on_start": cty.List(cty.Object(map[string]cty.Type{
+				"id": cty.String,
+			})),
+			"on_success": cty.List(cty.Object(map[string]cty.Type{
+				"id": cty.String,
+			})),
+		})),
+	})
+}
@@ -54,6 +54,11 @@ func TestResourceJobUpdate_WebhookNotifications(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=789",
+				Response: JobRunsList{},
+			},
 		},
 		ID:       "789",
 		Update:   true,
@@ -78,7 +78,7 @@ func (a LibrariesAPI) UpdateLibraries(clusterID string, add, remove ClusterLibra
 
 // clusterID string, timeout time.Duration, isActive bool, refresh bool
 func (a LibrariesAPI) WaitForLibrariesInstalled(wait Wait) (result *ClusterLibraryStatuses, err error) {
-	err = resource.RetryContext(a.context, wait.Timeout, func() *resource.RetryError {
+	err = common.RetryContext(a.context, wait.Timeout, func() *resource.RetryError {
 		libsClusterStatus, err := a.ClusterStatus(wait.ClusterID)
 		if err != nil {
 			var apiErr *apierr.APIError
@@ -316,6 +316,21 @@ func (cls ClusterLibraryStatuses) ToLibraryList() ClusterLibraryList {
 	return cll
 }
 
+// Only returns statuses of the given libraries, skipping the ones installed by other means
+func (cls ClusterLibraryStatuses) Only(libs []Library) ClusterLibraryStatuses {
+	keys := map[string]bool{}
+	for _, lib := range libs {
+		keys[lib.String()] = true
+	}
+	filtered := ClusterLibraryStatuses{ClusterID: cls.ClusterID}
+	for _, status := range cls.LibraryStatuses {
+		if keys[status.Library.String()] {
+			filtered.LibraryStatuses = append(filtered.LibraryStatuses, status)
+		}
+	}
+	return filtered
+}
+
 // IsRetryNeeded returns first bool if there needs to be retry.
 // If there needs to be retry, error message will explain why.
 // If retry does not need to happen and error is not nil - it failed.
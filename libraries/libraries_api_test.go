@@ -252,6 +252,25 @@ func TestClusterLibraryStatuses_ToLibraryList(t *testing.T) {
 	assert.Equal(t, "abc/jar:a", cll.String())
 }
 
+func TestClusterLibraryStatuses_Only(t *testing.T) {
+	cll := ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Library: &Library{
+					Jar: "a",
+				},
+			},
+			{
+				Library: &Library{
+					Whl: "b",
+				},
+			},
+		},
+	}.Only([]Library{{Whl: "b"}, {Egg: "c"}}).ToLibraryList()
+	assert.Equal(t, "abc/whl:b", cll.String())
+}
+
 func TestClusterLibraryStatuses_NoNeedAllClusters(t *testing.T) {
 	need, err := ClusterLibraryStatuses{
 		ClusterID: "abc",
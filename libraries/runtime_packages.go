@@ -0,0 +1,57 @@
+package libraries
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// runtimeProvidedPackages lists Python packages, that are part of every Databricks Runtime. The provider
+// doesn't know which versions a particular runtime ships, so any package with such a name is reported,
+// regardless of the requested version.
+var runtimeProvidedPackages = map[string]bool{
+	"pyspark":            true,
+	"py4j":               true,
+	"delta-spark":        true,
+	"databricks-connect": true,
+}
+
+// pypiPackageName matches the name of the package in requirement specifiers, like `pandas[excel]>=2.0`
+var pypiPackageName = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)`)
+
+var pypiNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// normalizePypiPackageName returns the package name from the requirement specifier as defined by PEP 503
+func normalizePypiPackageName(requirement string) string {
+	m := pypiPackageName.FindStringSubmatch(requirement)
+	if m == nil {
+		return ""
+	}
+	return strings.ToLower(pypiNameSeparators.ReplaceAllString(m[1], "-"))
+}
+
+// ValidateRuntimeProvidedPackage warns during the plan about PyPI packages that replace packages
+// provided by the Databricks Runtime
+func ValidateRuntimeProvidedPackage(i any, path cty.Path) diag.Diagnostics {
+	requirement, ok := i.(string)
+	if !ok {
+		return nil
+	}
+	name := normalizePypiPackageName(requirement)
+	if !runtimeProvidedPackages[name] {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s is provided by the Databricks Runtime", name),
+			Detail: fmt.Sprintf("Installing %s from PyPI replaces the package that comes with the "+
+				"Databricks Runtime, which may break the cluster environment. Remove the library "+
+				"to use the package of the runtime.", requirement),
+			AttributePath: path,
+		},
+	}
+}
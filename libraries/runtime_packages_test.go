@@ -0,0 +1,30 @@
+package libraries
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePypiPackageName(t *testing.T) {
+	assert.Equal(t, "pyspark", normalizePypiPackageName("PySpark==3.5.0"))
+	assert.Equal(t, "delta-spark", normalizePypiPackageName("delta_spark>=3.0"))
+	assert.Equal(t, "pandas", normalizePypiPackageName("pandas[excel] ; python_version > '3.8'"))
+	assert.Equal(t, "", normalizePypiPackageName("==1.0"))
+}
+
+func TestValidateRuntimeProvidedPackage(t *testing.T) {
+	path := cty.GetAttrPath("library")
+	diags := ValidateRuntimeProvidedPackage("pyspark==3.3.0", path)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "pyspark is provided by the Databricks Runtime", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "Installing pyspark==3.3.0 from PyPI replaces the package")
+	assert.Equal(t, path, diags[0].AttributePath)
+	assert.False(t, diags.HasError())
+
+	assert.Len(t, ValidateRuntimeProvidedPackage("pyspark-stubs", path), 0)
+	assert.Len(t, ValidateRuntimeProvidedPackage("requests", path), 0)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/exporter"
 	"github.com/databricks/terraform-provider-databricks/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
@@ -36,7 +37,9 @@ https://registry.terraform.io/providers/databricks/databricks/latest/docs
 
 `, common.Version())
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.DatabricksProvider,
+		GRPCProviderFunc: func() tfprotov5.ProviderServer {
+			return common.NewDeleteLimitServer(provider.DatabricksProvider())
+		},
 		ProviderAddr: "registry.terraform.io/databricks/databricks",
 		Debug:        debug,
 	})
@@ -102,5 +102,6 @@ func ResourceMlflowExperiment() common.Resource {
 		Schema:         s,
 		SchemaVersion:  0,
 		Timeouts:       &schema.ResourceTimeout{},
+		WorkspaceLevel: true,
 	}
 }
@@ -21,6 +21,7 @@ func ResourceMlflowModel() common.Resource {
 		})
 
 	return common.Resource{
+		WorkspaceLevel: true,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -11,7 +11,7 @@ func DataSourceMwsCredentials() common.Resource {
 	type mwsCredentialsData struct {
 		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
 	}
-	return common.DataResource(mwsCredentialsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+	r := common.DataResource(mwsCredentialsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*mwsCredentialsData)
 		if c.Config.AccountID == "" {
 			return fmt.Errorf("provider block is missing `account_id` property")
@@ -26,4 +26,6 @@ func DataSourceMwsCredentials() common.Resource {
 		}
 		return nil
 	})
+	r.AccountLevel = true
+	return r
 }
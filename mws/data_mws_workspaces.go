@@ -7,11 +7,22 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+type mwsWorkspaceInfo struct {
+	WorkspaceID     int64  `json:"workspace_id" tf:"computed"`
+	WorkspaceName   string `json:"workspace_name" tf:"computed"`
+	DeploymentName  string `json:"deployment_name,omitempty" tf:"computed"`
+	WorkspaceStatus string `json:"workspace_status,omitempty" tf:"computed"`
+	WorkspaceURL    string `json:"workspace_url,omitempty" tf:"computed"`
+	Cloud           string `json:"cloud,omitempty" tf:"computed"`
+	Location        string `json:"location,omitempty" tf:"computed"`
+}
+
 func DataSourceMwsWorkspaces() common.Resource {
 	type mwsWorkspacesData struct {
-		Ids map[string]int64 `json:"ids,omitempty" tf:"computed"`
+		Ids        map[string]int64   `json:"ids,omitempty" tf:"computed"`
+		Workspaces []mwsWorkspaceInfo `json:"workspaces,omitempty" tf:"computed"`
 	}
-	return common.DataResource(mwsWorkspacesData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+	r := common.DataResource(mwsWorkspacesData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*mwsWorkspacesData)
 		if c.Config.AccountID == "" {
 			return fmt.Errorf("provider block is missing `account_id` property")
@@ -21,9 +32,30 @@ func DataSourceMwsWorkspaces() common.Resource {
 			return err
 		}
 		data.Ids = map[string]int64{}
+		data.Workspaces = []mwsWorkspaceInfo{}
 		for _, v := range workspaces {
 			data.Ids[v.WorkspaceName] = v.WorkspaceID
+			workspaceURL := v.WorkspaceURL
+			if workspaceURL == "" && v.DeploymentName != "" {
+				// the same way as for the databricks_mws_workspaces resource
+				workspaceURL = fmt.Sprintf("https://%s", generateWorkspaceHostname(c, v))
+			}
+			location := v.Location
+			if location == "" {
+				location = v.AwsRegion
+			}
+			data.Workspaces = append(data.Workspaces, mwsWorkspaceInfo{
+				WorkspaceID:     v.WorkspaceID,
+				WorkspaceName:   v.WorkspaceName,
+				DeploymentName:  v.DeploymentName,
+				WorkspaceStatus: v.WorkspaceStatus,
+				WorkspaceURL:    workspaceURL,
+				Cloud:           v.Cloud,
+				Location:        location,
+			})
 		}
 		return nil
 	})
+	r.AccountLevel = true
+	return r
 }
@@ -13,14 +13,23 @@ func TestDataSourceMwsWorkspaces(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.0/accounts/abc/workspaces",
 
-				Response: []Workspace{
-					{
-						WorkspaceName: "bcd",
-						WorkspaceID:   123,
+				Response: []any{
+					Workspace{
+						WorkspaceName:   "bcd",
+						WorkspaceID:     123,
+						DeploymentName:  "bcd-deployment",
+						WorkspaceStatus: "RUNNING",
+						Cloud:           "aws",
+						AwsRegion:       "us-east-1",
 					},
-					{
-						WorkspaceName: "def",
-						WorkspaceID:   456,
+					// Workspace is marshalled as a creation request for GCP
+					map[string]any{
+						"workspace_name":   "def",
+						"workspace_id":     456,
+						"workspace_status": "PROVISIONING",
+						"workspace_url":    "https://def.gcp.databricks.com",
+						"cloud":            "gcp",
+						"location":         "us-central1",
 					},
 				},
 			},
@@ -35,6 +44,19 @@ func TestDataSourceMwsWorkspaces(t *testing.T) {
 			"bcd": 123,
 			"def": 456,
 		},
+		"workspaces.#":                  2,
+		"workspaces.0.workspace_id":     123,
+		"workspaces.0.workspace_name":   "bcd",
+		"workspaces.0.deployment_name":  "bcd-deployment",
+		"workspaces.0.workspace_status": "RUNNING",
+		"workspaces.0.workspace_url":    "https://bcd-deployment.cloud.databricks.com",
+		"workspaces.0.cloud":            "aws",
+		"workspaces.0.location":         "us-east-1",
+		"workspaces.1.workspace_id":     456,
+		"workspaces.1.workspace_status": "PROVISIONING",
+		"workspaces.1.workspace_url":    "https://def.gcp.databricks.com",
+		"workspaces.1.cloud":            "gcp",
+		"workspaces.1.location":         "us-central1",
 	})
 }
 
@@ -0,0 +1,193 @@
+package mws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultNetworkPolicyID is the policy that exists in every account and is assigned
+// to workspaces without explicit assignment. It can be changed, but not created or deleted.
+const defaultNetworkPolicyID = "default-policy"
+
+type InternetDestination struct {
+	Destination             string `json:"destination"`
+	InternetDestinationType string `json:"internet_destination_type,omitempty" tf:"default:DNS_NAME"`
+}
+
+type StorageDestination struct {
+	StorageDestinationType string `json:"storage_destination_type"`
+	BucketName             string `json:"bucket_name,omitempty"`
+	Region                 string `json:"region,omitempty"`
+	AzureStorageAccount    string `json:"azure_storage_account,omitempty"`
+	AzureStorageService    string `json:"azure_storage_service,omitempty"`
+}
+
+type PolicyEnforcement struct {
+	EnforcementMode         string   `json:"enforcement_mode,omitempty" tf:"default:ENFORCED"`
+	DryRunModeProductFilter []string `json:"dry_run_mode_product_filter,omitempty"`
+}
+
+type NetworkAccessPolicy struct {
+	RestrictionMode             string                `json:"restriction_mode"`
+	AllowedInternetDestinations []InternetDestination `json:"allowed_internet_destinations,omitempty"`
+	AllowedStorageDestinations  []StorageDestination  `json:"allowed_storage_destinations,omitempty"`
+	PolicyEnforcement           *PolicyEnforcement    `json:"policy_enforcement,omitempty"`
+}
+
+type NetworkPolicyEgress struct {
+	NetworkAccess *NetworkAccessPolicy `json:"network_access"`
+}
+
+// NetworkPolicy controls the network access of serverless compute in workspaces it's assigned to
+type NetworkPolicy struct {
+	AccountID       string               `json:"account_id,omitempty" tf:"computed"`
+	NetworkPolicyID string               `json:"network_policy_id" tf:"force_new"`
+	Egress          *NetworkPolicyEgress `json:"egress"`
+}
+
+type networkPolicyRequest struct {
+	NetworkPolicy NetworkPolicy `json:"network_policy"`
+}
+
+// WorkspaceNetworkOption is the assignment of network policy to a workspace
+type WorkspaceNetworkOption struct {
+	WorkspaceID     int64  `json:"workspace_id"`
+	NetworkPolicyID string `json:"network_policy_id"`
+}
+
+func NewNetworkPoliciesAPI(ctx context.Context, m any) NetworkPoliciesAPI {
+	return NetworkPoliciesAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// NetworkPoliciesAPI exposes the account network policies API
+type NetworkPoliciesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a NetworkPoliciesAPI) policiesPath() (string, error) {
+	if a.client.Config.AccountID == "" {
+		return "", errors.New("must have `account_id` on provider")
+	}
+	return fmt.Sprintf("/accounts/%s/network-policies", a.client.Config.AccountID), nil
+}
+
+func (a NetworkPoliciesAPI) Create(policy NetworkPolicy) error {
+	path, err := a.policiesPath()
+	if err != nil {
+		return err
+	}
+	return a.client.Post(a.context, path, networkPolicyRequest{policy}, nil)
+}
+
+func (a NetworkPoliciesAPI) Read(policyID string) (policy NetworkPolicy, err error) {
+	path, err := a.policiesPath()
+	if err != nil {
+		return
+	}
+	err = a.client.Get(a.context, fmt.Sprintf("%s/%s", path, policyID), nil, &policy)
+	return
+}
+
+func (a NetworkPoliciesAPI) Update(policy NetworkPolicy) error {
+	path, err := a.policiesPath()
+	if err != nil {
+		return err
+	}
+	return a.client.Put(a.context, fmt.Sprintf("%s/%s", path, policy.NetworkPolicyID), networkPolicyRequest{policy})
+}
+
+func (a NetworkPoliciesAPI) Delete(policyID string) error {
+	path, err := a.policiesPath()
+	if err != nil {
+		return err
+	}
+	return a.client.Delete(a.context, fmt.Sprintf("%s/%s", path, policyID), nil)
+}
+
+func (a NetworkPoliciesAPI) workspaceNetworkPath(workspaceID int64) (string, error) {
+	if a.client.Config.AccountID == "" {
+		return "", errors.New("must have `account_id` on provider")
+	}
+	return fmt.Sprintf("/accounts/%s/workspaces/%d/network", a.client.Config.AccountID, workspaceID), nil
+}
+
+// GetWorkspaceNetworkOption returns the network policy assigned to the workspace
+func (a NetworkPoliciesAPI) GetWorkspaceNetworkOption(workspaceID int64) (option WorkspaceNetworkOption, err error) {
+	path, err := a.workspaceNetworkPath(workspaceID)
+	if err != nil {
+		return
+	}
+	err = a.client.Get(a.context, path, nil, &option)
+	return
+}
+
+// UpdateWorkspaceNetworkOption assigns the network policy to the workspace
+func (a NetworkPoliciesAPI) UpdateWorkspaceNetworkOption(option WorkspaceNetworkOption) error {
+	path, err := a.workspaceNetworkPath(option.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	return a.client.Put(a.context, path, option)
+}
+
+func ResourceAccountNetworkPolicy() common.Resource {
+	s := common.StructToSchema(NetworkPolicy{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.MustSchemaPath(m, "egress", "network_access", "restriction_mode").ValidateFunc =
+			validation.StringInSlice([]string{"FULL_ACCESS", "RESTRICTED_ACCESS"}, false)
+		common.MustSchemaPath(m, "egress", "network_access", "allowed_internet_destinations",
+			"internet_destination_type").ValidateFunc = validation.StringInSlice([]string{"DNS_NAME"}, false)
+		common.MustSchemaPath(m, "egress", "network_access", "allowed_storage_destinations",
+			"storage_destination_type").ValidateFunc = validation.StringInSlice(
+			[]string{"AWS_S3", "AZURE_STORAGE", "GOOGLE_CLOUD_STORAGE"}, false)
+		common.MustSchemaPath(m, "egress", "network_access", "policy_enforcement",
+			"enforcement_mode").ValidateFunc = validation.StringInSlice([]string{"ENFORCED", "DRY_RUN"}, false)
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var policy NetworkPolicy
+			common.DataToStructPointer(d, s, &policy)
+			api := NewNetworkPoliciesAPI(ctx, c)
+			var err error
+			if policy.NetworkPolicyID == defaultNetworkPolicyID {
+				// the default policy always exists, so we can only take it under management
+				err = api.Update(policy)
+			} else {
+				err = api.Create(policy)
+			}
+			if err != nil {
+				return err
+			}
+			d.SetId(policy.NetworkPolicyID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			policy, err := NewNetworkPoliciesAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(policy, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var policy NetworkPolicy
+			common.DataToStructPointer(d, s, &policy)
+			return NewNetworkPoliciesAPI(ctx, c).Update(policy)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if d.Id() == defaultNetworkPolicyID {
+				log.Printf("[INFO] %s can't be deleted, removing it only from the state", defaultNetworkPolicyID)
+				return nil
+			}
+			return NewNetworkPoliciesAPI(ctx, c).Delete(d.Id())
+		},
+		AccountLevel: true,
+	}
+}
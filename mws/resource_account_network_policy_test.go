@@ -0,0 +1,205 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+var restrictedPolicy = NetworkPolicy{
+	AccountID:       "abc",
+	NetworkPolicyID: "restricted",
+	Egress: &NetworkPolicyEgress{
+		NetworkAccess: &NetworkAccessPolicy{
+			RestrictionMode: "RESTRICTED_ACCESS",
+			AllowedInternetDestinations: []InternetDestination{
+				{
+					Destination:             "pypi.org",
+					InternetDestinationType: "DNS_NAME",
+				},
+			},
+			AllowedStorageDestinations: []StorageDestination{
+				{
+					StorageDestinationType: "AWS_S3",
+					BucketName:             "landing",
+					Region:                 "us-west-2",
+				},
+			},
+			PolicyEnforcement: &PolicyEnforcement{
+				EnforcementMode: "ENFORCED",
+			},
+		},
+	},
+}
+
+const restrictedPolicyHCL = `
+network_policy_id = "restricted"
+egress {
+	network_access {
+		restriction_mode = "RESTRICTED_ACCESS"
+		allowed_internet_destinations {
+			destination = "pypi.org"
+		}
+		allowed_storage_destinations {
+			storage_destination_type = "AWS_S3"
+			bucket_name              = "landing"
+			region                   = "us-west-2"
+		}
+		policy_enforcement {
+			enforcement_mode = "ENFORCED"
+		}
+	}
+}
+`
+
+func TestAccountNetworkPolicyCreate(t *testing.T) {
+	request := restrictedPolicy
+	request.AccountID = ""
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/accounts/abc/network-policies",
+				ExpectedRequest: networkPolicyRequest{request},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/network-policies/restricted",
+				Response: restrictedPolicy,
+			},
+		},
+		Resource:  ResourceAccountNetworkPolicy(),
+		Create:    true,
+		AccountID: "abc",
+		HCL:       restrictedPolicyHCL,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "restricted", d.Id())
+	assert.Equal(t, "abc", d.Get("account_id"))
+}
+
+func TestAccountNetworkPolicyCreateDefault(t *testing.T) {
+	policy := NetworkPolicy{
+		NetworkPolicyID: "default-policy",
+		Egress: &NetworkPolicyEgress{
+			NetworkAccess: &NetworkAccessPolicy{
+				RestrictionMode: "FULL_ACCESS",
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PUT",
+				Resource:        "/api/2.0/accounts/abc/network-policies/default-policy",
+				ExpectedRequest: networkPolicyRequest{policy},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/network-policies/default-policy",
+				Response: policy,
+			},
+		},
+		Resource:  ResourceAccountNetworkPolicy(),
+		Create:    true,
+		AccountID: "abc",
+		HCL: `
+		network_policy_id = "default-policy"
+		egress {
+			network_access {
+				restriction_mode = "FULL_ACCESS"
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "default-policy",
+	})
+}
+
+func TestAccountNetworkPolicyRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/network-policies/restricted",
+				Response: restrictedPolicy,
+			},
+		},
+		Resource:  ResourceAccountNetworkPolicy(),
+		Read:      true,
+		New:       true,
+		AccountID: "abc",
+		ID:        "restricted",
+	}.ApplyAndExpectData(t, map[string]any{
+		"network_policy_id":                                                     "restricted",
+		"egress.0.network_access.0.restriction_mode":                            "RESTRICTED_ACCESS",
+		"egress.0.network_access.0.allowed_internet_destinations.0.destination": "pypi.org",
+		"egress.0.network_access.0.allowed_storage_destinations.0.bucket_name":  "landing",
+	})
+}
+
+func TestAccountNetworkPolicyUpdate(t *testing.T) {
+	request := restrictedPolicy
+	request.AccountID = "abc"
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PUT",
+				Resource:        "/api/2.0/accounts/abc/network-policies/restricted",
+				ExpectedRequest: networkPolicyRequest{request},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/network-policies/restricted",
+				Response: restrictedPolicy,
+			},
+		},
+		Resource:  ResourceAccountNetworkPolicy(),
+		Update:    true,
+		AccountID: "abc",
+		ID:        "restricted",
+		InstanceState: map[string]string{
+			"account_id":                "abc",
+			"network_policy_id":         "restricted",
+			"egress.#":                  "1",
+			"egress.0.network_access.#": "1",
+			"egress.0.network_access.0.restriction_mode": "FULL_ACCESS",
+		},
+		HCL: restrictedPolicyHCL,
+	}.ApplyNoError(t)
+}
+
+func TestAccountNetworkPolicyDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/abc/network-policies/restricted",
+			},
+		},
+		Resource:  ResourceAccountNetworkPolicy(),
+		Delete:    true,
+		AccountID: "abc",
+		ID:        "restricted",
+	}.ApplyNoError(t)
+}
+
+func TestAccountNetworkPolicyDeleteDefault(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:  []qa.HTTPFixture{},
+		Resource:  ResourceAccountNetworkPolicy(),
+		Delete:    true,
+		AccountID: "abc",
+		ID:        "default-policy",
+	}.ApplyNoError(t)
+}
+
+func TestAccountNetworkPolicyNoAccountID(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{},
+		Resource: ResourceAccountNetworkPolicy(),
+		Create:   true,
+		HCL:      restrictedPolicyHCL,
+	}.ExpectError(t, "must have `account_id` on provider")
+}
@@ -113,6 +113,7 @@ func ResourceMwsCustomerManagedKeys() common.Resource {
 				Upgrade: migrateResourceCustomerManagedKeyV0,
 			},
 		},
+		AccountLevel: true,
 	}
 }
 
@@ -0,0 +1,179 @@
+package mws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/aws"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	instanceProfileRegistered = "REGISTERED"
+	instanceProfileMissing    = "NOT_REGISTERED"
+)
+
+type mwsInstanceProfiles struct {
+	aws.InstanceProfileInfo
+	WorkspaceIds    []int64           `json:"workspace_ids" tf:"slice_set"`
+	WorkspaceStatus map[string]string `json:"workspace_status,omitempty" tf:"computed"`
+}
+
+// workspaceClientForHost is replaced in unit tests
+var workspaceClientForHost = func(ctx context.Context, c *common.DatabricksClient,
+	host string) (*common.DatabricksClient, error) {
+	return c.ClientForHost(ctx, host)
+}
+
+// instanceProfilesInWorkspace returns the API of the workspace with the given ID, that is reached
+// with the same credentials as the account
+func instanceProfilesInWorkspace(ctx context.Context, c *common.DatabricksClient,
+	workspaceId int64) (aws.InstanceProfilesAPI, error) {
+	if c.Config.AccountID == "" {
+		return aws.InstanceProfilesAPI{}, fmt.Errorf("provider block is missing `account_id` property")
+	}
+	ws, err := NewWorkspacesAPI(ctx, c).Read(c.Config.AccountID, strconv.FormatInt(workspaceId, 10))
+	if err != nil {
+		return aws.InstanceProfilesAPI{}, err
+	}
+	wc, err := workspaceClientForHost(ctx, c, ws.WorkspaceURL)
+	if err != nil {
+		return aws.InstanceProfilesAPI{}, err
+	}
+	return aws.NewInstanceProfilesAPI(ctx, wc), nil
+}
+
+// forEachWorkspace calls the callback for every workspace, so that a failure in one workspace
+// doesn't prevent changes in others. Failures are reported together.
+func forEachWorkspace(ctx context.Context, c *common.DatabricksClient, workspaceIds []int64,
+	cb func(api aws.InstanceProfilesAPI) error) error {
+	failures := []string{}
+	for _, workspaceId := range workspaceIds {
+		api, err := instanceProfilesInWorkspace(ctx, c, workspaceId)
+		if err == nil {
+			err = cb(api)
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("workspace %d: %s", workspaceId, err))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func registerInstanceProfile(profile aws.InstanceProfileInfo) func(api aws.InstanceProfilesAPI) error {
+	return func(api aws.InstanceProfilesAPI) error {
+		// registration is idempotent, so that failed applies could be retried
+		if api.IsRegistered(profile.InstanceProfileArn) {
+			return nil
+		}
+		return api.Create(profile)
+	}
+}
+
+func removeInstanceProfile(arn string) func(api aws.InstanceProfilesAPI) error {
+	return func(api aws.InstanceProfilesAPI) error {
+		err := api.Delete(arn)
+		if apierr.IsMissing(err) {
+			return nil
+		}
+		return err
+	}
+}
+
+func int64Set(v any) (ids []int64) {
+	for _, id := range v.(*schema.Set).List() {
+		ids = append(ids, int64(id.(int)))
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return
+}
+
+// ResourceMwsInstanceProfiles registers the same instance profile in many workspaces of the account
+func ResourceMwsInstanceProfiles() common.Resource {
+	s := common.StructToSchema(mwsInstanceProfiles{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["instance_profile_arn"].ValidateDiagFunc = aws.ValidArn
+			m["iam_role_arn"].ValidateDiagFunc = aws.ValidArn
+			for _, field := range []string{"instance_profile_arn", "iam_role_arn", "is_meta_instance_profile", "skip_validation"} {
+				m[field].ForceNew = true
+			}
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ipp mwsInstanceProfiles
+			common.DataToStructPointer(d, s, &ipp)
+			err := forEachWorkspace(ctx, c, int64Set(d.Get("workspace_ids")), registerInstanceProfile(ipp.InstanceProfileInfo))
+			if err != nil {
+				return err
+			}
+			d.SetId(ipp.InstanceProfileArn)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ipp mwsInstanceProfiles
+			common.DataToStructPointer(d, s, &ipp)
+			ipp.InstanceProfileArn = d.Id()
+			ipp.WorkspaceStatus = map[string]string{}
+			registered := []int64{}
+			for _, workspaceId := range int64Set(d.Get("workspace_ids")) {
+				key := strconv.FormatInt(workspaceId, 10)
+				api, err := instanceProfilesInWorkspace(ctx, c, workspaceId)
+				if err == nil {
+					_, err = api.Read(d.Id())
+				}
+				switch {
+				case err == nil:
+					ipp.WorkspaceStatus[key] = instanceProfileRegistered
+					registered = append(registered, workspaceId)
+				case apierr.IsMissing(err):
+					// dropping the workspace from the state makes the next plan register the profile again
+					ipp.WorkspaceStatus[key] = instanceProfileMissing
+				default:
+					// unreachable workspaces shouldn't block plans for all others
+					ipp.WorkspaceStatus[key] = fmt.Sprintf("ERROR: %s", err)
+					registered = append(registered, workspaceId)
+				}
+			}
+			ipp.WorkspaceIds = registered
+			if err := common.StructToData(ipp, s, d); err != nil {
+				return err
+			}
+			return d.Set("workspace_ids", registered)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ipp mwsInstanceProfiles
+			common.DataToStructPointer(d, s, &ipp)
+			o, n := d.GetChange("workspace_ids")
+			oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+			added := int64Set(newSet.Difference(oldSet))
+			removed := int64Set(oldSet.Difference(newSet))
+			err := forEachWorkspace(ctx, c, added, registerInstanceProfile(ipp.InstanceProfileInfo))
+			if err != nil {
+				d.Partial(true)
+				return err
+			}
+			err = forEachWorkspace(ctx, c, removed, removeInstanceProfile(d.Id()))
+			if err != nil {
+				d.Partial(true)
+				return err
+			}
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return forEachWorkspace(ctx, c, int64Set(d.Get("workspace_ids")), removeInstanceProfile(d.Id()))
+		},
+		AccountLevel: true,
+	}
+}
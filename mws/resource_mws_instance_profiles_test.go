@@ -0,0 +1,235 @@
+package mws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/aws"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const testInstanceProfileArn = "arn:aws:iam::999999999999:instance-profile/shared"
+
+// all workspaces are served by the same fixtures, hosts are checked separately
+func withWorkspaceHosts(t *testing.T, expected ...string) {
+	original := workspaceClientForHost
+	hosts := []string{}
+	workspaceClientForHost = func(ctx context.Context, c *common.DatabricksClient,
+		host string) (*common.DatabricksClient, error) {
+		hosts = append(hosts, host)
+		return c, nil
+	}
+	t.Cleanup(func() {
+		workspaceClientForHost = original
+		assert.Equal(t, expected, hosts)
+	})
+}
+
+func workspaceFixtures(ids ...int64) (fixtures []qa.HTTPFixture) {
+	for _, id := range ids {
+		fixtures = append(fixtures, qa.HTTPFixture{
+			Method:       "GET",
+			Resource:     fmt.Sprintf("/api/2.0/accounts/abc/workspaces/%d", id),
+			ReuseRequest: true,
+			Response: Workspace{
+				WorkspaceID:  id,
+				WorkspaceURL: fmt.Sprintf("https://ws%d.cloud.databricks.com", id),
+			},
+		})
+	}
+	return
+}
+
+func instanceProfilesListFixture(arns ...string) qa.HTTPFixture {
+	list := aws.InstanceProfileList{}
+	for _, arn := range arns {
+		list.InstanceProfiles = append(list.InstanceProfiles, aws.InstanceProfileInfo{
+			InstanceProfileArn: arn,
+		})
+	}
+	return qa.HTTPFixture{
+		Method:   "GET",
+		Resource: "/api/2.0/instance-profiles/list",
+		Response: list,
+	}
+}
+
+func workspaceIdsKey(id int) string {
+	return fmt.Sprintf("workspace_ids.%d", schema.HashSchema(&schema.Schema{Type: schema.TypeInt})(id))
+}
+
+func TestResourceMwsInstanceProfilesCreate(t *testing.T) {
+	withWorkspaceHosts(t,
+		"https://ws123.cloud.databricks.com", "https://ws456.cloud.databricks.com",
+		"https://ws123.cloud.databricks.com", "https://ws456.cloud.databricks.com")
+	qa.ResourceFixture{
+		Fixtures: append(workspaceFixtures(123, 456),
+			instanceProfilesListFixture(),
+			qa.HTTPFixture{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/add",
+				ExpectedRequest: aws.InstanceProfileInfo{
+					InstanceProfileArn: testInstanceProfileArn,
+					SkipValidation:     true,
+				},
+			},
+			// already registered in the second workspace
+			instanceProfilesListFixture(testInstanceProfileArn),
+			instanceProfilesListFixture(testInstanceProfileArn),
+			instanceProfilesListFixture(testInstanceProfileArn),
+		),
+		Resource:  ResourceMwsInstanceProfiles(),
+		Create:    true,
+		AccountID: "abc",
+		HCL: `
+		instance_profile_arn = "` + testInstanceProfileArn + `"
+		skip_validation = true
+		workspace_ids = [123, 456]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                   testInstanceProfileArn,
+		"workspace_ids.#":      2,
+		"workspace_status.123": "REGISTERED",
+		"workspace_status.456": "REGISTERED",
+	})
+}
+
+func TestResourceMwsInstanceProfilesCreate_PartialFailure(t *testing.T) {
+	withWorkspaceHosts(t, "https://ws123.cloud.databricks.com", "https://ws456.cloud.databricks.com")
+	qa.ResourceFixture{
+		Fixtures: append(workspaceFixtures(123, 456),
+			instanceProfilesListFixture(),
+			qa.HTTPFixture{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/add",
+				Status:   400,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Verification of the instance profile failed",
+				},
+			},
+			instanceProfilesListFixture(),
+			qa.HTTPFixture{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/add",
+			},
+		),
+		Resource:  ResourceMwsInstanceProfiles(),
+		Create:    true,
+		AccountID: "abc",
+		HCL: `
+		instance_profile_arn = "` + testInstanceProfileArn + `"
+		workspace_ids = [123, 456]
+		`,
+	}.ExpectError(t, "workspace 123: Verification of the instance profile failed")
+}
+
+func TestResourceMwsInstanceProfilesCreate_NoAccountID(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{},
+		Resource: ResourceMwsInstanceProfiles(),
+		Create:   true,
+		HCL: `
+		instance_profile_arn = "` + testInstanceProfileArn + `"
+		workspace_ids = [123]
+		`,
+	}.ExpectError(t, "workspace 123: provider block is missing `account_id` property")
+}
+
+func TestResourceMwsInstanceProfilesRead_NotRegistered(t *testing.T) {
+	withWorkspaceHosts(t, "https://ws123.cloud.databricks.com", "https://ws456.cloud.databricks.com")
+	qa.ResourceFixture{
+		Fixtures: append(workspaceFixtures(123, 456),
+			instanceProfilesListFixture(testInstanceProfileArn),
+			instanceProfilesListFixture(),
+		),
+		Resource:  ResourceMwsInstanceProfiles(),
+		Read:      true,
+		New:       true,
+		AccountID: "abc",
+		ID:        testInstanceProfileArn,
+		State: map[string]any{
+			"instance_profile_arn": testInstanceProfileArn,
+			"workspace_ids":        []any{123, 456},
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_ids.#":      1,
+		"workspace_status.123": "REGISTERED",
+		"workspace_status.456": "NOT_REGISTERED",
+	})
+}
+
+func TestResourceMwsInstanceProfilesUpdate(t *testing.T) {
+	withWorkspaceHosts(t,
+		"https://ws789.cloud.databricks.com", "https://ws456.cloud.databricks.com",
+		"https://ws123.cloud.databricks.com", "https://ws789.cloud.databricks.com")
+	qa.ResourceFixture{
+		Fixtures: append(workspaceFixtures(123, 456, 789),
+			instanceProfilesListFixture(),
+			qa.HTTPFixture{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/add",
+				ExpectedRequest: aws.InstanceProfileInfo{
+					InstanceProfileArn: testInstanceProfileArn,
+				},
+			},
+			qa.HTTPFixture{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/remove",
+				ExpectedRequest: map[string]any{
+					"instance_profile_arn": testInstanceProfileArn,
+				},
+			},
+			instanceProfilesListFixture(testInstanceProfileArn),
+			instanceProfilesListFixture(testInstanceProfileArn),
+		),
+		Resource:  ResourceMwsInstanceProfiles(),
+		Update:    true,
+		AccountID: "abc",
+		ID:        testInstanceProfileArn,
+		InstanceState: map[string]string{
+			"instance_profile_arn": testInstanceProfileArn,
+			"workspace_ids.#":      "2",
+			workspaceIdsKey(123):   "123",
+			workspaceIdsKey(456):   "456",
+		},
+		HCL: `
+		instance_profile_arn = "` + testInstanceProfileArn + `"
+		workspace_ids = [123, 789]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_ids.#":      2,
+		"workspace_status.%":   "2",
+		"workspace_status.789": "REGISTERED",
+	})
+}
+
+func TestResourceMwsInstanceProfilesDelete(t *testing.T) {
+	withWorkspaceHosts(t, "https://ws123.cloud.databricks.com")
+	qa.ResourceFixture{
+		Fixtures: append(workspaceFixtures(123),
+			qa.HTTPFixture{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/remove",
+				Status:   404,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Instance profile is not registered",
+				},
+			},
+		),
+		Resource:  ResourceMwsInstanceProfiles(),
+		Delete:    true,
+		AccountID: "abc",
+		ID:        testInstanceProfileArn,
+		State: map[string]any{
+			"instance_profile_arn": testInstanceProfileArn,
+			"workspace_ids":        []any{123},
+		},
+	}.ApplyNoError(t)
+}
@@ -45,7 +45,7 @@ func (a NetworksAPI) Delete(mwsAcctID, networksID string) error {
 	if err := a.client.Delete(a.context, networksAPIPath, nil); err != nil {
 		return err
 	}
-	return resource.RetryContext(a.context, 60*time.Second, func() *resource.RetryError {
+	return common.RetryContext(a.context, 60*time.Second, func() *resource.RetryError {
 		network, err := a.Read(mwsAcctID, networksID)
 		if apierr.IsMissing(err) {
 			log.Printf("[INFO] Network %s/%s is removed.", mwsAcctID, networksID)
@@ -115,5 +115,6 @@ func ResourceMwsNetworks() common.Resource {
 			}
 			return NewNetworksAPI(ctx, c).Delete(accountID, networkID)
 		},
+		AccountLevel: true,
 	}
 }
@@ -30,7 +30,7 @@ func (a VPCEndpointAPI) Create(vpcEndpoint *VPCEndpoint) error {
 	if err != nil {
 		return err
 	}
-	return resource.RetryContext(a.context, 15*time.Minute, func() *resource.RetryError {
+	return common.RetryContext(a.context, 15*time.Minute, func() *resource.RetryError {
 		ve, err := a.Read(vpcEndpoint.AccountID, vpcEndpoint.VPCEndpointID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -115,5 +115,6 @@ func ResourceMwsVpcEndpoint() common.Resource {
 			}
 			return NewVPCEndpointAPI(ctx, c).Delete(accountID, vpcEndpointID)
 		},
+		AccountLevel: true,
 	}
 }
@@ -232,7 +232,7 @@ func (a WorkspacesAPI) explainWorkspaceFailure(ws Workspace) error {
 
 // WaitForRunning will wait until workspace is running, otherwise will try to explain why it failed
 func (a WorkspacesAPI) WaitForRunning(ws Workspace, timeout time.Duration) error {
-	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+	return common.RetryContext(a.context, timeout, func() *resource.RetryError {
 		workspace, err := a.Read(ws.AccountID, fmt.Sprintf("%d", ws.WorkspaceID))
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -322,7 +322,7 @@ func (a WorkspacesAPI) Delete(mwsAcctID, workspaceID string) error {
 	if err != nil {
 		return err
 	}
-	return resource.RetryContext(a.context, 15*time.Minute, func() *resource.RetryError {
+	return common.RetryContext(a.context, 15*time.Minute, func() *resource.RetryError {
 		workspace, err := a.Read(mwsAcctID, workspaceID)
 		if apierr.IsMissing(err) {
 			log.Printf("[INFO] Workspace %s/%s is removed.", mwsAcctID, workspaceID)
@@ -613,6 +613,7 @@ func ResourceMwsWorkspaces() common.Resource {
 			Read:   schema.DefaultTimeout(DefaultProvisionTimeout),
 			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
 		},
+		AccountLevel: true,
 	}
 }
 
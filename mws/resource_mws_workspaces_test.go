@@ -1474,6 +1474,31 @@ func TestEnsureTokenExists_NoRecreate(t *testing.T) {
 	})
 }
 
+func TestEnsureTokenExists_ReadOnly(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/token/list",
+			Response: `{}`,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.ReadOnly = true
+		r := ResourceMwsWorkspaces()
+		d := r.ToResource().TestResourceData()
+		d.Set("workspace_url", client.Config.Host)
+		d.Set("token", []any{
+			map[string]any{
+				"lifetime_seconds": 3600,
+				"comment":          "test",
+				"token_id":         "abcdef",
+			},
+		})
+		wsApi := NewWorkspacesAPI(context.Background(), client)
+		err := EnsureTokenExistsIfNeeded(wsApi, r.Schema, d)
+		assert.ErrorContains(t, err, "POST /api/2.0/token/create is blocked, because the provider is in read-only mode")
+	})
+}
+
 func TestWorkspaceTokenWrongAuthCornerCase(t *testing.T) {
 	client, err := client.New(&config.Config{})
 	if err != nil {
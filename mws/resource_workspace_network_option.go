@@ -0,0 +1,57 @@
+package mws
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceWorkspaceNetworkOption() common.Resource {
+	s := common.StructToSchema(WorkspaceNetworkOption{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["workspace_id"].ForceNew = true
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var option WorkspaceNetworkOption
+			common.DataToStructPointer(d, s, &option)
+			err := NewNetworkPoliciesAPI(ctx, c).UpdateWorkspaceNetworkOption(option)
+			if err != nil {
+				return err
+			}
+			d.SetId(strconv.FormatInt(option.WorkspaceID, 10))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			workspaceID, err := strconv.ParseInt(d.Id(), 10, 64)
+			if err != nil {
+				return err
+			}
+			option, err := NewNetworkPoliciesAPI(ctx, c).GetWorkspaceNetworkOption(workspaceID)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(option, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var option WorkspaceNetworkOption
+			common.DataToStructPointer(d, s, &option)
+			return NewNetworkPoliciesAPI(ctx, c).UpdateWorkspaceNetworkOption(option)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			workspaceID, err := strconv.ParseInt(d.Id(), 10, 64)
+			if err != nil {
+				return err
+			}
+			// workspaces always have a policy assigned, so we fall back to the default one
+			return NewNetworkPoliciesAPI(ctx, c).UpdateWorkspaceNetworkOption(WorkspaceNetworkOption{
+				WorkspaceID:     workspaceID,
+				NetworkPolicyID: defaultNetworkPolicyID,
+			})
+		},
+		AccountLevel: true,
+	}
+}
@@ -0,0 +1,114 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestWorkspaceNetworkOptionCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				ExpectedRequest: WorkspaceNetworkOption{
+					WorkspaceID:     123,
+					NetworkPolicyID: "restricted",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				Response: WorkspaceNetworkOption{
+					WorkspaceID:     123,
+					NetworkPolicyID: "restricted",
+				},
+			},
+		},
+		Resource:  ResourceWorkspaceNetworkOption(),
+		Create:    true,
+		AccountID: "abc",
+		HCL: `
+		workspace_id      = 123
+		network_policy_id = "restricted"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "123",
+		"network_policy_id": "restricted",
+	})
+}
+
+func TestWorkspaceNetworkOptionUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				ExpectedRequest: WorkspaceNetworkOption{
+					WorkspaceID:     123,
+					NetworkPolicyID: "default-policy",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				Response: WorkspaceNetworkOption{
+					WorkspaceID:     123,
+					NetworkPolicyID: "default-policy",
+				},
+			},
+		},
+		Resource:  ResourceWorkspaceNetworkOption(),
+		Update:    true,
+		AccountID: "abc",
+		ID:        "123",
+		InstanceState: map[string]string{
+			"workspace_id":      "123",
+			"network_policy_id": "restricted",
+		},
+		HCL: `
+		workspace_id      = 123
+		network_policy_id = "default-policy"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestWorkspaceNetworkOptionDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				ExpectedRequest: WorkspaceNetworkOption{
+					WorkspaceID:     123,
+					NetworkPolicyID: "default-policy",
+				},
+			},
+		},
+		Resource:  ResourceWorkspaceNetworkOption(),
+		Delete:    true,
+		AccountID: "abc",
+		ID:        "123",
+	}.ApplyNoError(t)
+}
+
+func TestWorkspaceNetworkOptionReadError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				Status:   400,
+				Response: map[string]string{
+					"error_code": "INVALID_PARAMETER_VALUE",
+					"message":    "Workspace 123 is not serverless-enabled",
+				},
+			},
+		},
+		Resource:  ResourceWorkspaceNetworkOption(),
+		Read:      true,
+		AccountID: "abc",
+		ID:        "123",
+	}.ExpectError(t, "Workspace 123 is not serverless-enabled")
+}
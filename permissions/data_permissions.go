@@ -0,0 +1,212 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/scim"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// permissionLevelRanks orders permission levels from the weakest to the strongest one
+var permissionLevelRanks = map[string]int{
+	"CAN_VIEW":                       1,
+	"CAN_READ":                       1,
+	"CAN_RUN":                        2,
+	"CAN_QUERY":                      2,
+	"CAN_USE":                        2,
+	"CAN_ATTACH_TO":                  2,
+	"CAN_RESTART":                    3,
+	"CAN_EDIT":                       3,
+	"CAN_MANAGE_RUN":                 3,
+	"CAN_MANAGE_STAGING_VERSIONS":    4,
+	"CAN_MANAGE_PRODUCTION_VERSIONS": 5,
+	"CAN_MANAGE":                     6,
+	"IS_OWNER":                       7,
+}
+
+// EffectivePermission is a permission of the principal, that is granted directly, through a group
+// or inherited from a parent object
+type EffectivePermission struct {
+	PermissionLevel     string   `json:"permission_level"`
+	GroupName           string   `json:"group_name,omitempty"`
+	Inherited           bool     `json:"inherited,omitempty"`
+	InheritedFromObject []string `json:"inherited_from_object,omitempty"`
+}
+
+// principalGroups returns names of all groups that the principal is a member of, including parent groups
+func principalGroups(ctx context.Context, c *common.DatabricksClient, userName, servicePrincipalName,
+	groupName string) ([]string, error) {
+	var direct []scim.ComplexValue
+	switch {
+	case userName != "":
+		users, err := scim.NewUsersAPI(ctx, c).Filter(fmt.Sprintf(`userName eq "%s"`, userName), true)
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("cannot find user %s", userName)
+		}
+		// all users and service principals of the workspace are members of the `users` group
+		direct = append(users[0].Groups, scim.ComplexValue{Display: "users"})
+	case servicePrincipalName != "":
+		sps, err := scim.NewServicePrincipalsAPI(ctx, c).Filter(
+			fmt.Sprintf(`applicationId eq "%s"`, servicePrincipalName), true)
+		if err != nil {
+			return nil, err
+		}
+		if len(sps) == 0 {
+			return nil, fmt.Errorf("cannot find service principal %s", servicePrincipalName)
+		}
+		direct = append(sps[0].Groups, scim.ComplexValue{Display: "users"})
+	default:
+		group, err := scim.NewGroupsAPI(ctx, c).ReadByDisplayName(groupName, "")
+		if err != nil {
+			return nil, err
+		}
+		direct = group.Groups
+	}
+	groupsAPI := scim.NewGroupsAPI(ctx, c)
+	seen := map[string]bool{}
+	queue := []scim.ComplexValue{}
+	queue = append(queue, direct...)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if seen[current.Display] || current.Display == groupName {
+			continue
+		}
+		seen[current.Display] = true
+		if current.Display == "users" {
+			continue
+		}
+		group, err := groupsAPI.ReadByDisplayName(current.Display, "")
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, group.Groups...)
+	}
+	groups := []string{}
+	for name := range seen {
+		groups = append(groups, name)
+	}
+	sort.Strings(groups)
+	return groups, nil
+}
+
+// effectivePermissions returns permissions of the principal and of groups it's a member of
+func (oa *ObjectACL) effectivePermissions(userName, servicePrincipalName, groupName string,
+	groups []string) (permissions []EffectivePermission) {
+	memberOf := map[string]bool{}
+	for _, v := range groups {
+		memberOf[v] = true
+	}
+	for _, ac := range oa.AccessControlList {
+		via := ""
+		switch {
+		case userName != "" && ac.UserName == userName:
+		case servicePrincipalName != "" && ac.ServicePrincipalName == servicePrincipalName:
+		case groupName != "" && ac.GroupName == groupName:
+		case ac.GroupName != "" && memberOf[ac.GroupName]:
+			via = ac.GroupName
+		default:
+			continue
+		}
+		for _, p := range ac.AllPermissions {
+			permissions = append(permissions, EffectivePermission{
+				PermissionLevel:     p.PermissionLevel,
+				GroupName:           via,
+				Inherited:           p.Inherited,
+				InheritedFromObject: p.InheritedFromObject,
+			})
+		}
+		if ac.PermissionLevel != "" {
+			permissions = append(permissions, EffectivePermission{
+				PermissionLevel: ac.PermissionLevel,
+				GroupName:       via,
+			})
+		}
+	}
+	return permissions
+}
+
+// DataSourcePermissions returns effective permissions of a principal on a workspace object
+func DataSourcePermissions() common.Resource {
+	type effectivePermissions struct {
+		UserName                 string                `json:"user_name,omitempty"`
+		GroupName                string                `json:"group_name,omitempty"`
+		ServicePrincipalName     string                `json:"service_principal_name,omitempty"`
+		ObjectType               string                `json:"object_type,omitempty" tf:"computed"`
+		Groups                   []string              `json:"groups,omitempty" tf:"computed"`
+		EffectivePermissionLevel string                `json:"effective_permission_level,omitempty" tf:"computed"`
+		Permissions              []EffectivePermission `json:"permissions,omitempty" tf:"computed"`
+	}
+	principals := []string{"user_name", "group_name", "service_principal_name"}
+	s := common.StructToSchema(effectivePermissions{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		for _, p := range principals {
+			m[p].ExactlyOneOf = principals
+		}
+		objects := []string{}
+		for _, mapping := range permissionsResourceIDFields() {
+			if _, ok := m[mapping.field]; ok {
+				continue
+			}
+			m[mapping.field] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+			objects = append(objects, mapping.field)
+		}
+		for _, field := range objects {
+			m[field].ExactlyOneOf = objects
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var data effectivePermissions
+			common.DataToStructPointer(d, s, &data)
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			objectID := ""
+			for _, mapping := range permissionsResourceIDFields() {
+				v, ok := d.GetOk(mapping.field)
+				if !ok {
+					continue
+				}
+				id, err := mapping.idRetriever(ctx, w, v.(string))
+				if err != nil {
+					return err
+				}
+				objectID = fmt.Sprintf("/%s/%s", mapping.resourceType, id)
+				break
+			}
+			objectACL, err := NewPermissionsAPI(ctx, c).Read(objectID)
+			if err != nil {
+				return err
+			}
+			data.Groups, err = principalGroups(ctx, c, data.UserName, data.ServicePrincipalName, data.GroupName)
+			if err != nil {
+				return err
+			}
+			data.ObjectType = objectACL.ObjectType
+			data.Permissions = objectACL.effectivePermissions(data.UserName, data.ServicePrincipalName,
+				data.GroupName, data.Groups)
+			data.EffectivePermissionLevel = ""
+			for _, p := range data.Permissions {
+				if permissionLevelRanks[p.PermissionLevel] > permissionLevelRanks[data.EffectivePermissionLevel] {
+					data.EffectivePermissionLevel = p.PermissionLevel
+				}
+			}
+			d.SetId(objectID)
+			return common.StructToData(data, s, d)
+		},
+		WorkspaceLevel: true,
+	}
+}
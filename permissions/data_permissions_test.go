@@ -0,0 +1,185 @@
+package permissions
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/stretchr/testify/assert"
+)
+
+func groupFixture(name string, parents ...string) qa.HTTPFixture {
+	group := scim.Group{DisplayName: name}
+	for _, p := range parents {
+		group.Groups = append(group.Groups, scim.ComplexValue{Display: p})
+	}
+	return qa.HTTPFixture{
+		Method:   "GET",
+		Resource: "/api/2.0/preview/scim/v2/Groups?filter=displayName%20eq%20%22" + name + "%22",
+		Response: scim.GroupList{
+			Resources: []scim.Group{group},
+		},
+	}
+}
+
+var clusterACLFixture = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/permissions/clusters/abc",
+	Response: ObjectACL{
+		ObjectID:   "/clusters/abc",
+		ObjectType: "cluster",
+		AccessControlList: []AccessControl{
+			{
+				UserName:       "alice@example.com",
+				AllPermissions: []Permission{{PermissionLevel: "CAN_ATTACH_TO"}},
+			},
+			{
+				GroupName: "data-engineers",
+				AllPermissions: []Permission{
+					{
+						PermissionLevel:     "CAN_RESTART",
+						Inherited:           true,
+						InheritedFromObject: []string{"/clusters/"},
+					},
+				},
+			},
+			{
+				GroupName:      "admins",
+				AllPermissions: []Permission{{PermissionLevel: "CAN_MANAGE"}},
+			},
+			{
+				UserName:       "bob@example.com",
+				AllPermissions: []Permission{{PermissionLevel: "CAN_MANAGE"}},
+			},
+		},
+	},
+}
+
+func TestDataSourcePermissions_User(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			clusterACLFixture,
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22alice%40example.com%22",
+				Response: scim.UserList{
+					Resources: []scim.User{
+						{
+							UserName: "alice@example.com",
+							Groups:   []scim.ComplexValue{{Display: "team-a"}},
+						},
+					},
+				},
+			},
+			// team-a is a member of data-engineers
+			groupFixture("team-a", "data-engineers"),
+			groupFixture("data-engineers"),
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		New:         true,
+		ID:          "_",
+		HCL: `
+		cluster_id = "abc"
+		user_name = "alice@example.com"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "/clusters/abc", d.Id())
+	assert.Equal(t, "cluster", d.Get("object_type"))
+	assert.Equal(t, "CAN_RESTART", d.Get("effective_permission_level"))
+	assert.Equal(t, []any{"data-engineers", "team-a", "users"}, d.Get("groups"))
+	assert.Equal(t, 2, d.Get("permissions.#"))
+	assert.Equal(t, "CAN_ATTACH_TO", d.Get("permissions.0.permission_level"))
+	assert.Equal(t, "", d.Get("permissions.0.group_name"))
+	assert.Equal(t, "CAN_RESTART", d.Get("permissions.1.permission_level"))
+	assert.Equal(t, "data-engineers", d.Get("permissions.1.group_name"))
+	assert.Equal(t, true, d.Get("permissions.1.inherited"))
+}
+
+func TestDataSourcePermissions_ServicePrincipalWithoutPermissions(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			clusterACLFixture,
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=applicationId%20eq%20%2200000000-0000-0000-0000-000000000001%22",
+				Response: scim.UserList{
+					Resources: []scim.User{
+						{
+							ApplicationID: "00000000-0000-0000-0000-000000000001",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		New:         true,
+		ID:          "_",
+		HCL: `
+		cluster_id = "abc"
+		service_principal_name = "00000000-0000-0000-0000-000000000001"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "", d.Get("effective_permission_level"))
+	assert.Equal(t, 0, d.Get("permissions.#"))
+}
+
+func TestDataSourcePermissions_Group(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			clusterACLFixture,
+			groupFixture("admins"),
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		New:         true,
+		ID:          "_",
+		HCL: `
+		cluster_id = "abc"
+		group_name = "admins"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "CAN_MANAGE", d.Get("effective_permission_level"))
+	assert.Equal(t, 1, d.Get("permissions.#"))
+}
+
+func TestDataSourcePermissions_UnknownUser(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			clusterACLFixture,
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22eve%40example.com%22",
+				Response: scim.UserList{},
+			},
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		New:         true,
+		ID:          "_",
+		HCL: `
+		cluster_id = "abc"
+		user_name = "eve@example.com"
+		`,
+	}.ExpectError(t, "cannot find user eve@example.com")
+}
+
+func TestDataSourcePermissions_NoPrincipal(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		New:         true,
+		ID:          "_",
+		HCL:         `cluster_id = "abc"`,
+	}.ExpectError(t, "invalid config supplied. [group_name] Invalid combination of arguments. "+
+		"[service_principal_name] Invalid combination of arguments. [user_name] Invalid combination of arguments")
+}
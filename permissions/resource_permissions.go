@@ -166,6 +166,11 @@ func (a PermissionsAPI) put(objectID string, objectACL AccessControlChangeList)
 	return a.client.Put(a.context, urlPathForObjectID(objectID), objectACL)
 }
 
+// Patch adds or updates the given access control entries, keeping all others intact
+func (a PermissionsAPI) Patch(objectID string, objectACL AccessControlChangeList) error {
+	return a.client.Patch(a.context, urlPathForObjectID(objectID), objectACL)
+}
+
 // Update updates object permissions. Technically, it's using method named SetOrDelete, but here we do more
 func (a PermissionsAPI) Update(objectID string, objectACL AccessControlChangeList) error {
 	if objectID == "/authorization/tokens" || objectID == "/registered-models/root" || objectID == "/directories/0" {
@@ -319,10 +324,47 @@ type PermissionsEntity struct {
 	AccessControlList []AccessControlChange `json:"access_control" tf:"slice_set"`
 }
 
-func (oa *ObjectACL) ToPermissionsEntity(d *schema.ResourceData, me string) (PermissionsEntity, error) {
-	entity := PermissionsEntity{}
+// addedAccessControlChanges returns entries of the new list, that are not in the old one. The second
+// result is false, if any entry of the old list was removed or changed, as it requires replacing the
+// whole access control list.
+func addedAccessControlChanges(old, new []AccessControlChange) ([]AccessControlChange, bool) {
+	inNew := map[string]bool{}
+	for _, v := range new {
+		inNew[v.String()] = true
+	}
+	inOld := map[string]bool{}
+	for _, v := range old {
+		if !inNew[v.String()] {
+			return nil, false
+		}
+		inOld[v.String()] = true
+	}
+	added := []AccessControlChange{}
+	for _, v := range new {
+		if !inOld[v.String()] {
+			added = append(added, v)
+		}
+	}
+	return added, true
+}
+
+func accessControlChangesFromSet(v any) (changes []AccessControlChange) {
+	for _, item := range v.(*schema.Set).List() {
+		m := item.(map[string]any)
+		change := AccessControlChange{}
+		change.UserName, _ = m["user_name"].(string)
+		change.GroupName, _ = m["group_name"].(string)
+		change.ServicePrincipalName, _ = m["service_principal_name"].(string)
+		change.PermissionLevel, _ = m["permission_level"].(string)
+		changes = append(changes, change)
+	}
+	return
+}
+
+// directAccessControlChanges returns direct permissions, that could be modified by the current user
+func (oa *ObjectACL) directAccessControlChanges(objectID, me string) (changes []AccessControlChange) {
 	for _, accessControl := range oa.AccessControlList {
-		if accessControl.GroupName == "admins" && d.Id() != "/authorization/passwords" {
+		if accessControl.GroupName == "admins" && objectID != "/authorization/passwords" {
 			// not possible to lower admins permissions anywhere from CAN_MANAGE
 			continue
 		}
@@ -331,9 +373,16 @@ func (oa *ObjectACL) ToPermissionsEntity(d *schema.ResourceData, me string) (Per
 			continue
 		}
 		if change, direct := accessControl.toAccessControlChange(); direct {
-			entity.AccessControlList = append(entity.AccessControlList, change)
+			changes = append(changes, change)
 		}
 	}
+	return changes
+}
+
+func (oa *ObjectACL) ToPermissionsEntity(d *schema.ResourceData, me string) (PermissionsEntity, error) {
+	entity := PermissionsEntity{
+		AccessControlList: oa.directAccessControlChanges(d.Id(), me),
+	}
 	for _, mapping := range permissionsResourceIDFields() {
 		if mapping.objectType != oa.ObjectType {
 			continue
@@ -475,12 +524,22 @@ func ResourcePermissions() common.Resource {
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var entity PermissionsEntity
 			common.DataToStructPointer(d, s, &entity)
-			return NewPermissionsAPI(ctx, c).Update(d.Id(), AccessControlChangeList{
+			api := NewPermissionsAPI(ctx, c)
+			oldACL, _ := d.GetChange("access_control")
+			added, onlyAdded := addedAccessControlChanges(accessControlChangesFromSet(oldACL), entity.AccessControlList)
+			if onlyAdded && len(added) > 0 && !isDbsqlPermissionsWorkaroundNecessary(d.Id()) {
+				// apply only the delta, so that concurrent changes to other entries are not overwritten
+				return api.Patch(d.Id(), AccessControlChangeList{
+					AccessControlList: added,
+				})
+			}
+			return api.Update(d.Id(), AccessControlChangeList{
 				AccessControlList: entity.AccessControlList,
 			})
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewPermissionsAPI(ctx, c).Delete(d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -2,7 +2,9 @@ package permissions
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -32,6 +34,25 @@ var (
 	}
 )
 
+// accessControlState adds access control entries to the instance state
+func accessControlState(state map[string]string, changes ...AccessControlChange) map[string]string {
+	hash := schema.HashResource(ResourcePermissions().Schema["access_control"].Elem.(*schema.Resource))
+	state["access_control.#"] = strconv.Itoa(len(changes))
+	for _, c := range changes {
+		fields := map[string]any{
+			"user_name":              c.UserName,
+			"group_name":             c.GroupName,
+			"service_principal_name": c.ServicePrincipalName,
+			"permission_level":       c.PermissionLevel,
+		}
+		prefix := fmt.Sprintf("access_control.%d", hash(fields))
+		for k, v := range fields {
+			state[prefix+"."+k] = v.(string)
+		}
+	}
+	return state
+}
+
 func TestEntityAccessControlChangeString(t *testing.T) {
 	assert.Equal(t, "me CAN_READ", AccessControlChange{
 		UserName:        "me",
@@ -260,9 +281,12 @@ func TestResourcePermissionsUpdate_Mlflow_Model(t *testing.T) {
 				},
 			},
 		},
-		InstanceState: map[string]string{
+		InstanceState: accessControlState(map[string]string{
 			"registered_model_id": "fakeuuid123",
-		},
+		}, AccessControlChange{
+			UserName:        TestingUser,
+			PermissionLevel: "CAN_EDIT",
+		}),
 		HCL: `
 		registered_model_id = "fakeuuid123"
 
@@ -1247,9 +1271,12 @@ func TestResourcePermissionsUpdate(t *testing.T) {
 				},
 			},
 		},
-		InstanceState: map[string]string{
+		InstanceState: accessControlState(map[string]string{
 			"job_id": "9",
-		},
+		}, AccessControlChange{
+			UserName:        TestingUser,
+			PermissionLevel: "CAN_MANAGE_RUN",
+		}),
 		HCL: `
 		job_id = 9
 
@@ -1899,3 +1926,97 @@ func TestResourcePermissionsRootDirectory(t *testing.T) {
 	assert.Equal(t, TestingUser, firstElem["user_name"])
 	assert.Equal(t, "CAN_READ", firstElem["permission_level"])
 }
+
+func TestResourcePermissionsUpdate_PatchesAddedEntries(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "data-engineers",
+							PermissionLevel: "CAN_RESTART",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_ATTACH_TO",
+								},
+							},
+						},
+						{
+							GroupName: "data-engineers",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+						{
+							UserName: TestingAdminUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		InstanceState: accessControlState(map[string]string{
+			"cluster_id": "abc",
+		}, AccessControlChange{
+			UserName:        TestingUser,
+			PermissionLevel: "CAN_ATTACH_TO",
+		}),
+		HCL: `
+		cluster_id = "abc"
+
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_ATTACH_TO"
+		}
+
+		access_control {
+			group_name = "data-engineers"
+			permission_level = "CAN_RESTART"
+		}
+		`,
+		Resource: ResourcePermissions(),
+		Update:   true,
+		ID:       "/clusters/abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"access_control.#": 2,
+	})
+}
+
+func TestAddedAccessControlChanges(t *testing.T) {
+	ben := AccessControlChange{UserName: "ben", PermissionLevel: "CAN_VIEW"}
+	benManage := AccessControlChange{UserName: "ben", PermissionLevel: "CAN_MANAGE"}
+	group := AccessControlChange{GroupName: "users", PermissionLevel: "CAN_VIEW"}
+
+	added, onlyAdded := addedAccessControlChanges([]AccessControlChange{ben}, []AccessControlChange{ben, group})
+	assert.True(t, onlyAdded)
+	assert.Equal(t, []AccessControlChange{group}, added)
+
+	_, onlyAdded = addedAccessControlChanges([]AccessControlChange{ben, group}, []AccessControlChange{ben})
+	assert.False(t, onlyAdded, "removed entries require full replacement")
+
+	_, onlyAdded = addedAccessControlChanges([]AccessControlChange{ben}, []AccessControlChange{benManage})
+	assert.False(t, onlyAdded, "changed entries require full replacement")
+}
@@ -0,0 +1,148 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	ws_api "github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// WorkspaceFileACLEntity describes access control on a workspace object, that is addressed by its path
+type WorkspaceFileACLEntity struct {
+	Path              string                `json:"path" tf:"force_new"`
+	ObjectID          string                `json:"object_id,omitempty" tf:"computed"`
+	ObjectType        string                `json:"object_type,omitempty" tf:"computed"`
+	AccessControlList []AccessControlChange `json:"access_control" tf:"slice_set"`
+}
+
+// mapping of workspace object types to the resource types of permissions API
+var workspaceObjectResourceTypes = map[ws_api.ObjectType]string{
+	ws_api.ObjectTypeFile:      "files",
+	ws_api.ObjectTypeNotebook:  "notebooks",
+	ws_api.ObjectTypeDirectory: "directories",
+	ws_api.ObjectTypeRepo:      "repos",
+}
+
+// workspace objects are available both as `/Shared/x` and `/Workspace/Shared/x`,
+// but workspace API accepts only the former.
+func normalizeWorkspacePath(path string) string {
+	if strings.HasPrefix(path, "/Workspace/") {
+		return strings.TrimPrefix(path, "/Workspace")
+	}
+	return path
+}
+
+func workspaceObjectPermissionsID(ctx context.Context, w *databricks.WorkspaceClient,
+	path string) (string, ws_api.ObjectType, error) {
+	info, err := w.Workspace.GetStatusByPath(ctx, normalizeWorkspacePath(path))
+	if err != nil {
+		return "", "", err
+	}
+	resourceType, ok := workspaceObjectResourceTypes[info.ObjectType]
+	if !ok {
+		return "", "", fmt.Errorf("access control is not supported for %s objects: %s",
+			info.ObjectType, path)
+	}
+	return fmt.Sprintf("/%s/%d", resourceType, info.ObjectId), info.ObjectType, nil
+}
+
+// ResourceWorkspaceFileACL manages access control for an arbitrary workspace object by its path
+func ResourceWorkspaceFileACL() common.Resource {
+	s := common.StructToSchema(WorkspaceFileACLEntity{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["access_control"].MinItems = 1
+		common.MustSchemaPath(s, "access_control", "permission_level").ValidateFunc = validation.StringInSlice(
+			[]string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, false)
+		return s
+	})
+	update := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (string, error) {
+		var entity WorkspaceFileACLEntity
+		common.DataToStructPointer(d, s, &entity)
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return "", err
+		}
+		me, err := w.CurrentUser.Me(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, v := range entity.AccessControlList {
+			if v.UserName == me.UserName {
+				format := "it is not possible to decrease administrative permissions for the current user: %s"
+				return "", fmt.Errorf(format, me.UserName)
+			}
+			if v.GroupName == "admins" {
+				return "", fmt.Errorf("it is not possible to restrict any permissions from `admins`")
+			}
+		}
+		objectID, _, err := workspaceObjectPermissionsID(ctx, w, entity.Path)
+		if err != nil {
+			return "", err
+		}
+		return objectID, NewPermissionsAPI(ctx, c).Update(objectID, AccessControlChangeList{
+			AccessControlList: entity.AccessControlList,
+		})
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			_, err := update(ctx, d, c)
+			if err != nil {
+				return err
+			}
+			d.SetId(d.Get("path").(string))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			objectID, objectType, err := workspaceObjectPermissionsID(ctx, w, d.Id())
+			if err != nil {
+				return err
+			}
+			objectACL, err := NewPermissionsAPI(ctx, c).Read(objectID)
+			if err != nil {
+				return err
+			}
+			me, err := w.CurrentUser.Me(ctx)
+			if err != nil {
+				return err
+			}
+			entity := WorkspaceFileACLEntity{
+				Path:              d.Id(),
+				ObjectID:          objectID,
+				ObjectType:        string(objectType),
+				AccessControlList: objectACL.directAccessControlChanges(objectID, me.UserName),
+			}
+			if len(entity.AccessControlList) == 0 {
+				// empty "modifiable" access control list is the same as resource absence
+				d.SetId("")
+				return nil
+			}
+			return common.StructToData(entity, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			_, err := update(ctx, d, c)
+			return err
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			objectID, _, err := workspaceObjectPermissionsID(ctx, w, d.Id())
+			if err != nil {
+				return err
+			}
+			return NewPermissionsAPI(ctx, c).Delete(objectID)
+		},
+		WorkspaceLevel: true,
+	}
+}
@@ -0,0 +1,206 @@
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var wheelStatus = qa.HTTPFixture{
+	ReuseRequest: true,
+	Method:       http.MethodGet,
+	Resource:     "/api/2.0/workspace/get-status?path=%2FShared%2Flib.whl",
+	Response: workspace.ObjectStatus{
+		ObjectID:   988765,
+		ObjectType: workspace.File,
+		Path:       "/Shared/lib.whl",
+	},
+}
+
+var wheelACL = ObjectACL{
+	ObjectID:   "/files/988765",
+	ObjectType: "file",
+	AccessControlList: []AccessControl{
+		{
+			GroupName: "ds",
+			AllPermissions: []Permission{
+				{
+					PermissionLevel: "CAN_READ",
+				},
+			},
+		},
+		{
+			UserName: TestingAdminUser,
+			AllPermissions: []Permission{
+				{
+					PermissionLevel: "CAN_MANAGE",
+				},
+			},
+		},
+		{
+			GroupName: "admins",
+			AllPermissions: []Permission{
+				{
+					PermissionLevel: "CAN_MANAGE",
+				},
+			},
+		},
+	},
+}
+
+func TestResourceWorkspaceFileACLCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			wheelStatus,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/files/988765",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "ds",
+							PermissionLevel: "CAN_READ",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/files/988765",
+				Response: wheelACL,
+			},
+		},
+		Resource: ResourceWorkspaceFileACL(),
+		HCL: `path = "/Workspace/Shared/lib.whl"
+
+		access_control {
+			group_name = "ds"
+			permission_level = "CAN_READ"
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "/Workspace/Shared/lib.whl", d.Id())
+	assert.Equal(t, "/files/988765", d.Get("object_id"))
+	assert.Equal(t, "FILE", d.Get("object_type"))
+	ac := d.Get("access_control").(*schema.Set)
+	require.Equal(t, 1, len(ac.List()))
+	firstElem := ac.List()[0].(map[string]any)
+	assert.Equal(t, "ds", firstElem["group_name"])
+	assert.Equal(t, "CAN_READ", firstElem["permission_level"])
+}
+
+func TestResourceWorkspaceFileACLCreate_Admins(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{me},
+		Resource: ResourceWorkspaceFileACL(),
+		HCL: `path = "/Shared/lib.whl"
+
+		access_control {
+			group_name = "admins"
+			permission_level = "CAN_READ"
+		}`,
+		Create: true,
+	}.ExpectError(t, "it is not possible to restrict any permissions from `admins`")
+}
+
+func TestResourceWorkspaceFileACLCreate_UnsupportedType(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Flib",
+				Response: workspace.ObjectStatus{
+					ObjectID:   123,
+					ObjectType: "LIBRARY",
+					Path:       "/Shared/lib",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFileACL(),
+		HCL: `path = "/Shared/lib"
+
+		access_control {
+			group_name = "ds"
+			permission_level = "CAN_READ"
+		}`,
+		Create: true,
+	}.ExpectError(t, "access control is not supported for LIBRARY objects: /Shared/lib")
+}
+
+func TestResourceWorkspaceFileACLRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			wheelStatus,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/files/988765",
+				Response: wheelACL,
+			},
+		},
+		Resource: ResourceWorkspaceFileACL(),
+		Read:     true,
+		New:      true,
+		ID:       "/Shared/lib.whl",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "/Shared/lib.whl", d.Get("path"))
+	ac := d.Get("access_control").(*schema.Set)
+	require.Equal(t, 1, len(ac.List()))
+}
+
+func TestResourceWorkspaceFileACLRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Flib.whl",
+				Response: map[string]string{
+					"error_code": "RESOURCE_DOES_NOT_EXIST",
+					"message":    "Path (/Shared/lib.whl) doesn't exist.",
+				},
+				Status: 404,
+			},
+		},
+		Resource: ResourceWorkspaceFileACL(),
+		Read:     true,
+		Removed:  true,
+		ID:       "/Shared/lib.whl",
+	}.ApplyNoError(t)
+}
+
+func TestResourceWorkspaceFileACLDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			wheelStatus,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/files/988765",
+				Response: wheelACL,
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/files/988765",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "admins",
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFileACL(),
+		Delete:   true,
+		ID:       "/Shared/lib.whl",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,82 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// pipelineIdByName returns the ID of the only pipeline with exactly the given name
+func pipelineIdByName(ctx context.Context, w *databricks.WorkspaceClient, name string) (string, error) {
+	list, err := w.Pipelines.ListPipelinesAll(ctx, pipelines.ListPipelinesRequest{
+		Filter:     fmt.Sprintf("name LIKE '%s'", strings.ReplaceAll(name, "'", "''")),
+		MaxResults: 100,
+	})
+	if err != nil {
+		return "", err
+	}
+	ids := []string{}
+	for _, p := range list {
+		if p.Name == name {
+			ids = append(ids, p.PipelineId)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("there is no pipeline with name '%s'", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("there are %d pipelines with name '%s', use pipeline_id instead", len(ids), name)
+	}
+}
+
+// DataSourcePipeline returns the state of the pipeline specified by ID or name
+func DataSourcePipeline() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Id                string                      `json:"id,omitempty" tf:"computed"`
+		PipelineId        string                      `json:"pipeline_id,omitempty" tf:"computed"`
+		PipelineName      string                      `json:"pipeline_name,omitempty" tf:"computed"`
+		State             string                      `json:"state,omitempty" tf:"computed"`
+		Health            string                      `json:"health,omitempty" tf:"computed"`
+		Cause             string                      `json:"cause,omitempty" tf:"computed"`
+		ClusterId         string                      `json:"cluster_id,omitempty" tf:"computed"`
+		CreatorUserName   string                      `json:"creator_user_name,omitempty" tf:"computed"`
+		RunAsUserName     string                      `json:"run_as_user_name,omitempty" tf:"computed"`
+		LatestUpdateState string                      `json:"latest_update_state,omitempty" tf:"computed"`
+		LatestUpdates     []pipelines.UpdateStateInfo `json:"latest_updates,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		if data.PipelineId == "" && data.PipelineName == "" {
+			return fmt.Errorf("either pipeline_id or pipeline_name has to be specified")
+		}
+		if data.PipelineId == "" {
+			id, err := pipelineIdByName(ctx, w, data.PipelineName)
+			if err != nil {
+				return err
+			}
+			data.PipelineId = id
+		}
+		p, err := w.Pipelines.GetByPipelineId(ctx, data.PipelineId)
+		if err != nil {
+			return err
+		}
+		data.Id = p.PipelineId
+		data.PipelineName = p.Name
+		data.State = string(p.State)
+		data.Health = string(p.Health)
+		data.Cause = p.Cause
+		data.ClusterId = p.ClusterId
+		data.CreatorUserName = p.CreatorUserName
+		data.RunAsUserName = p.RunAsUserName
+		data.LatestUpdates = p.LatestUpdates
+		// updates are ordered with the newest one first
+		if len(p.LatestUpdates) > 0 {
+			data.LatestUpdateState = string(p.LatestUpdates[0].State)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,119 @@
+package pipelines
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+var ingestionPipeline = &pipelines.GetPipelineResponse{
+	PipelineId:      "abc",
+	Name:            "Ingestion",
+	State:           pipelines.PipelineStateIdle,
+	Health:          pipelines.GetPipelineResponseHealthHealthy,
+	CreatorUserName: "user@example.com",
+	LatestUpdates: []pipelines.UpdateStateInfo{
+		{
+			UpdateId: "u2",
+			State:    pipelines.UpdateStateInfoStateCompleted,
+		},
+		{
+			UpdateId: "u1",
+			State:    pipelines.UpdateStateInfoStateFailed,
+		},
+	},
+}
+
+func TestDataSourcePipelineByName(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockPipelinesAPI().EXPECT()
+			e.ListPipelinesAll(mock.Anything, pipelines.ListPipelinesRequest{
+				Filter:     "name LIKE 'Ingestion'",
+				MaxResults: 100,
+			}).Return([]pipelines.PipelineStateInfo{
+				{PipelineId: "abc", Name: "Ingestion"},
+				{PipelineId: "def", Name: "Ingestion (copy)"},
+			}, nil)
+			e.GetByPipelineId(mock.Anything, "abc").Return(ingestionPipeline, nil)
+		},
+		Resource:    DataSourcePipeline(),
+		HCL:         `pipeline_name = "Ingestion"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "abc",
+		"pipeline_id":                "abc",
+		"state":                      "IDLE",
+		"health":                     "HEALTHY",
+		"creator_user_name":          "user@example.com",
+		"latest_update_state":        "COMPLETED",
+		"latest_updates.#":           2,
+		"latest_updates.1.update_id": "u1",
+	})
+}
+
+func TestDataSourcePipelineById(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockPipelinesAPI().EXPECT().GetByPipelineId(mock.Anything, "abc").Return(ingestionPipeline, nil)
+		},
+		Resource:    DataSourcePipeline(),
+		HCL:         `pipeline_id = "abc"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":            "abc",
+		"pipeline_name": "Ingestion",
+	})
+}
+
+func TestDataSourcePipelineByName_Ambiguous(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockPipelinesAPI().EXPECT().ListPipelinesAll(mock.Anything, pipelines.ListPipelinesRequest{
+				Filter:     "name LIKE 'Ingestion'",
+				MaxResults: 100,
+			}).Return([]pipelines.PipelineStateInfo{
+				{PipelineId: "abc", Name: "Ingestion"},
+				{PipelineId: "def", Name: "Ingestion"},
+			}, nil)
+		},
+		Resource:    DataSourcePipeline(),
+		HCL:         `pipeline_name = "Ingestion"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "there are 2 pipelines with name 'Ingestion', use pipeline_id instead")
+}
+
+func TestDataSourcePipelineByName_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockPipelinesAPI().EXPECT().ListPipelinesAll(mock.Anything, pipelines.ListPipelinesRequest{
+				Filter:     "name LIKE 'Bob''s pipeline'",
+				MaxResults: 100,
+			}).Return(nil, nil)
+		},
+		Resource:    DataSourcePipeline(),
+		HCL:         `pipeline_name = "Bob's pipeline"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "there is no pipeline with name 'Bob's pipeline'")
+}
+
+func TestDataSourcePipeline_NoArguments(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {},
+		Resource:                DataSourcePipeline(),
+		Read:                    true,
+		NonWritable:             true,
+		ID:                      "_",
+	}.ExpectError(t, "either pipeline_id or pipeline_name has to be specified")
+}
@@ -10,10 +10,20 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+type pipelineSummary struct {
+	Id                string `json:"id"`
+	Name              string `json:"name"`
+	State             string `json:"state,omitempty"`
+	ClusterId         string `json:"cluster_id,omitempty"`
+	CreatorUserName   string `json:"creator_user_name,omitempty"`
+	LatestUpdateState string `json:"latest_update_state,omitempty"`
+}
+
 func DataSourcePipelines() common.Resource {
 	type pipelinesData struct {
-		PipelineNameContains string   `json:"pipeline_name,omitempty"`
-		Ids                  []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		PipelineNameContains string            `json:"pipeline_name,omitempty"`
+		Ids                  []string          `json:"ids,omitempty" tf:"computed,slice_set"`
+		Pipelines            []pipelineSummary `json:"pipelines,omitempty" tf:"computed"`
 	}
 	return common.WorkspaceData(func(ctx context.Context, data *pipelinesData, w *databricks.WorkspaceClient) error {
 		pipelineSearch := pipelines.ListPipelinesRequest{MaxResults: 100}
@@ -30,9 +40,24 @@ func DataSourcePipelines() common.Resource {
 
 		for _, p := range pipelines {
 			data.Ids = append(data.Ids, p.PipelineId)
+			summary := pipelineSummary{
+				Id:              p.PipelineId,
+				Name:            p.Name,
+				State:           string(p.State),
+				ClusterId:       p.ClusterId,
+				CreatorUserName: p.CreatorUserName,
+			}
+			// updates are ordered with the newest one first
+			if len(p.LatestUpdates) > 0 {
+				summary.LatestUpdateState = string(p.LatestUpdates[0].State)
+			}
+			data.Pipelines = append(data.Pipelines, summary)
 		}
 
 		sort.Strings(data.Ids)
+		sort.Slice(data.Pipelines, func(i, j int) bool {
+			return data.Pipelines[i].Id < data.Pipelines[j].Id
+		})
 
 		return nil
 
@@ -3,6 +3,7 @@ package pipelines
 import (
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	"github.com/databricks/terraform-provider-databricks/qa"
 )
 
@@ -125,3 +126,51 @@ func TestDataSourcePipelines_NoneFound(t *testing.T) {
 		ID: "_",
 	}.ApplyNoError(t)
 }
+
+func TestDataSourcePipelines_Summaries(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines?max_results=100",
+				Response: pipelines.ListPipelinesResponse{
+					Statuses: []pipelines.PipelineStateInfo{
+						{
+							PipelineId: "456",
+							Name:       "Pipeline2",
+							State:      pipelines.PipelineStateFailed,
+						},
+						{
+							PipelineId: "123",
+							Name:       "Pipeline1",
+							State:      pipelines.PipelineStateRunning,
+							ClusterId:  "0101-abc",
+							LatestUpdates: []pipelines.UpdateStateInfo{
+								{
+									UpdateId: "u2",
+									State:    pipelines.UpdateStateInfoStateRunning,
+								},
+								{
+									UpdateId: "u1",
+									State:    pipelines.UpdateStateInfoStateCompleted,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourcePipelines(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"pipelines.#":                     2,
+		"pipelines.0.id":                  "123",
+		"pipelines.0.state":               "RUNNING",
+		"pipelines.0.cluster_id":          "0101-abc",
+		"pipelines.0.latest_update_state": "RUNNING",
+		"pipelines.1.name":                "Pipeline2",
+		"pipelines.1.state":               "FAILED",
+	})
+}
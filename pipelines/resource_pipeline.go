@@ -16,6 +16,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/libraries"
+	"github.com/databricks/terraform-provider-databricks/scim"
 )
 
 // DefaultTimeout is the default amount of time that Terraform will wait when creating, updating and deleting pipelines.
@@ -96,6 +97,12 @@ type Notification struct {
 	Alerts          []string `json:"alerts" tf:"min_items:1"`
 }
 
+// PipelineRunAs is the identity, that updates of the pipeline run as. It's also the owner of the pipeline.
+type PipelineRunAs struct {
+	UserName             string `json:"user_name,omitempty"`
+	ServicePrincipalName string `json:"service_principal_name,omitempty"`
+}
+
 type PipelineSpec struct {
 	ID                  string            `json:"id,omitempty" tf:"computed"`
 	Name                string            `json:"name,omitempty"`
@@ -114,6 +121,7 @@ type PipelineSpec struct {
 	Channel             string            `json:"channel,omitempty" tf:"suppress_diff,default:CURRENT"`
 	Notifications       []Notification    `json:"notifications,omitempty" tf:"alias:notification"`
 	Serverless          bool              `json:"serverless" tf:"optional"`
+	RunAs               *PipelineRunAs    `json:"run_as,omitempty" tf:"computed"`
 }
 
 type createPipelineResponse struct {
@@ -146,15 +154,17 @@ const (
 )
 
 type PipelineInfo struct {
-	PipelineID      string                `json:"pipeline_id"`
-	Spec            *PipelineSpec         `json:"spec"`
-	State           *PipelineState        `json:"state"`
-	Cause           string                `json:"cause"`
-	ClusterID       string                `json:"cluster_id"`
-	Name            string                `json:"name"`
-	Health          *PipelineHealthStatus `json:"health"`
-	CreatorUserName string                `json:"creator_user_name"`
-	LastModified    int64                 `json:"last_modified"`
+	PipelineID      string                    `json:"pipeline_id"`
+	Spec            *PipelineSpec             `json:"spec"`
+	State           *PipelineState            `json:"state"`
+	Cause           string                    `json:"cause"`
+	ClusterID       string                    `json:"cluster_id"`
+	Name            string                    `json:"name"`
+	Health          *PipelineHealthStatus     `json:"health"`
+	CreatorUserName string                    `json:"creator_user_name"`
+	RunAsUserName   string                    `json:"run_as_user_name,omitempty"`
+	LastModified    int64                     `json:"last_modified"`
+	LatestUpdates   []PipelineUpdateStateInfo `json:"latest_updates,omitempty"`
 }
 
 type PipelineUpdateStateInfo struct {
@@ -228,24 +238,96 @@ func adjustForceSendFields(s *PipelineSpec) {
 
 func (a PipelinesAPI) Read(id string) (p PipelineInfo, err error) {
 	err = a.client.Get(a.ctx, "/pipelines/"+id, nil, &p)
+	if err != nil {
+		return
+	}
+	// `run_as` can only be set on write and isn't returned in the spec, so it's populated from the
+	// top-level `run_as_user_name` field, so that Terraform can still diff it with the intended state.
+	if p.Spec != nil && p.RunAsUserName != "" {
+		if common.StringIsUUID(p.RunAsUserName) {
+			p.Spec.RunAs = &PipelineRunAs{
+				ServicePrincipalName: p.RunAsUserName,
+			}
+		} else {
+			p.Spec.RunAs = &PipelineRunAs{
+				UserName: p.RunAsUserName,
+			}
+		}
+	}
 	return
 }
 
+// keepIgnoredTags adds custom tags of pipeline clusters, that aren't managed by Terraform, to the clusters
+// with the same label, so that the edit doesn't remove them
+func (a PipelinesAPI) keepIgnoredTags(id string, s *PipelineSpec) error {
+	remote, err := a.Read(id)
+	if err != nil {
+		return err
+	}
+	if remote.Spec == nil {
+		return nil
+	}
+	for i := range s.Clusters {
+		for _, rc := range remote.Spec.Clusters {
+			if rc.Label == s.Clusters[i].Label {
+				s.Clusters[i].CustomTags = a.client.KeepIgnoredTags(s.Clusters[i].CustomTags, rc.CustomTags)
+			}
+		}
+	}
+	return nil
+}
+
+// checkRunAsExists fails before the pipeline is changed, if the user or the service principal to run as
+// isn't added to the workspace. It doesn't check if the caller is allowed to use the service principal.
+func (a PipelinesAPI) checkRunAsExists(runAs *PipelineRunAs) error {
+	if runAs == nil {
+		return nil
+	}
+	if runAs.UserName != "" {
+		users, err := scim.NewUsersAPI(a.ctx, a.client).Filter(
+			fmt.Sprintf(`userName eq "%s"`, runAs.UserName), true)
+		if err != nil {
+			return err
+		}
+		if len(users) == 0 {
+			return fmt.Errorf("cannot run pipeline as user %s, because it isn't added to the workspace",
+				runAs.UserName)
+		}
+	}
+	if runAs.ServicePrincipalName != "" {
+		sps, err := scim.NewServicePrincipalsAPI(a.ctx, a.client).Filter(
+			fmt.Sprintf(`applicationId eq "%s"`, runAs.ServicePrincipalName), true)
+		if err != nil {
+			return err
+		}
+		if len(sps) == 0 {
+			return fmt.Errorf("cannot run pipeline as service principal %s, because it isn't added to the workspace",
+				runAs.ServicePrincipalName)
+		}
+	}
+	return nil
+}
+
 func (a PipelinesAPI) Update(id string, s PipelineSpec, timeout time.Duration) error {
-	adjustForceSendFields(&s)
-	err := a.client.Put(a.ctx, "/pipelines/"+id, s)
+	err := a.Edit(id, s)
 	if err != nil {
 		return err
 	}
 	return a.waitForState(id, timeout, StateRunning)
 }
 
+// Edit changes the pipeline settings without waiting for the pipeline to get back to running state
+func (a PipelinesAPI) Edit(id string, s PipelineSpec) error {
+	adjustForceSendFields(&s)
+	return a.client.Put(a.ctx, "/pipelines/"+id, s)
+}
+
 func (a PipelinesAPI) Delete(id string, timeout time.Duration) error {
 	err := a.client.Delete(a.ctx, "/pipelines/"+id, map[string]string{})
 	if err != nil {
 		return err
 	}
-	return resource.RetryContext(a.ctx, timeout,
+	return common.RetryContext(a.ctx, timeout,
 		func() *resource.RetryError {
 			i, err := a.Read(id)
 			if err != nil {
@@ -285,7 +367,7 @@ func (a PipelinesAPI) List(pageSize int, filter string) ([]PipelineStateInfo, er
 }
 
 func (a PipelinesAPI) waitForState(id string, timeout time.Duration, desiredState PipelineState) error {
-	return resource.RetryContext(a.ctx, timeout,
+	return common.RetryContext(a.ctx, timeout,
 		func() *resource.RetryError {
 			i, err := a.Read(id)
 			if err != nil {
@@ -328,6 +410,8 @@ func adjustPipelineResourceSchema(m map[string]*schema.Schema) map[string]*schem
 	common.MustSchemaPath(clustersSchema, "autoscale", "mode").DiffSuppressFunc = common.EqualFoldDiffSuppress
 
 	common.MustSchemaPath(clustersSchema, "init_scripts", "dbfs").Deprecated = clusters.DbfsDeprecationWarning
+	clusters.SetInitScriptsValidation(clustersSchema)
+	clusters.SetClusterLogConfValidation(clustersSchema)
 
 	gcpAttributes, _ := clustersSchema["gcp_attributes"].Elem.(*schema.Resource)
 	gcpAttributesSchema := gcpAttributes.Schema
@@ -339,10 +423,35 @@ func adjustPipelineResourceSchema(m map[string]*schema.Schema) map[string]*schem
 		Type:     schema.TypeString,
 		Computed: true,
 	}
+	m["latest_update"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"update_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"creation_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
 	m["channel"].ValidateFunc = validation.StringInSlice([]string{"current", "preview"}, true)
 	m["edition"].ValidateFunc = validation.StringInSlice([]string{"pro", "core", "advanced"}, true)
 	m["edition"].DiffSuppressFunc = common.EqualFoldDiffSuppress
 
+	// we need to have only one of user name vs service principal in the run_as block
+	run_as_eoo := []string{"run_as.0.user_name", "run_as.0.service_principal_name"}
+	common.MustSchemaPath(m, "run_as", "user_name").ExactlyOneOf = run_as_eoo
+	common.MustSchemaPath(m, "run_as", "service_principal_name").ExactlyOneOf = run_as_eoo
+
 	m["storage"].DiffSuppressFunc = suppressStorageDiff
 	m["storage"].ConflictsWith = []string{"catalog"}
 	m["catalog"].ConflictsWith = []string{"storage"}
@@ -359,6 +468,9 @@ func ResourcePipeline() common.Resource {
 			var s PipelineSpec
 			common.DataToStructPointer(d, pipelineSchema, &s)
 			api := NewPipelinesAPI(ctx, c)
+			if err := api.checkRunAsExists(s.RunAs); err != nil {
+				return err
+			}
 			id, err := api.Create(s, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
@@ -375,12 +487,53 @@ func ResourcePipeline() common.Resource {
 			if i.Spec == nil {
 				return fmt.Errorf("pipeline spec is nil for '%v'", i.PipelineID)
 			}
+			latestUpdate := []map[string]any{}
+			if len(i.LatestUpdates) > 0 {
+				update := i.LatestUpdates[0]
+				state := ""
+				if update.State != nil {
+					state = string(*update.State)
+				}
+				latestUpdate = append(latestUpdate, map[string]any{
+					"update_id":     update.UpdateID,
+					"state":         state,
+					"creation_time": update.CreationTime,
+				})
+			}
+			if err = d.Set("latest_update", latestUpdate); err != nil {
+				return err
+			}
+			for k := range i.Spec.Clusters {
+				cluster := &i.Spec.Clusters[k]
+				configured, _ := d.Get(fmt.Sprintf("cluster.%d.custom_tags", k)).(map[string]any)
+				cluster.CustomTags = c.RemoveIgnoredTags(cluster.CustomTags, configured)
+			}
 			return common.StructToData(*i.Spec, pipelineSchema, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var s PipelineSpec
 			common.DataToStructPointer(d, pipelineSchema, &s)
-			return NewPipelinesAPI(ctx, c).Update(d.Id(), s, d.Timeout(schema.TimeoutUpdate))
+			api := NewPipelinesAPI(ctx, c)
+			if d.HasChange("run_as") {
+				// changing the identity also transfers the ownership of the pipeline to it
+				if err := api.checkRunAsExists(s.RunAs); err != nil {
+					return err
+				}
+			} else {
+				s.RunAs = nil
+			}
+			if len(c.IgnoreTags) > 0 {
+				err := api.keepIgnoredTags(d.Id(), &s)
+				if err != nil {
+					return err
+				}
+			}
+			if !d.HasChangesExcept("development") {
+				// switching between development and production modes is applied with the next update,
+				// so there is no need to wait for an active update to restart
+				return api.Edit(d.Id(), s)
+			}
+			return api.Update(d.Id(), s, d.Timeout(schema.TimeoutUpdate))
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			api := NewPipelinesAPI(ctx, c)
@@ -389,5 +542,6 @@ func ResourcePipeline() common.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(DefaultTimeout),
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -109,6 +110,186 @@ func TestResourcePipelineCreate(t *testing.T) {
 	assert.Equal(t, "abcd", d.Id())
 }
 
+func TestResourcePipelineCreate_RunAsServicePrincipal(t *testing.T) {
+	spName := "00000000-0000-0000-0000-000000000001"
+	spec := PipelineSpec{
+		Name:    "test-pipeline",
+		Catalog: "main",
+		Libraries: []PipelineLibrary{
+			{
+				Notebook: &NotebookLibrary{
+					Path: "/Test",
+				},
+			},
+		},
+		Edition: "ADVANCED",
+		Channel: "CURRENT",
+	}
+	withRunAs := spec
+	withRunAs.RunAs = &PipelineRunAs{
+		ServicePrincipalName: spName,
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=applicationId%20eq%20%22" + spName + "%22",
+				Response: scim.UserList{
+					Resources: []scim.User{
+						{
+							ApplicationID: spName,
+						},
+					},
+				},
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/pipelines",
+				ExpectedRequest: withRunAs,
+				Response: createPipelineResponse{
+					PipelineID: "abcd",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/pipelines/abcd",
+				ReuseRequest: true,
+				Response: map[string]any{
+					"pipeline_id":      "abcd",
+					"state":            "RUNNING",
+					"spec":             spec,
+					"run_as_user_name": spName,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "test-pipeline"
+		catalog = "main"
+		library {
+		  notebook {
+			path = "/Test"
+		  }
+		}
+		run_as {
+		  service_principal_name = "` + spName + `"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                              "abcd",
+		"run_as.0.service_principal_name": spName,
+	})
+}
+
+func TestResourcePipelineCreate_RunAsMissingServicePrincipal(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=applicationId%20eq%20%22abc%22",
+				Response: scim.UserList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "test-pipeline"
+		catalog = "main"
+		library {
+		  notebook {
+			path = "/Test"
+		  }
+		}
+		run_as {
+		  service_principal_name = "abc"
+		}
+		`,
+	}.ExpectError(t, "cannot run pipeline as service principal abc, because it isn't added to the workspace")
+}
+
+func TestResourcePipelineCreate_RunAsMissingUser(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22",
+				Response: scim.UserList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "test-pipeline"
+		catalog = "main"
+		library {
+		  notebook {
+			path = "/Test"
+		  }
+		}
+		run_as {
+		  user_name = "me@example.com"
+		}
+		`,
+	}.ExpectError(t, "cannot run pipeline as user me@example.com, because it isn't added to the workspace")
+}
+
+func TestResourcePipelineUpdate_RunAsUnchanged(t *testing.T) {
+	spec := PipelineSpec{
+		ID:      "abcd",
+		Name:    "test-pipeline",
+		Catalog: "main",
+		Libraries: []PipelineLibrary{
+			{
+				Notebook: &NotebookLibrary{
+					Path: "/Updated",
+				},
+			},
+		},
+		Edition: "ADVANCED",
+		Channel: "CURRENT",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PUT",
+				Resource:        "/api/2.0/pipelines/abcd",
+				ExpectedRequest: spec,
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/pipelines/abcd",
+				ReuseRequest: true,
+				Response: map[string]any{
+					"pipeline_id":      "abcd",
+					"state":            "RUNNING",
+					"spec":             spec,
+					"run_as_user_name": "me@example.com",
+				},
+			},
+		},
+		Update:   true,
+		ID:       "abcd",
+		Resource: ResourcePipeline(),
+		InstanceState: map[string]string{
+			"id":                 "abcd",
+			"name":               "test-pipeline",
+			"catalog":            "main",
+			"edition":            "ADVANCED",
+			"channel":            "CURRENT",
+			"run_as.#":           "1",
+			"run_as.0.user_name": "me@example.com",
+		},
+		HCL: `name = "test-pipeline"
+		catalog = "main"
+		library {
+		  notebook {
+			path = "/Updated"
+		  }
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"run_as.0.user_name": "me@example.com",
+	})
+}
+
 func TestResourcePipelineCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -267,6 +448,110 @@ func TestResourcePipelineRead(t *testing.T) {
 	assert.Equal(t, false, d.Get("continuous"))
 }
 
+func TestResourcePipelineRead_IgnoreTags(t *testing.T) {
+	spec := basicPipelineSpec
+	spec.Clusters = []pipelineCluster{
+		{
+			Label: "default",
+			CustomTags: map[string]string{
+				"cluster_tag1": "cluster_value1",
+				"owner":        "finance",
+			},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd",
+				Response: PipelineInfo{
+					PipelineID: "abcd",
+					Spec:       &spec,
+				},
+			},
+		},
+		Resource:   ResourcePipeline(),
+		IgnoreTags: []string{"owner"},
+		Read:       true,
+		New:        true,
+		ID:         "abcd",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"cluster_tag1": "cluster_value1"}, d.Get("cluster.0.custom_tags"))
+}
+
+func TestResourcePipelineUpdate_KeepsIgnoredTags(t *testing.T) {
+	state := StateRunning
+	remote := PipelineSpec{
+		ID:      "abcd",
+		Name:    "test",
+		Storage: "/test/storage",
+		Clusters: []pipelineCluster{
+			{
+				Label: "default",
+				CustomTags: map[string]string{
+					"team":  "data",
+					"owner": "finance",
+				},
+			},
+		},
+		Channel: "CURRENT",
+		Edition: "ADVANCED",
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/pipelines/abcd",
+				ReuseRequest: true,
+				Response: PipelineInfo{
+					PipelineID: "abcd",
+					Spec:       &remote,
+					State:      &state,
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/pipelines/abcd",
+				ExpectedRequest: PipelineSpec{
+					ID:      "abcd",
+					Name:    "test",
+					Storage: "/test/storage",
+					Clusters: []pipelineCluster{
+						{
+							Label: "default",
+							CustomTags: map[string]string{
+								"team":  "ml",
+								"owner": "finance",
+							},
+						},
+					},
+					Channel: "CURRENT",
+					Edition: "ADVANCED",
+				},
+			},
+		},
+		Resource:   ResourcePipeline(),
+		IgnoreTags: []string{"owner"},
+		HCL: `name = "test"
+		storage = "/test/storage"
+		cluster {
+			label = "default"
+			custom_tags = {
+				team = "ml"
+			}
+		}`,
+		InstanceState: map[string]string{
+			"name":    "test",
+			"storage": "/test/storage",
+		},
+		Update: true,
+		ID:     "abcd",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abcd", d.Id())
+}
+
 func TestResourcePipelineRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -375,6 +660,72 @@ func TestResourcePipelineUpdate(t *testing.T) {
 	assert.Equal(t, "abcd", d.Id(), "Id should be the same as in reading")
 }
 
+func TestResourcePipelineUpdate_DevelopmentModeOnly(t *testing.T) {
+	state := StateRunning
+	updateState := StateRunning
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/pipelines/abcd",
+				ExpectedRequest: PipelineSpec{
+					ID:          "abcd",
+					Name:        "test",
+					Storage:     "/test/storage",
+					Continuous:  true,
+					Development: true,
+					Channel:     "CURRENT",
+					Edition:     "ADVANCED",
+				},
+			},
+			// no waiting for the pipeline to get back to the running state, only a read after the update
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd",
+				Response: PipelineInfo{
+					PipelineID: "abcd",
+					Spec: &PipelineSpec{
+						ID:          "abcd",
+						Name:        "test",
+						Storage:     "/test/storage",
+						Continuous:  true,
+						Development: true,
+						Channel:     "CURRENT",
+						Edition:     "ADVANCED",
+					},
+					State: &state,
+					LatestUpdates: []PipelineUpdateStateInfo{
+						{
+							UpdateID:     "u1",
+							State:        &updateState,
+							CreationTime: "2024-01-01T00:00:00.000Z",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePipeline(),
+		HCL: `name = "test"
+		storage = "/test/storage"
+		continuous = true
+		development = true`,
+		InstanceState: map[string]string{
+			"name":        "test",
+			"storage":     "/test/storage",
+			"continuous":  "true",
+			"development": "false",
+			"channel":     "CURRENT",
+			"edition":     "ADVANCED",
+		},
+		Update: true,
+		ID:     "abcd",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, true, d.Get("development"))
+	assert.Equal(t, "u1", d.Get("latest_update.0.update_id"))
+	assert.Equal(t, "RUNNING", d.Get("latest_update.0.state"))
+}
+
 func TestResourcePipelineUpdate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -875,3 +1226,51 @@ func TestDefault(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "abcd", d.Id())
 }
+
+func TestResourcePipelineCreate_InvalidInitScripts(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `
+		name = "test-pipeline"
+		library {
+			notebook {
+				path = "/Test"
+			}
+		}
+		cluster {
+			label = "default"
+			init_scripts {
+				workspace {
+					destination = "init.sh"
+				}
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [cluster.#.init_scripts.#.workspace.#.destination] "+
+		"invalid value for cluster.0.init_scripts.0.workspace.0.destination "+
+		"(workspace init script must be an absolute path, like /Users/me@example.com/init.sh)")
+}
+
+func TestResourcePipelineCreate_InvalidClusterLogConfVolumes(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `
+		name = "test-pipeline"
+		library {
+			notebook {
+				path = "/Test"
+			}
+		}
+		cluster {
+			label = "default"
+			cluster_log_conf {
+				volumes {
+					destination = "/logs"
+				}
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [cluster.#.cluster_log_conf.#.volumes.#.destination] "+
+		"invalid value for cluster.0.cluster_log_conf.0.volumes.0.destination "+
+		"(cluster logs must be delivered to a Unity Catalog volume path, like /Volumes/main/default/logs)")
+}
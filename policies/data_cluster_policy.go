@@ -2,8 +2,12 @@ package policies
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
@@ -11,6 +15,7 @@ import (
 func DataSourceClusterPolicy() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
 		Id                              string `json:"id,omitempty" tf:"computed"`
+		PolicyId                        string `json:"policy_id,omitempty" tf:"computed"`
 		Name                            string `json:"name,omitempty" tf:"computed"`
 		Definition                      string `json:"definition,omitempty" tf:"computed"`
 		Description                     string `json:"description,omitempty" tf:"computed"`
@@ -18,18 +23,51 @@ func DataSourceClusterPolicy() common.Resource {
 		PolicyFamilyDefinitionOverrides string `json:"policy_family_definition_overrides,omitempty" tf:"computed"`
 		IsDefault                       bool   `json:"is_default,omitempty" tf:"computed"`
 		MaxClustersPerUser              int    `json:"max_clusters_per_user,omitempty" tf:"computed"`
+		// Attributes of the policy, that reference workspace-specific objects like instance pools
+		ExcludeAttributes  []string `json:"exclude_attributes,omitempty"`
+		RenderedDefinition string   `json:"rendered_definition,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
-		policy, err := w.ClusterPolicies.GetByName(ctx, data.Name)
+		var policy *compute.Policy
+		var err error
+		switch {
+		case data.PolicyId != "":
+			policy, err = w.ClusterPolicies.GetByPolicyId(ctx, data.PolicyId)
+		case data.Name != "":
+			policy, err = w.ClusterPolicies.GetByName(ctx, data.Name)
+		default:
+			return fmt.Errorf("either name or policy_id has to be specified")
+		}
 		if err != nil {
 			return err
 		}
 		data.Id = policy.PolicyId
+		data.PolicyId = policy.PolicyId
+		data.Name = policy.Name
 		data.Definition = policy.Definition
 		data.Description = policy.Description
 		data.PolicyFamilyId = policy.PolicyFamilyId
 		data.PolicyFamilyDefinitionOverrides = policy.PolicyFamilyDefinitionOverrides
 		data.IsDefault = policy.IsDefault
 		data.MaxClustersPerUser = int(policy.MaxClustersPerUser)
-		return nil
+		data.RenderedDefinition, err = renderPolicyDefinition(policy.Definition, data.ExcludeAttributes)
+		return err
 	})
 }
+
+// renderPolicyDefinition returns the canonical policy definition without the excluded attributes,
+// so that it could be used to create the same policy in other workspaces
+func renderPolicyDefinition(definition string, exclude []string) (string, error) {
+	parsed, err := parsePolicyDefinition(definition)
+	if err != nil {
+		return "", err
+	}
+	for key := range parsed {
+		for _, attr := range exclude {
+			if key == attr || strings.HasPrefix(key, attr+".") {
+				delete(parsed, key)
+			}
+		}
+	}
+	out, err := json.Marshal(parsed)
+	return string(out), err
+}
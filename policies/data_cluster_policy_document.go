@@ -0,0 +1,109 @@
+package policies
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// parsePolicyDefinition decodes JSON object, keeping numbers as they are written
+func parsePolicyDefinition(definition string) (map[string]any, error) {
+	out := map[string]any{}
+	if definition == "" {
+		return out, nil
+	}
+	decoder := json.NewDecoder(bytes.NewBufferString(definition))
+	decoder.UseNumber()
+	err := decoder.Decode(&out)
+	return out, err
+}
+
+// mergePolicyDefinitions deep-merges override into base: nested objects are merged,
+// `null` values remove the key and all other values replace the ones from base
+func mergePolicyDefinitions(base, override map[string]any) map[string]any {
+	for k, v := range override {
+		if v == nil {
+			delete(base, k)
+			continue
+		}
+		overrideObject, ok := v.(map[string]any)
+		if !ok {
+			base[k] = v
+			continue
+		}
+		baseObject, ok := base[k].(map[string]any)
+		if !ok {
+			baseObject = map[string]any{}
+		}
+		base[k] = mergePolicyDefinitions(baseObject, overrideObject)
+	}
+	return base
+}
+
+// canonicalPolicyDefinition returns compact JSON with sorted keys
+func canonicalPolicyDefinition(definition string) (string, error) {
+	parsed, err := parsePolicyDefinition(definition)
+	if err != nil {
+		return "", err
+	}
+	out, err := json.Marshal(parsed)
+	return string(out), err
+}
+
+// suppressPolicyDefinitionDiff ignores formatting and key order of the policy definition, which is normalized by the API
+func suppressPolicyDefinitionDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldCanonical, err := canonicalPolicyDefinition(old)
+	if err != nil {
+		return common.SuppressDiffWhitespaceChange(k, old, new, d)
+	}
+	newCanonical, err := canonicalPolicyDefinition(new)
+	if err != nil {
+		return common.SuppressDiffWhitespaceChange(k, old, new, d)
+	}
+	return oldCanonical == newCanonical
+}
+
+// DataSourceClusterPolicyDocument merges base cluster policy definition with overrides
+func DataSourceClusterPolicyDocument() common.Resource {
+	return common.Resource{
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			merged, err := parsePolicyDefinition(d.Get("base").(string))
+			if err != nil {
+				return fmt.Errorf("base: %w", err)
+			}
+			for i, v := range d.Get("overrides").([]any) {
+				override, err := parsePolicyDefinition(v.(string))
+				if err != nil {
+					return fmt.Errorf("overrides[%d]: %w", i, err)
+				}
+				merged = mergePolicyDefinitions(merged, override)
+			}
+			definition, err := json.Marshal(merged)
+			if err != nil {
+				return err
+			}
+			d.SetId(fmt.Sprintf("%x", sha256.Sum256(definition)))
+			return d.Set("definition", string(definition))
+		},
+		Schema: map[string]*schema.Schema{
+			"base": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package policies
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceClusterPolicyDocument(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataSourceClusterPolicyDocument(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		base = <<EOT
+		{
+			"spark_version": {"type": "unlimited", "defaultValue": "auto:latest-lts"},
+			"autotermination_minutes": {"type": "range", "maxValue": 120, "defaultValue": 60},
+			"custom_tags.Team": {"type": "fixed", "value": "platform"}
+		}
+		EOT
+		overrides = [
+			"{\"custom_tags.Team\": {\"value\": \"analytics\"}}",
+			"{\"autotermination_minutes\": {\"defaultValue\": null}, \"node_type_id\": {\"type\": \"fixed\", \"value\": \"i3.xlarge\"}}",
+		]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"definition": `{"autotermination_minutes":{"maxValue":120,"type":"range"},` +
+			`"custom_tags.Team":{"type":"fixed","value":"analytics"},` +
+			`"node_type_id":{"type":"fixed","value":"i3.xlarge"},` +
+			`"spark_version":{"defaultValue":"auto:latest-lts","type":"unlimited"}}`,
+	})
+}
+
+func TestDataSourceClusterPolicyDocument_InvalidOverride(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataSourceClusterPolicyDocument(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		base = "{}"
+		overrides = ["{\"a\": {}}", "not json"]
+		`,
+	}.ExpectError(t, "overrides[1]: invalid character 'o' in literal null (expecting 'u')")
+}
+
+func TestSuppressPolicyDefinitionDiff(t *testing.T) {
+	assert.True(t, suppressPolicyDefinitionDiff("definition",
+		`{"b": {"value": 1, "type": "fixed"}, "a": {"type": "unlimited"}}`,
+		`{"a":{"type":"unlimited"},"b":{"type":"fixed","value":1}}`, nil))
+	assert.False(t, suppressPolicyDefinitionDiff("definition",
+		`{"a": {"type": "fixed", "value": 1}}`,
+		`{"a": {"type": "fixed", "value": 2}}`, nil))
+	assert.True(t, suppressPolicyDefinitionDiff("definition", "not json ", "not json", nil))
+}
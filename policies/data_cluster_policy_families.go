@@ -0,0 +1,40 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type policyFamily struct {
+	PolicyFamilyId string `json:"policy_family_id" tf:"computed"`
+	Name           string `json:"name" tf:"computed"`
+	Description    string `json:"description,omitempty" tf:"computed"`
+	Definition     string `json:"definition,omitempty" tf:"computed"`
+}
+
+// DataSourceClusterPolicyFamilies returns the Databricks-provided cluster policy families
+func DataSourceClusterPolicyFamilies() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		PolicyFamilies []policyFamily    `json:"policy_families,omitempty" tf:"computed"`
+		Ids            map[string]string `json:"ids,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		families, err := w.PolicyFamilies.ListAll(ctx, compute.ListPolicyFamiliesRequest{})
+		if err != nil {
+			return err
+		}
+		data.Ids = map[string]string{}
+		for _, family := range families {
+			data.PolicyFamilies = append(data.PolicyFamilies, policyFamily{
+				PolicyFamilyId: family.PolicyFamilyId,
+				Name:           family.Name,
+				Description:    family.Description,
+				Definition:     family.Definition,
+			})
+			data.Ids[family.Name] = family.PolicyFamilyId
+		}
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+package policies
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceClusterPolicyFamilies(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policy-families?",
+				Response: compute.ListPolicyFamiliesResponse{
+					PolicyFamilies: []compute.PolicyFamily{
+						{
+							PolicyFamilyId: "personal-vm",
+							Name:           "Personal Compute",
+							Description:    "Use with small-to-medium data",
+							Definition:     `{"node_type_id":{"type":"allowlist"}}`,
+						},
+						{
+							PolicyFamilyId: "job-cluster",
+							Name:           "Job Compute",
+							Definition:     `{"cluster_type":{"type":"fixed","value":"job"}}`,
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicyFamilies(),
+		ID:          ".",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids.%":                              "2",
+		"ids.Personal Compute":               "personal-vm",
+		"ids.Job Compute":                    "job-cluster",
+		"policy_families.#":                  2,
+		"policy_families.0.description":      "Use with small-to-medium data",
+		"policy_families.1.policy_family_id": "job-cluster",
+		"policy_families.1.definition":       `{"cluster_type":{"type":"fixed","value":"job"}}`,
+	})
+}
+
+func TestDataSourceClusterPolicyFamiliesError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policy-families?",
+				Status:   404,
+				Response: apierr.APIError{
+					Message: "searching_error",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicyFamilies(),
+		ID:          ".",
+	}.ExpectError(t, "searching_error")
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDataSourceClusterPolicy(t *testing.T) {
@@ -82,3 +83,78 @@ func TestDataSourceClusterPolicyNotFound(t *testing.T) {
 		HCL:         `name = "policy"`,
 	}.ExpectError(t, "Policy named 'policy' does not exist")
 }
+
+func TestDataSourceClusterPolicyById(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: compute.Policy{
+					PolicyId:   "abc",
+					Name:       "policy",
+					Definition: `{"abc":"123"}`,
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicy(),
+		ID:          ".",
+		HCL:         `policy_id = "abc"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":         "abc",
+		"name":       "policy",
+		"definition": `{"abc":"123"}`,
+	})
+}
+
+func TestDataSourceClusterPolicyNoNameOrId(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicy(),
+		ID:          ".",
+		HCL:         `exclude_attributes = ["instance_pool_id"]`,
+	}.ExpectError(t, "either name or policy_id has to be specified")
+}
+
+func TestDataSourceClusterPolicyRenderedDefinition(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/list?",
+				Response: compute.ListPoliciesResponse{
+					Policies: []compute.Policy{
+						{
+							PolicyId: "abc",
+							Name:     "golden",
+							Definition: `{
+								"spark_version": {"type": "fixed", "value": "14.3.x-scala2.12"},
+								"instance_pool_id": {"type": "fixed", "value": "0101-pool"},
+								"custom_tags.team": {"type": "fixed", "value": "data"},
+								"custom_tags.cost_center": {"type": "fixed", "value": "42"}
+							}`,
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicy(),
+		ID:          ".",
+		HCL: `
+		name = "golden"
+		exclude_attributes = ["instance_pool_id", "custom_tags"]`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                  "abc",
+		"rendered_definition": `{"spark_version":{"type":"fixed","value":"14.3.x-scala2.12"}}`,
+	})
+}
+
+func TestRenderPolicyDefinitionInvalidJSON(t *testing.T) {
+	_, err := renderPolicyDefinition(`{"a":`, nil)
+	assert.Error(t, err)
+}
@@ -13,13 +13,22 @@ func getPool(poolsAPI InstancePoolsAPI, name string) (*InstancePoolAndStats, err
 	if err != nil {
 		return nil, err
 	}
+	var found []InstancePoolAndStats
 	for _, pool := range poolList.InstancePools {
 		if pool.InstancePoolName == name {
-			return &pool, nil
+			found = append(found, pool)
 		}
 	}
-
-	return nil, fmt.Errorf("instance pool '%s' doesn't exist", name)
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("instance pool '%s' doesn't exist", name)
+	case 1:
+		return &found[0], nil
+	default:
+		// pool names aren't unique, so picking any of them could silently point clusters to a wrong pool
+		return nil, fmt.Errorf("there are %d instance pools with name '%s', rename them to have unique names",
+			len(found), name)
+	}
 }
 
 // DataSourceInstancePool returns information about instance pool specified by name
@@ -42,5 +51,6 @@ func DataSourceInstancePool() common.Resource {
 			err = common.StructToData(poolDetails{Name: name, Attributes: pool}, s, d)
 			return err
 		},
+		WorkspaceLevel: true,
 	}
 }
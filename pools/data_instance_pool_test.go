@@ -88,3 +88,33 @@ func TestDataSourceInstancePool_NotFound(t *testing.T) {
 		},
 	}.ExpectError(t, "instance pool 'Unknown' doesn't exist")
 }
+
+func TestDataSourceInstancePool_Ambiguous(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/list",
+				Response: InstancePoolList{
+					InstancePools: []InstancePoolAndStats{
+						{
+							InstancePoolID:   "abc",
+							InstancePoolName: "pool",
+						},
+						{
+							InstancePoolID:   "def",
+							InstancePoolName: "pool",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceInstancePool(),
+		ID:          ".",
+		State: map[string]any{
+			"name": "pool",
+		},
+	}.ExpectError(t, "there are 2 instance pools with name 'pool', rename them to have unique names")
+}
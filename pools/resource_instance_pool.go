@@ -30,6 +30,7 @@ type InstancePoolAzureAttributes struct {
 type InstancePoolGcpAttributes struct {
 	Availability  clusters.Availability `json:"gcp_availability,omitempty" tf:"force_new"`
 	LocalSsdCount int32                 `json:"local_ssd_count,omitempty"`
+	ZoneID        string                `json:"zone_id,omitempty" tf:"computed,force_new"`
 }
 
 // InstancePoolDiskType contains disk type information for each of the different cloud service providers
@@ -87,6 +88,8 @@ type InstancePool struct {
 	DiskSpec                           *InstancePoolDiskSpec           `json:"disk_spec,omitempty" tf:"force_new"`
 	PreloadedSparkVersions             []string                        `json:"preloaded_spark_versions,omitempty" tf:"force_new"`
 	PreloadedDockerImages              []clusters.DockerImage          `json:"preloaded_docker_images,omitempty" tf:"force_new,slice_set,alias:preloaded_docker_image"`
+	// Stats are only reported by the platform and change with the usage of the pool
+	Stats *InstancePoolStats `json:"stats,omitempty" tf:"computed"`
 }
 
 // InstancePoolStats contains the stats on a given pool
@@ -196,6 +199,9 @@ func ResourceInstancePool() common.Resource {
 				clusters.AzureAvailabilityOnDemand,
 			}, false)
 		}
+		if v, err := common.SchemaPath(s, "azure_attributes", "spot_bid_max_price"); err == nil {
+			v.ValidateFunc = clusters.ValidateSpotBidMaxPrice
+		}
 		if v, err := common.SchemaPath(s, "gcp_attributes", "gcp_availability"); err == nil {
 			v.Default = clusters.GcpAvailabilityOnDemand
 			v.ValidateFunc = validation.StringInSlice([]string{
@@ -259,16 +265,27 @@ func ResourceInstancePool() common.Resource {
 			if err != nil {
 				return err
 			}
+			ip.CustomTags = c.RemoveIgnoredTags(ip.CustomTags, d.Get("custom_tags").(map[string]any))
 			return common.StructToData(ip, s, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ip InstancePool
 			common.DataToStructPointer(d, s, &ip)
 			ip.InstancePoolID = d.Id()
-			return NewInstancePoolsAPI(ctx, c).Update(ip)
+			ip.Stats = nil
+			api := NewInstancePoolsAPI(ctx, c)
+			if len(c.IgnoreTags) > 0 {
+				remote, err := api.Read(d.Id())
+				if err != nil {
+					return err
+				}
+				ip.CustomTags = c.KeepIgnoredTags(ip.CustomTags, remote.CustomTags)
+			}
+			return api.Update(ip)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewInstancePoolsAPI(ctx, c).Delete(d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 
+	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
 )
@@ -55,6 +56,60 @@ func TestResourceInstancePoolCreate(t *testing.T) {
 	assert.Equal(t, "abc", d.Id())
 }
 
+func TestResourceInstancePoolCreate_GcpAttributes(t *testing.T) {
+	gcpAttributes := &InstancePoolGcpAttributes{
+		Availability:  clusters.GcpAvailabilityPreemptible,
+		LocalSsdCount: 2,
+		ZoneID:        "us-central1-a",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/create",
+				ExpectedRequest: InstancePool{
+					InstancePoolName:                   "GCP Pool",
+					NodeTypeID:                         "n1-standard-4",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					GcpAttributes:                      gcpAttributes,
+				},
+				Response: InstancePoolAndStats{
+					InstancePoolID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePoolAndStats{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "GCP Pool",
+					NodeTypeID:                         "n1-standard-4",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					GcpAttributes:                      gcpAttributes,
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "GCP Pool"
+		node_type_id = "n1-standard-4"
+		idle_instance_autotermination_minutes = 15
+		gcp_attributes {
+			gcp_availability = "PREEMPTIBLE_GCP"
+			local_ssd_count = 2
+			zone_id = "us-central1-a"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                               "abc",
+		"gcp_attributes.0.zone_id":         "us-central1-a",
+		"gcp_attributes.0.local_ssd_count": 2,
+	})
+}
+
 func TestResourceInstancePoolCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -82,6 +137,22 @@ func TestResourceInstancePoolCreate_Error(t *testing.T) {
 	assert.Equal(t, "", d.Id(), "Id should be empty for error creates")
 }
 
+func TestResourceInstancePoolCreate_InvalidSpotBidMaxPrice(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceInstancePool(),
+		Create:   true,
+		HCL: `
+		instance_pool_name = "Spot Pool"
+		node_type_id = "Standard_DS3_v2"
+		idle_instance_autotermination_minutes = 15
+		azure_attributes {
+			availability = "SPOT_AZURE"
+			spot_bid_max_price = -0.5
+		}`,
+	}.ExpectError(t, "invalid config supplied. [azure_attributes.#.spot_bid_max_price] "+
+		"azure_attributes.0.spot_bid_max_price must be -1 to use the on-demand price or a non-negative price, got: -0.5")
+}
+
 func TestResourceInstancePoolRead(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -113,6 +184,177 @@ func TestResourceInstancePoolRead(t *testing.T) {
 	assert.Equal(t, "i3.xlarge", d.Get("node_type_id"))
 }
 
+func TestResourceInstancePoolRead_IgnoreTags(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePoolAndStats{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Shared Pool",
+					MaxCapacity:                        1000,
+					NodeTypeID:                         "i3.xlarge",
+					IdleInstanceAutoTerminationMinutes: 15,
+					CustomTags: map[string]string{
+						"team":  "data",
+						"owner": "finance",
+					},
+					DefaultTags: map[string]string{
+						"Vendor":                   "Databricks",
+						"DatabricksInstancePoolId": "abc",
+					},
+				},
+			},
+		},
+		Resource:   ResourceInstancePool(),
+		IgnoreTags: []string{"owner"},
+		Read:       true,
+		New:        true,
+		ID:         "abc",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"team": "data"}, d.Get("custom_tags"))
+}
+
+func TestResourceInstancePoolUpdate_KeepsIgnoredTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				ReuseRequest: true,
+				Response: InstancePoolAndStats{
+					EnableElasticDisk:                  true,
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Shared Pool",
+					NodeTypeID:                         "i3.xlarge",
+					IdleInstanceAutoTerminationMinutes: 20,
+					CustomTags: map[string]string{
+						"team":  "data",
+						"owner": "finance",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/edit",
+				ExpectedRequest: InstancePool{
+					EnableElasticDisk:                  true,
+					InstancePoolID:                     "abc",
+					NodeTypeID:                         "i3.xlarge",
+					IdleInstanceAutoTerminationMinutes: 20,
+					InstancePoolName:                   "Shared Pool",
+					CustomTags: map[string]string{
+						"team":  "ml",
+						"owner": "finance",
+					},
+				},
+			},
+		},
+		Resource:   ResourceInstancePool(),
+		IgnoreTags: []string{"owner"},
+		InstanceState: map[string]string{
+			"node_type_id":                          "i3.xlarge",
+			"enable_elastic_disk":                   "true",
+			"instance_pool_name":                    "Shared Pool",
+			"idle_instance_autotermination_minutes": "20",
+			"custom_tags.%":                         "1",
+			"custom_tags.team":                      "data",
+		},
+		HCL: `
+		idle_instance_autotermination_minutes = 20
+		instance_pool_name = "Shared Pool"
+		node_type_id = "i3.xlarge"
+		custom_tags = {
+			team = "ml"
+		}`,
+		Update: true,
+		ID:     "abc",
+	}.ApplyNoError(t)
+}
+
+func TestResourceInstancePoolReadStats(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePoolAndStats{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Shared Pool",
+					NodeTypeID:                         "i3.xlarge",
+					IdleInstanceAutoTerminationMinutes: 15,
+					PreloadedSparkVersions:             []string{"14.3.x-scala2.12", "15.4.x-scala2.12"},
+					Stats: &InstancePoolStats{
+						UsedCount: 3,
+						IdleCount: 2,
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"preloaded_spark_versions.#": 2,
+		"stats.0.used_count":         3,
+		"stats.0.idle_count":         2,
+		"stats.0.pending_used_count": 0,
+	})
+}
+
+func TestResourceInstancePoolUpdateDoesNotSendStats(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/edit",
+				ExpectedRequest: InstancePool{
+					EnableElasticDisk:                  true,
+					InstancePoolID:                     "abc",
+					NodeTypeID:                         "i3.xlarge",
+					IdleInstanceAutoTerminationMinutes: 20,
+					InstancePoolName:                   "Shared Pool",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePoolAndStats{
+					EnableElasticDisk:                  true,
+					InstancePoolID:                     "abc",
+					NodeTypeID:                         "i3.xlarge",
+					IdleInstanceAutoTerminationMinutes: 20,
+					InstancePoolName:                   "Shared Pool",
+					Stats: &InstancePoolStats{
+						IdleCount: 1,
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		InstanceState: map[string]string{
+			"node_type_id":                          "i3.xlarge",
+			"enable_elastic_disk":                   "true",
+			"instance_pool_name":                    "Shared Pool",
+			"idle_instance_autotermination_minutes": "15",
+			"stats.#":                               "1",
+			"stats.0.idle_count":                    "4",
+		},
+		State: map[string]any{
+			"idle_instance_autotermination_minutes": 20,
+			"instance_pool_name":                    "Shared Pool",
+			"node_type_id":                          "i3.xlarge",
+		},
+		Update: true,
+		ID:     "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"stats.0.idle_count": 1,
+	})
+}
+
 func TestResourceInstancePoolRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -7,10 +7,12 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/databricks/databricks-sdk-go/client"
 	"github.com/databricks/databricks-sdk-go/config"
@@ -30,6 +32,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/pipelines"
 	"github.com/databricks/terraform-provider-databricks/policies"
 	"github.com/databricks/terraform-provider-databricks/pools"
+	"github.com/databricks/terraform-provider-databricks/replication"
 	"github.com/databricks/terraform-provider-databricks/repos"
 	"github.com/databricks/terraform-provider-databricks/scim"
 	"github.com/databricks/terraform-provider-databricks/secrets"
@@ -60,7 +63,10 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_cluster":                  clusters.DataSourceCluster().ToResource(),
 			"databricks_clusters":                 clusters.DataSourceClusters().ToResource(),
 			"databricks_cluster_policy":           policies.DataSourceClusterPolicy().ToResource(),
+			"databricks_cluster_policy_document":  policies.DataSourceClusterPolicyDocument().ToResource(),
+			"databricks_cluster_policy_families":  policies.DataSourceClusterPolicyFamilies().ToResource(),
 			"databricks_catalogs":                 catalog.DataSourceCatalogs().ToResource(),
+			"databricks_compute_tags":             clusters.DataSourceComputeTags().ToResource(),
 			"databricks_current_config":           mws.DataSourceCurrentConfiguration().ToResource(),
 			"databricks_current_metastore":        catalog.DataSourceCurrentMetastore().ToResource(),
 			"databricks_current_user":             scim.DataSourceCurrentUser().ToResource(),
@@ -80,13 +86,19 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_node_type":                clusters.DataSourceNodeType().ToResource(),
 			"databricks_notebook":                 workspace.DataSourceNotebook().ToResource(),
 			"databricks_notebook_paths":           workspace.DataSourceNotebookPaths().ToResource(),
+			"databricks_permissions":              permissions.DataSourcePermissions().ToResource(),
+			"databricks_pipeline":                 pipelines.DataSourcePipeline().ToResource(),
 			"databricks_pipelines":                pipelines.DataSourcePipelines().ToResource(),
+			"databricks_precondition":             settings.DataSourcePrecondition().ToResource(),
 			"databricks_schemas":                  catalog.DataSourceSchemas().ToResource(),
 			"databricks_service_principal":        scim.DataSourceServicePrincipal().ToResource(),
 			"databricks_service_principals":       scim.DataSourceServicePrincipals().ToResource(),
 			"databricks_share":                    catalog.DataSourceShare().ToResource(),
 			"databricks_shares":                   catalog.DataSourceShares().ToResource(),
 			"databricks_spark_version":            clusters.DataSourceSparkVersion().ToResource(),
+			"databricks_sql_alerts":               sql.DataSourceAlerts().ToResource(),
+			"databricks_sql_queries":              sql.DataSourceQueries().ToResource(),
+			"databricks_sql_query_history":        sql.DataSourceQueryHistory().ToResource(),
 			"databricks_sql_warehouse":            sql.DataSourceWarehouse().ToResource(),
 			"databricks_sql_warehouses":           sql.DataSourceWarehouses().ToResource(),
 			"databricks_storage_credential":       catalog.DataSourceStorageCredential().ToResource(),
@@ -99,21 +111,26 @@ func DatabricksProvider() *schema.Provider {
 		},
 		ResourcesMap: map[string]*schema.Resource{ // must be in alphabetical order
 			"databricks_access_control_rule_set":     permissions.ResourceAccessControlRuleSet().ToResource(),
+			"databricks_account_network_policy":      mws.ResourceAccountNetworkPolicy().ToResource(),
 			"databricks_artifact_allowlist":          catalog.ResourceArtifactAllowlist().ToResource(),
 			"databricks_aws_s3_mount":                storage.ResourceAWSS3Mount().ToResource(),
 			"databricks_azure_adls_gen1_mount":       storage.ResourceAzureAdlsGen1Mount().ToResource(),
 			"databricks_azure_adls_gen2_mount":       storage.ResourceAzureAdlsGen2Mount().ToResource(),
 			"databricks_azure_blob_mount":            storage.ResourceAzureBlobMount().ToResource(),
 			"databricks_catalog":                     catalog.ResourceCatalog().ToResource(),
+			"databricks_catalog_default_grants":      catalog.ResourceCatalogDefaultGrants().ToResource(),
 			"databricks_catalog_workspace_binding":   catalog.ResourceCatalogWorkspaceBinding().ToResource(),
 			"databricks_connection":                  catalog.ResourceConnection().ToResource(),
 			"databricks_cluster":                     clusters.ResourceCluster().ToResource(),
 			"databricks_cluster_policy":              policies.ResourceClusterPolicy().ToResource(),
 			"databricks_dbfs_file":                   storage.ResourceDbfsFile().ToResource(),
+			"databricks_default_storage":             catalog.ResourceDefaultStorage().ToResource(),
 			"databricks_directory":                   workspace.ResourceDirectory().ToResource(),
+			"databricks_disaster_recovery_pairing":   replication.ResourceDisasterRecoveryPairing().ToResource(),
 			"databricks_entitlements":                scim.ResourceEntitlements().ToResource(),
 			"databricks_external_location":           catalog.ResourceExternalLocation().ToResource(),
 			"databricks_file":                        storage.ResourceFile().ToResource(),
+			"databricks_function":                    catalog.ResourceFunction().ToResource(),
 			"databricks_git_credential":              repos.ResourceGitCredential().ToResource(),
 			"databricks_global_init_script":          workspace.ResourceGlobalInitScript().ToResource(),
 			"databricks_grant":                       catalog.ResourceGrant().ToResource(),
@@ -138,6 +155,7 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_mount":                       storage.ResourceMount().ToResource(),
 			"databricks_mws_customer_managed_keys":   mws.ResourceMwsCustomerManagedKeys().ToResource(),
 			"databricks_mws_credentials":             mws.ResourceMwsCredentials().ToResource(),
+			"databricks_mws_instance_profiles":       mws.ResourceMwsInstanceProfiles().ToResource(),
 			"databricks_mws_log_delivery":            mws.ResourceMwsLogDelivery().ToResource(),
 			"databricks_mws_networks":                mws.ResourceMwsNetworks().ToResource(),
 			"databricks_mws_permission_assignment":   mws.ResourceMwsPermissionAssignment().ToResource(),
@@ -146,23 +164,28 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_mws_vpc_endpoint":            mws.ResourceMwsVpcEndpoint().ToResource(),
 			"databricks_mws_workspaces":              mws.ResourceMwsWorkspaces().ToResource(),
 			"databricks_notebook":                    workspace.ResourceNotebook().ToResource(),
+			"databricks_notification_destination":    settings.ResourceNotificationDestination().ToResource(),
 			"databricks_obo_token":                   tokens.ResourceOboToken().ToResource(),
 			"databricks_permission_assignment":       access.ResourcePermissionAssignment().ToResource(),
 			"databricks_permissions":                 permissions.ResourcePermissions().ToResource(),
 			"databricks_pipeline":                    pipelines.ResourcePipeline().ToResource(),
 			"databricks_provider":                    catalog.ResourceProvider().ToResource(),
+			"databricks_query_snippet":               sql.ResourceQuerySnippet().ToResource(),
 			"databricks_recipient":                   sharing.ResourceRecipient().ToResource(),
 			"databricks_registered_model":            catalog.ResourceRegisteredModel().ToResource(),
 			"databricks_repo":                        repos.ResourceRepo().ToResource(),
+			"databricks_repos_settings":              repos.ResourceReposSettings().ToResource(),
 			"databricks_schema":                      catalog.ResourceSchema().ToResource(),
 			"databricks_secret":                      secrets.ResourceSecret().ToResource(),
 			"databricks_secret_scope":                secrets.ResourceSecretScope().ToResource(),
 			"databricks_secret_acl":                  secrets.ResourceSecretACL().ToResource(),
+			"databricks_secrets":                     secrets.ResourceSecrets().ToResource(),
 			"databricks_service_principal":           scim.ResourceServicePrincipal().ToResource(),
 			"databricks_service_principal_role":      aws.ResourceServicePrincipalRole().ToResource(),
 			"databricks_service_principal_secret":    tokens.ResourceServicePrincipalSecret().ToResource(),
 			"databricks_share":                       catalog.ResourceShare().ToResource(),
 			"databricks_sql_dashboard":               sql.ResourceSqlDashboard().ToResource(),
+			"databricks_sql_dashboard_migration":     sql.ResourceSqlDashboardMigration().ToResource(),
 			"databricks_sql_endpoint":                sql.ResourceSqlEndpoint().ToResource(),
 			"databricks_sql_global_config":           sql.ResourceSqlGlobalConfig().ToResource(),
 			"databricks_sql_permissions":             access.ResourceSqlPermissions().ToResource(),
@@ -178,16 +201,22 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_user":                        scim.ResourceUser().ToResource(),
 			"databricks_user_instance_profile":       aws.ResourceUserInstanceProfile().ToResource(),
 			"databricks_user_role":                   aws.ResourceUserRole().ToResource(),
+			"databricks_user_roles":                  aws.ResourceUserRoles().ToResource(),
 			"databricks_vector_search_endpoint":      vectorsearch.ResourceVectorSearchEndpoint().ToResource(),
 			"databricks_volume":                      catalog.ResourceVolume().ToResource(),
 			"databricks_workspace_conf":              workspace.ResourceWorkspaceConf().ToResource(),
 			"databricks_workspace_file":              workspace.ResourceWorkspaceFile().ToResource(),
+			"databricks_workspace_file_acl":          permissions.ResourceWorkspaceFileACL().ToResource(),
+			"databricks_workspace_network_option":    mws.ResourceWorkspaceNetworkOption().ToResource(),
 		},
 		Schema: providerSchema(),
 	}
 	for name, resource := range settings.AllSettingsResources() {
 		p.ResourcesMap[fmt.Sprintf("databricks_%s_setting", name)] = resource.ToResource()
 	}
+	for name, dataSource := range settings.AllSettingsDataSources() {
+		p.DataSourcesMap[fmt.Sprintf("databricks_%s_setting", name)] = dataSource.ToResource()
+	}
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 		if p.TerraformVersion != "" {
 			useragent.WithUserAgentExtra("terraform", p.TerraformVersion)
@@ -196,6 +225,13 @@ func DatabricksProvider() *schema.Provider {
 		return configureDatabricksClient(ctx, d)
 	}
 	common.AddContextToAllResources(p, "databricks")
+	common.AddNameAffixes(p, map[string]string{
+		"databricks_cluster":      "cluster_name",
+		"databricks_job":          "name",
+		"databricks_secret_scope": "name",
+		"databricks_sql_query":    "name",
+	})
+	common.AddReadOnlyChecks(p)
 	return p
 }
 
@@ -221,6 +257,44 @@ func providerSchema() map[string]*schema.Schema {
 	// TODO: check if still relevant
 	ps["rate_limit"].DefaultFunc = schema.EnvDefaultFunc("DATABRICKS_RATE_LIMIT", 15)
 	ps["debug_truncate_bytes"].DefaultFunc = schema.EnvDefaultFunc("DATABRICKS_DEBUG_TRUNCATE_BYTES", 96)
+	ps["azure_environment"].ValidateFunc = validation.StringInSlice(common.AzureEnvironments(), true)
+	ps["read_only"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_READ_ONLY", false),
+	}
+	ps["name_prefix"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_NAME_PREFIX", nil),
+	}
+	ps["name_suffix"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_NAME_SUFFIX", nil),
+	}
+	ps["max_deletes"] = &schema.Schema{
+		Type:             schema.TypeInt,
+		Optional:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("DATABRICKS_MAX_DELETES", 0),
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+	}
+	ps["ignore_tags"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	ps["lookup_cache_ttl"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_LOOKUP_CACHE_TTL", nil),
+		ValidateFunc: func(i any, k string) (_ []string, errors []error) {
+			if _, err := time.ParseDuration(i.(string)); err != nil {
+				errors = append(errors, fmt.Errorf("%s must be a duration, like 1h: %w", k, err))
+			}
+			return
+		},
+	}
 	return ps
 }
 
@@ -258,12 +332,40 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (any
 			cfg.AuthType = newer
 		}
 	}
+	// environment variables and ~/.databrickscfg have to be loaded, before checking if the host is known
+	err := cfg.EnsureResolved()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if cfg.Host == "" && cfg.AccountID != "" && cfg.AzureEnvironment != "" && cfg.AzureResourceID == "" {
+		// account-level provider on Azure, including Azure Government and Azure China, can derive
+		// the accounts host from the environment
+		cfg.Host = common.AzureAccountsHost(cfg.AzureEnvironment)
+		log.Printf("[INFO] Using %s as host for azure_environment = %s", cfg.Host, cfg.AzureEnvironment)
+	}
+	if d.Get("read_only").(bool) {
+		// reads that change something, like starting the cluster for databricks_mount, are blocked as well
+		cfg.HTTPTransport = common.ReadOnlyTransport(cfg.HTTPTransport)
+	}
 	client, err := client.New(cfg)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 	pc := &common.DatabricksClient{
 		DatabricksClient: client,
+		ReadOnly:         d.Get("read_only").(bool),
+		NamePrefix:       d.Get("name_prefix").(string),
+		NameSuffix:       d.Get("name_suffix").(string),
+		MaxDeletes:       d.Get("max_deletes").(int),
+	}
+	for _, v := range d.Get("ignore_tags").([]any) {
+		pc.IgnoreTags = append(pc.IgnoreTags, v.(string))
+	}
+	if v, ok := d.GetOk("lookup_cache_ttl"); ok {
+		pc.LookupCacheTTL, err = time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
 	}
 	pc.WithCommandExecutor(func(ctx context.Context, client *common.DatabricksClient) common.CommandExecutor {
 		return commands.NewCommandsAPI(ctx, client)
@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/sql/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -477,3 +479,141 @@ func configureProviderAndReturnClient(t *testing.T, tt providerFixture) (*common
 	}
 	return client, nil
 }
+
+func TestConfig_AzureEnvironmentAccountsHost(t *testing.T) {
+	providerFixture{
+		env: map[string]string{
+			"DATABRICKS_ACCOUNT_ID": "abc",
+			"ARM_ENVIRONMENT":       "USGOVERNMENT",
+			"DATABRICKS_TOKEN":      "x",
+		},
+		assertAzure: true,
+		assertAuth:  "pat",
+		assertHost:  "https://accounts.databricks.azure.us",
+	}.apply(t)
+}
+
+func TestConfig_AzureEnvironmentKeepsHostFromDatabricksCfg(t *testing.T) {
+	providerFixture{
+		env: map[string]string{
+			"HOME":                  "../common/testdata",
+			"DATABRICKS_ACCOUNT_ID": "abc",
+			"ARM_ENVIRONMENT":       "USGOVERNMENT",
+		},
+		assertAuth: "pat",
+		assertHost: "https://dbc-XXXXXXXX-YYYY.cloud.databricks.com",
+	}.apply(t)
+}
+
+func TestConfig_InvalidAzureEnvironment(t *testing.T) {
+	validate := DatabricksProvider().Schema["azure_environment"].ValidateFunc
+	_, errs := validate("GERMANY", "azure_environment")
+	assert.Len(t, errs, 1)
+	_, errs = validate("usgovernment", "azure_environment")
+	assert.Len(t, errs, 0)
+}
+
+func TestConfig_ReadOnly(t *testing.T) {
+	c := providerFixture{
+		env: map[string]string{
+			"DATABRICKS_HOST":      "x",
+			"DATABRICKS_TOKEN":     "x",
+			"DATABRICKS_READ_ONLY": "true",
+		},
+		assertAuth: "pat",
+		assertHost: "https://x",
+	}.apply(t)
+	assert.True(t, c.ReadOnly)
+}
+
+func TestConfig_NameAffixes(t *testing.T) {
+	c := providerFixture{
+		env: map[string]string{
+			"DATABRICKS_HOST":        "x",
+			"DATABRICKS_TOKEN":       "x",
+			"DATABRICKS_NAME_PREFIX": "dev-",
+			"DATABRICKS_NAME_SUFFIX": "-a",
+		},
+		assertAuth: "pat",
+		assertHost: "https://x",
+	}.apply(t)
+	assert.Equal(t, "dev-", c.NamePrefix)
+	assert.Equal(t, "-a", c.NameSuffix)
+}
+
+func TestConfig_MaxDeletes(t *testing.T) {
+	c := providerFixture{
+		env: map[string]string{
+			"DATABRICKS_HOST":        "x",
+			"DATABRICKS_TOKEN":       "x",
+			"DATABRICKS_MAX_DELETES": "5",
+		},
+		assertAuth: "pat",
+		assertHost: "https://x",
+	}.apply(t)
+	assert.Equal(t, 5, c.MaxDeletes)
+}
+
+func TestConfig_LookupCacheTTL(t *testing.T) {
+	c := providerFixture{
+		env: map[string]string{
+			"DATABRICKS_HOST":             "x",
+			"DATABRICKS_TOKEN":            "x",
+			"DATABRICKS_LOOKUP_CACHE_TTL": "1h",
+		},
+		assertAuth: "pat",
+		assertHost: "https://x",
+	}.apply(t)
+	assert.Equal(t, time.Hour, c.LookupCacheTTL)
+}
+
+func TestNameAffixes_Resources(t *testing.T) {
+	p := DatabricksProvider()
+	for _, k := range []string{"databricks_cluster", "databricks_job", "databricks_secret_scope", "databricks_sql_query"} {
+		assert.Contains(t, p.ResourcesMap[k].Schema, "effective_name", k)
+	}
+}
+
+func TestNameAffixes_SqlQuery(t *testing.T) {
+	query := api.Query{
+		ID:           "foo",
+		DataSourceID: "xyz",
+		Name:         "dev-Query name-a",
+		Query:        "SELECT 1",
+	}
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/preview/sql/queries",
+			ExpectedRequest: api.Query{
+				DataSourceID: "xyz",
+				Name:         "dev-Query name-a",
+				Query:        "SELECT 1",
+			},
+			Response: query,
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/preview/sql/queries/foo",
+			Response:     query,
+			ReuseRequest: true,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.NamePrefix = "dev-"
+		client.NameSuffix = "-a"
+		r := DatabricksProvider().ResourcesMap["databricks_sql_query"]
+		d := r.TestResourceData()
+		d.Set("data_source_id", "xyz")
+		d.Set("name", "Query name")
+		d.Set("query", "SELECT 1")
+
+		require.False(t, r.CreateContext(ctx, d, client).HasError())
+		assert.Equal(t, "foo", d.Id())
+		assert.Equal(t, "Query name", d.Get("name"))
+		assert.Equal(t, "dev-Query name-a", d.Get("effective_name"))
+
+		require.False(t, r.ReadContext(ctx, d, client).HasError())
+		assert.Equal(t, "Query name", d.Get("name"))
+		assert.Equal(t, "dev-Query name-a", d.Get("effective_name"))
+	})
+}
@@ -67,6 +67,8 @@ type HTTPFixture struct {
 	ExpectedRequest any
 	ReuseRequest    bool
 	MatchAny        bool
+	// Headers of the response, like `X-Databricks-Org-Id`
+	ResponseHeaders map[string]string
 }
 
 // ResourceFixture is a helper to unit test terraform resources. It does this by
@@ -120,6 +122,11 @@ type ResourceFixture struct {
 	Gcp         bool
 	AccountID   string
 	Token       string
+	// keys of custom tags from the ignore_tags provider option
+	IgnoreTags []string
+	// name_prefix and name_suffix provider options
+	NamePrefix string
+	NameSuffix string
 	// new resource
 	New bool
 }
@@ -279,6 +286,9 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if f.AccountID != "" {
 		config.AccountID = f.AccountID
 	}
+	client.IgnoreTags = f.IgnoreTags
+	client.NamePrefix = f.NamePrefix
+	client.NameSuffix = f.NameSuffix
 	f.setDatabricksEnvironmentForTest(client, server.URL)
 	if len(f.HCL) > 0 {
 		var out any
@@ -525,6 +535,9 @@ func HttpFixtureClientWithToken(t *testing.T, fixtures []HTTPFixture, token stri
 		found := false
 		for i, fixture := range fixtures {
 			if (req.Method == fixture.Method && req.RequestURI == fixture.Resource) || fixture.MatchAny {
+				for k, v := range fixture.ResponseHeaders {
+					rw.Header().Set(k, v)
+				}
 				if fixture.Status == 0 {
 					rw.WriteHeader(200)
 				} else {
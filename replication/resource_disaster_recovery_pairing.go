@@ -0,0 +1,506 @@
+package replication
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DisasterRecoveryPairing mirrors selected objects of the workspace configured in the provider
+// to the secondary workspace and keeps the mapping between their IDs
+type DisasterRecoveryPairing struct {
+	SecondaryHost     string   `json:"secondary_host" tf:"force_new"`
+	JobIDs            []string `json:"job_ids,omitempty" tf:"slice_set"`
+	QueryIDs          []string `json:"query_ids,omitempty" tf:"slice_set"`
+	QueryDataSourceID string   `json:"query_data_source_id,omitempty"`
+	// Schedules, triggers and continuous runs of copied jobs are paused, so that they don't run twice
+	PauseSchedules  bool `json:"pause_schedules,omitempty" tf:"default:true"`
+	CopyPermissions bool `json:"copy_permissions,omitempty"`
+	// IDs of the objects in the primary workspace mapped to the IDs of their copies
+	JobMapping   map[string]string `json:"job_mapping,omitempty" tf:"computed"`
+	QueryMapping map[string]string `json:"query_mapping,omitempty" tf:"computed"`
+	// Digests of the primary objects at the time of the last replication
+	Digests map[string]string `json:"digests,omitempty" tf:"computed"`
+}
+
+// secondaryWorkspaceClient is replaced in unit tests
+var secondaryWorkspaceClient = func(ctx context.Context, c *common.DatabricksClient,
+	host string) (*databricks.WorkspaceClient, error) {
+	secondary, err := c.ClientForHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	return secondary.WorkspaceClient()
+}
+
+type pairing struct {
+	DisasterRecoveryPairing
+	primary   *databricks.WorkspaceClient
+	secondary *databricks.WorkspaceClient
+}
+
+func newPairing(ctx context.Context, c *common.DatabricksClient, drp DisasterRecoveryPairing) (*pairing, error) {
+	primary, err := c.WorkspaceClient()
+	if err != nil {
+		return nil, err
+	}
+	secondary, err := secondaryWorkspaceClient(ctx, c, drp.SecondaryHost)
+	if err != nil {
+		return nil, fmt.Errorf("secondary workspace: %w", err)
+	}
+	if drp.JobMapping == nil {
+		drp.JobMapping = map[string]string{}
+	}
+	if drp.QueryMapping == nil {
+		drp.QueryMapping = map[string]string{}
+	}
+	if drp.Digests == nil {
+		drp.Digests = map[string]string{}
+	}
+	return &pairing{drp, primary, secondary}, nil
+}
+
+func digest(values ...any) (string, error) {
+	h := sha256.New()
+	enc := json.NewEncoder(h)
+	for _, v := range values {
+		if err := enc.Encode(v); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type primaryJob struct {
+	settings jobs.JobSettings
+	acl      []jobs.JobAccessControlRequest
+	digest   string
+}
+
+func (p *pairing) primaryJob(ctx context.Context, id string) (*primaryJob, error) {
+	jobID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("job_ids: %w", err)
+	}
+	job, err := p.primary.Jobs.GetByJobId(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	pj := &primaryJob{}
+	if job.Settings != nil {
+		pj.settings = *job.Settings
+	}
+	if p.CopyPermissions {
+		permissions, err := p.primary.Jobs.GetPermissionsByJobId(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		pj.acl = jobAccessControl(permissions)
+	}
+	if p.PauseSchedules {
+		pauseJob(&pj.settings)
+	}
+	pj.digest, err = digest(pj.settings, pj.acl)
+	return pj, err
+}
+
+func jobAccessControl(permissions *jobs.JobPermissions) (acl []jobs.JobAccessControlRequest) {
+	for _, ac := range permissions.AccessControlList {
+		for _, permission := range ac.AllPermissions {
+			if permission.Inherited {
+				continue
+			}
+			acl = append(acl, jobs.JobAccessControlRequest{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+				PermissionLevel:      permission.PermissionLevel,
+			})
+		}
+	}
+	return
+}
+
+func pauseJob(settings *jobs.JobSettings) {
+	if settings.Schedule != nil {
+		schedule := *settings.Schedule
+		schedule.PauseStatus = jobs.PauseStatusPaused
+		settings.Schedule = &schedule
+	}
+	if settings.Trigger != nil {
+		trigger := *settings.Trigger
+		trigger.PauseStatus = jobs.PauseStatusPaused
+		settings.Trigger = &trigger
+	}
+	if settings.Continuous != nil {
+		continuous := *settings.Continuous
+		continuous.PauseStatus = jobs.PauseStatusPaused
+		settings.Continuous = &continuous
+	}
+}
+
+func (p *pairing) replicateJob(ctx context.Context, id string) error {
+	pj, err := p.primaryJob(ctx, id)
+	if err != nil {
+		return err
+	}
+	secondaryID, ok := p.JobMapping[id]
+	if ok && p.Digests["job/"+id] == pj.digest {
+		return nil
+	}
+	if ok {
+		jobID, err := strconv.ParseInt(secondaryID, 10, 64)
+		if err != nil {
+			return err
+		}
+		err = p.secondary.Jobs.Reset(ctx, jobs.ResetJob{
+			JobId:       jobID,
+			NewSettings: pj.settings,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		// job settings and create request share the same fields
+		settingsJSON, err := json.Marshal(pj.settings)
+		if err != nil {
+			return err
+		}
+		var create jobs.CreateJob
+		err = json.Unmarshal(settingsJSON, &create)
+		if err != nil {
+			return err
+		}
+		created, err := p.secondary.Jobs.Create(ctx, create)
+		if err != nil {
+			return err
+		}
+		secondaryID = strconv.FormatInt(created.JobId, 10)
+		p.JobMapping[id] = secondaryID
+	}
+	if p.CopyPermissions {
+		_, err = p.secondary.Jobs.SetPermissions(ctx, jobs.JobPermissionsRequest{
+			JobId:             secondaryID,
+			AccessControlList: pj.acl,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("[INFO] Replicated job %s to %s as %s", id, p.SecondaryHost, secondaryID)
+	p.Digests["job/"+id] = pj.digest
+	return nil
+}
+
+type primaryQuery struct {
+	content sql.QueryPostContent
+	acl     []sql.AccessControl
+	digest  string
+}
+
+func (p *pairing) primaryQuery(ctx context.Context, id string) (*primaryQuery, error) {
+	query, err := p.primary.Queries.GetByQueryId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	pq := &primaryQuery{
+		content: sql.QueryPostContent{
+			DataSourceId: p.QueryDataSourceID,
+			Name:         query.Name,
+			Description:  query.Description,
+			Query:        query.Query,
+			RunAsRole:    query.RunAsRole,
+		},
+	}
+	if query.Options != nil {
+		pq.content.Options = query.Options
+	}
+	if p.CopyPermissions {
+		permissions, err := p.primary.DbsqlPermissions.GetByObjectTypeAndObjectId(ctx, sql.ObjectTypePluralQueries, id)
+		if err != nil {
+			return nil, err
+		}
+		pq.acl = permissions.AccessControlList
+	}
+	pq.digest, err = digest(pq.content, pq.acl)
+	return pq, err
+}
+
+func (p *pairing) replicateQuery(ctx context.Context, id string) error {
+	if p.QueryDataSourceID == "" {
+		return fmt.Errorf("query_data_source_id is required to replicate queries")
+	}
+	pq, err := p.primaryQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+	secondaryID, ok := p.QueryMapping[id]
+	if ok && p.Digests["query/"+id] == pq.digest {
+		return nil
+	}
+	if ok {
+		_, err = p.secondary.Queries.Update(ctx, sql.QueryEditContent{
+			QueryId:      secondaryID,
+			DataSourceId: pq.content.DataSourceId,
+			Name:         pq.content.Name,
+			Description:  pq.content.Description,
+			Query:        pq.content.Query,
+			Options:      pq.content.Options,
+			RunAsRole:    pq.content.RunAsRole,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		created, err := p.secondary.Queries.Create(ctx, pq.content)
+		if err != nil {
+			return err
+		}
+		secondaryID = created.Id
+		p.QueryMapping[id] = secondaryID
+	}
+	if p.CopyPermissions {
+		_, err = p.secondary.DbsqlPermissions.Set(ctx, sql.SetRequest{
+			ObjectType:        sql.ObjectTypePluralQueries,
+			ObjectId:          secondaryID,
+			AccessControlList: pq.acl,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("[INFO] Replicated query %s to %s as %s", id, p.SecondaryHost, secondaryID)
+	p.Digests["query/"+id] = pq.digest
+	return nil
+}
+
+func (p *pairing) deleteJob(ctx context.Context, id string) error {
+	jobID, err := strconv.ParseInt(p.JobMapping[id], 10, 64)
+	if err != nil {
+		return err
+	}
+	err = p.secondary.Jobs.DeleteByJobId(ctx, jobID)
+	if err != nil && !apierr.IsMissing(err) {
+		return err
+	}
+	delete(p.JobMapping, id)
+	delete(p.Digests, "job/"+id)
+	return nil
+}
+
+func (p *pairing) deleteQuery(ctx context.Context, id string) error {
+	err := p.secondary.Queries.DeleteByQueryId(ctx, p.QueryMapping[id])
+	if err != nil && !apierr.IsMissing(err) {
+		return err
+	}
+	delete(p.QueryMapping, id)
+	delete(p.Digests, "query/"+id)
+	return nil
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// replicate removes copies of objects, that are no longer selected, and copies new or changed ones
+func (p *pairing) replicate(ctx context.Context) error {
+	for id := range p.JobMapping {
+		if contains(p.JobIDs, id) {
+			continue
+		}
+		if err := p.deleteJob(ctx, id); err != nil {
+			return err
+		}
+	}
+	for id := range p.QueryMapping {
+		if contains(p.QueryIDs, id) {
+			continue
+		}
+		if err := p.deleteQuery(ctx, id); err != nil {
+			return err
+		}
+	}
+	for _, id := range p.JobIDs {
+		if err := p.replicateJob(ctx, id); err != nil {
+			return fmt.Errorf("job %s: %w", id, err)
+		}
+	}
+	for _, id := range p.QueryIDs {
+		if err := p.replicateQuery(ctx, id); err != nil {
+			return fmt.Errorf("query %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// isJobInSync returns false, if the primary job has changed since the last replication
+// or if its copy was removed from the secondary workspace
+func (p *pairing) isJobInSync(ctx context.Context, id string) (bool, error) {
+	secondaryID, ok := p.JobMapping[id]
+	if !ok {
+		return false, nil
+	}
+	jobID, err := strconv.ParseInt(secondaryID, 10, 64)
+	if err != nil {
+		return false, err
+	}
+	_, err = p.secondary.Jobs.GetByJobId(ctx, jobID)
+	if apierr.IsMissing(err) {
+		delete(p.JobMapping, id)
+		delete(p.Digests, "job/"+id)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	pj, err := p.primaryJob(ctx, id)
+	if apierr.IsMissing(err) {
+		log.Printf("[WARN] Job %s doesn't exist in the primary workspace", id)
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return p.Digests["job/"+id] == pj.digest, nil
+}
+
+// isQueryInSync returns false, if the primary query has changed since the last replication
+// or if its copy was removed from the secondary workspace
+func (p *pairing) isQueryInSync(ctx context.Context, id string) (bool, error) {
+	secondaryID, ok := p.QueryMapping[id]
+	if !ok {
+		return false, nil
+	}
+	_, err := p.secondary.Queries.GetByQueryId(ctx, secondaryID)
+	if apierr.IsMissing(err) {
+		delete(p.QueryMapping, id)
+		delete(p.Digests, "query/"+id)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	pq, err := p.primaryQuery(ctx, id)
+	if apierr.IsMissing(err) {
+		log.Printf("[WARN] Query %s doesn't exist in the primary workspace", id)
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return p.Digests["query/"+id] == pq.digest, nil
+}
+
+// refresh leaves out objects, that aren't in sync, from `job_ids` and `query_ids`,
+// so that the next plan shows them as added and the apply replicates them again
+func (p *pairing) refresh(ctx context.Context) error {
+	jobIDs := []string{}
+	for _, id := range p.JobIDs {
+		inSync, err := p.isJobInSync(ctx, id)
+		if err != nil {
+			return fmt.Errorf("job %s: %w", id, err)
+		}
+		if inSync {
+			jobIDs = append(jobIDs, id)
+		}
+	}
+	queryIDs := []string{}
+	for _, id := range p.QueryIDs {
+		inSync, err := p.isQueryInSync(ctx, id)
+		if err != nil {
+			return fmt.Errorf("query %s: %w", id, err)
+		}
+		if inSync {
+			queryIDs = append(queryIDs, id)
+		}
+	}
+	p.JobIDs = jobIDs
+	p.QueryIDs = queryIDs
+	return nil
+}
+
+func ResourceDisasterRecoveryPairing() common.Resource {
+	s := common.StructToSchema(DisasterRecoveryPairing{}, common.NoCustomize)
+	// StructToData skips empty values, so IDs and mappings are set explicitly to allow clearing them
+	toData := func(drp DisasterRecoveryPairing, d *schema.ResourceData) error {
+		err := common.StructToData(drp, s, d)
+		if err != nil {
+			return err
+		}
+		for k, v := range map[string]any{
+			"job_ids":       drp.JobIDs,
+			"query_ids":     drp.QueryIDs,
+			"job_mapping":   drp.JobMapping,
+			"query_mapping": drp.QueryMapping,
+			"digests":       drp.Digests,
+		} {
+			if err = d.Set(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	replicate := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var drp DisasterRecoveryPairing
+		common.DataToStructPointer(d, s, &drp)
+		p, err := newPairing(ctx, c, drp)
+		if err != nil {
+			return err
+		}
+		err = p.replicate(ctx)
+		// mapping of already replicated objects has to be saved even if some of them failed
+		if stateErr := toData(p.DisasterRecoveryPairing, d); stateErr != nil {
+			return stateErr
+		}
+		return err
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			d.SetId(d.Get("secondary_host").(string))
+			return replicate(ctx, d, c)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var drp DisasterRecoveryPairing
+			common.DataToStructPointer(d, s, &drp)
+			p, err := newPairing(ctx, c, drp)
+			if err != nil {
+				return err
+			}
+			err = p.refresh(ctx)
+			if err != nil {
+				return err
+			}
+			return toData(p.DisasterRecoveryPairing, d)
+		},
+		Update: replicate,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var drp DisasterRecoveryPairing
+			common.DataToStructPointer(d, s, &drp)
+			p, err := newPairing(ctx, c, drp)
+			if err != nil {
+				return err
+			}
+			p.JobIDs = nil
+			p.QueryIDs = nil
+			return p.replicate(ctx)
+		},
+		WorkspaceLevel: true,
+	}
+}
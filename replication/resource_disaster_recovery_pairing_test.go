@@ -0,0 +1,268 @@
+package replication
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func withSecondaryWorkspace(t *testing.T, setup func(*mocks.MockWorkspaceClient)) {
+	secondary := mocks.NewMockWorkspaceClient(t)
+	setup(secondary)
+	original := secondaryWorkspaceClient
+	secondaryWorkspaceClient = func(ctx context.Context, c *common.DatabricksClient,
+		host string) (*databricks.WorkspaceClient, error) {
+		assert.Equal(t, "https://secondary.cloud.databricks.com", host)
+		return secondary.WorkspaceClient, nil
+	}
+	t.Cleanup(func() {
+		secondaryWorkspaceClient = original
+	})
+}
+
+var nightlyJob = &jobs.Job{
+	JobId: 123,
+	Settings: &jobs.JobSettings{
+		Name: "Nightly",
+		Schedule: &jobs.CronSchedule{
+			QuartzCronExpression: "0 0 1 * * ?",
+			TimezoneId:           "UTC",
+		},
+	},
+}
+
+var revenueQuery = &sql.Query{
+	Id:           "abc",
+	Name:         "Revenue",
+	Query:        "SELECT 1",
+	DataSourceId: "primary-ds",
+}
+
+var errMissing = &apierr.APIError{
+	ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+	StatusCode: 404,
+	Message:    "not found",
+}
+
+func TestDisasterRecoveryPairingCreate(t *testing.T) {
+	withSecondaryWorkspace(t, func(w *mocks.MockWorkspaceClient) {
+		e := w.GetMockJobsAPI().EXPECT()
+		e.Create(mock.Anything, jobs.CreateJob{
+			Name:            "Nightly",
+			ForceSendFields: []string{"Name"},
+			Schedule: &jobs.CronSchedule{
+				QuartzCronExpression: "0 0 1 * * ?",
+				TimezoneId:           "UTC",
+				PauseStatus:          jobs.PauseStatusPaused,
+			},
+		}).Return(&jobs.CreateResponse{JobId: 456}, nil)
+		e.SetPermissions(mock.Anything, jobs.JobPermissionsRequest{
+			JobId: "456",
+			AccessControlList: []jobs.JobAccessControlRequest{
+				{
+					GroupName:       "oncall",
+					PermissionLevel: jobs.JobPermissionLevelCanManageRun,
+				},
+			},
+		}).Return(nil, nil)
+		e.GetByJobId(mock.Anything, int64(456)).Return(&jobs.Job{JobId: 456}, nil)
+
+		q := w.GetMockQueriesAPI().EXPECT()
+		q.Create(mock.Anything, sql.QueryPostContent{
+			DataSourceId: "secondary-ds",
+			Name:         "Revenue",
+			Query:        "SELECT 1",
+		}).Return(&sql.Query{Id: "def"}, nil)
+		q.GetByQueryId(mock.Anything, "def").Return(&sql.Query{Id: "def"}, nil)
+		w.GetMockDbsqlPermissionsAPI().EXPECT().Set(mock.Anything, sql.SetRequest{
+			ObjectType: sql.ObjectTypePluralQueries,
+			ObjectId:   "def",
+			AccessControlList: []sql.AccessControl{
+				{
+					UserName:        "analyst@example.com",
+					PermissionLevel: sql.PermissionLevelCanRun,
+				},
+			},
+		}).Return(nil, nil)
+	})
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockJobsAPI().EXPECT()
+			e.GetByJobId(mock.Anything, int64(123)).Return(nightlyJob, nil)
+			e.GetPermissionsByJobId(mock.Anything, "123").Return(&jobs.JobPermissions{
+				AccessControlList: []jobs.JobAccessControlResponse{
+					{
+						GroupName: "oncall",
+						AllPermissions: []jobs.JobPermission{
+							{
+								PermissionLevel: jobs.JobPermissionLevelCanManageRun,
+							},
+						},
+					},
+					{
+						GroupName: "admins",
+						AllPermissions: []jobs.JobPermission{
+							{
+								PermissionLevel: jobs.JobPermissionLevelCanManage,
+								Inherited:       true,
+							},
+						},
+					},
+				},
+			}, nil)
+			w.GetMockQueriesAPI().EXPECT().GetByQueryId(mock.Anything, "abc").Return(revenueQuery, nil)
+			w.GetMockDbsqlPermissionsAPI().EXPECT().
+				GetByObjectTypeAndObjectId(mock.Anything, sql.ObjectTypePluralQueries, "abc").
+				Return(&sql.GetResponse{
+					AccessControlList: []sql.AccessControl{
+						{
+							UserName:        "analyst@example.com",
+							PermissionLevel: sql.PermissionLevelCanRun,
+						},
+					},
+				}, nil)
+		},
+		Resource: ResourceDisasterRecoveryPairing(),
+		Create:   true,
+		HCL: `
+		secondary_host = "https://secondary.cloud.databricks.com"
+		job_ids = ["123"]
+		query_ids = ["abc"]
+		query_data_source_id = "secondary-ds"
+		copy_permissions = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "https://secondary.cloud.databricks.com",
+		"job_ids.#":         1,
+		"query_ids.#":       1,
+		"job_mapping.123":   "456",
+		"query_mapping.abc": "def",
+	})
+}
+
+func TestDisasterRecoveryPairingCreateQueryWithoutDataSource(t *testing.T) {
+	withSecondaryWorkspace(t, func(w *mocks.MockWorkspaceClient) {})
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {},
+		Resource:                ResourceDisasterRecoveryPairing(),
+		Create:                  true,
+		HCL: `
+		secondary_host = "https://secondary.cloud.databricks.com"
+		query_ids = ["abc"]
+		`,
+	}.ExpectError(t, "query abc: query_data_source_id is required to replicate queries")
+}
+
+func TestDisasterRecoveryPairingReadChangedAndMissing(t *testing.T) {
+	withSecondaryWorkspace(t, func(w *mocks.MockWorkspaceClient) {
+		e := w.GetMockJobsAPI().EXPECT()
+		e.GetByJobId(mock.Anything, int64(456)).Return(&jobs.Job{JobId: 456}, nil)
+		e.GetByJobId(mock.Anything, int64(789)).Return(nil, errMissing)
+	})
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockJobsAPI().EXPECT().GetByJobId(mock.Anything, int64(123)).Return(nightlyJob, nil)
+		},
+		Resource: ResourceDisasterRecoveryPairing(),
+		Read:     true,
+		ID:       "https://secondary.cloud.databricks.com",
+		State: map[string]any{
+			"secondary_host":  "https://secondary.cloud.databricks.com",
+			"job_ids":         []any{"123", "124"},
+			"pause_schedules": true,
+			"job_mapping": map[string]any{
+				"123": "456",
+				"124": "789",
+			},
+			"digests": map[string]any{
+				"job/123": "outdated",
+				"job/124": "outdated",
+			},
+		},
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, d.Get("job_ids.#"))
+	assert.Equal(t, map[string]any{"123": "456"}, d.Get("job_mapping"))
+	assert.Equal(t, map[string]any{"job/123": "outdated"}, d.Get("digests"))
+}
+
+func TestDisasterRecoveryPairingUpdate(t *testing.T) {
+	withSecondaryWorkspace(t, func(w *mocks.MockWorkspaceClient) {
+		e := w.GetMockJobsAPI().EXPECT()
+		e.DeleteByJobId(mock.Anything, int64(789)).Return(nil)
+		e.Reset(mock.Anything, jobs.ResetJob{
+			JobId: 456,
+			NewSettings: jobs.JobSettings{
+				Name: "Nightly",
+				Schedule: &jobs.CronSchedule{
+					QuartzCronExpression: "0 0 1 * * ?",
+					TimezoneId:           "UTC",
+				},
+			},
+		}).Return(nil)
+		e.GetByJobId(mock.Anything, int64(456)).Return(&jobs.Job{JobId: 456}, nil)
+	})
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockJobsAPI().EXPECT().GetByJobId(mock.Anything, int64(123)).Return(nightlyJob, nil)
+		},
+		Resource: ResourceDisasterRecoveryPairing(),
+		Update:   true,
+		ID:       "https://secondary.cloud.databricks.com",
+		InstanceState: map[string]string{
+			"secondary_host": "https://secondary.cloud.databricks.com",
+			"job_ids.#":      "1",
+			fmt.Sprintf("job_ids.%d", schema.HashSchema(&schema.Schema{Type: schema.TypeString})("124")): "124",
+			"pause_schedules": "true",
+			"job_mapping.%":   "2",
+			"job_mapping.123": "456",
+			"job_mapping.124": "789",
+			"digests.%":       "2",
+			"digests.job/123": "outdated",
+			"digests.job/124": "outdated",
+		},
+		HCL: `
+		secondary_host = "https://secondary.cloud.databricks.com"
+		job_ids = ["123"]
+		pause_schedules = false
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"job_mapping.%":   "1",
+		"job_mapping.123": "456",
+	})
+}
+
+func TestDisasterRecoveryPairingDelete(t *testing.T) {
+	withSecondaryWorkspace(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockJobsAPI().EXPECT().DeleteByJobId(mock.Anything, int64(456)).Return(nil)
+		w.GetMockQueriesAPI().EXPECT().DeleteByQueryId(mock.Anything, "def").Return(errMissing)
+	})
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {},
+		Resource:                ResourceDisasterRecoveryPairing(),
+		Delete:                  true,
+		ID:                      "https://secondary.cloud.databricks.com",
+		State: map[string]any{
+			"secondary_host": "https://secondary.cloud.databricks.com",
+			"job_ids":        []any{"123"},
+			"query_ids":      []any{"abc"},
+			"job_mapping": map[string]any{
+				"123": "456",
+			},
+			"query_mapping": map[string]any{
+				"abc": "def",
+			},
+		},
+	}.ApplyNoError(t)
+}
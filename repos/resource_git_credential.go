@@ -3,6 +3,7 @@ package repos
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 
@@ -11,6 +12,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// azureDevOpsAadProvider authenticates to Azure DevOps Services with Microsoft Entra ID (formerly Azure AD)
+// token of the user, so it doesn't require a personal access token
+const azureDevOpsAadProvider = "azureDevOpsServicesAad"
+
+// withoutTokenForAad removes personal access token, that may come from environment variables,
+// as it can't be used together with Microsoft Entra ID authentication
+func withoutTokenForAad(provider string, token *string) {
+	if strings.EqualFold(provider, azureDevOpsAadProvider) && *token != "" {
+		log.Printf("[WARN] personal_access_token is ignored for %s git provider", azureDevOpsAadProvider)
+		*token = ""
+	}
+}
+
 func ResourceGitCredential() common.Resource {
 	s := common.StructToSchema(workspace.CreateCredentials{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
 		s["force"] = &schema.Schema{
@@ -36,6 +50,7 @@ func ResourceGitCredential() common.Resource {
 
 			var req workspace.CreateCredentials
 			common.DataToStructPointer(d, s, &req)
+			withoutTokenForAad(req.GitProvider, &req.PersonalAccessToken)
 			resp, err := w.GitCredentials.Create(ctx, req)
 
 			if err != nil {
@@ -51,6 +66,7 @@ func ResourceGitCredential() common.Resource {
 				}
 				var req workspace.UpdateCredentials
 				common.DataToStructPointer(d, s, &req)
+				withoutTokenForAad(req.GitProvider, &req.PersonalAccessToken)
 				req.CredentialId = creds[0].CredentialId
 
 				err = w.GitCredentials.Update(ctx, req)
@@ -83,6 +99,7 @@ func ResourceGitCredential() common.Resource {
 			var req workspace.UpdateCredentials
 
 			common.DataToStructPointer(d, s, &req)
+			withoutTokenForAad(req.GitProvider, &req.PersonalAccessToken)
 			cred_id, err := strconv.ParseInt(d.Id(), 10, 64)
 			if err != nil {
 				return err
@@ -105,5 +122,6 @@ func ResourceGitCredential() common.Resource {
 			}
 			return w.GitCredentials.DeleteByCredentialId(ctx, cred_id)
 		},
+		WorkspaceLevel: true,
 	}
 }
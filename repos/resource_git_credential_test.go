@@ -191,6 +191,34 @@ func TestResourceGitCredentialCreate(t *testing.T) {
 	}.ApplyAndExpectData(t, map[string]any{"id": fmt.Sprintf("%d", resp.CredentialId), "git_provider": provider, "git_username": user})
 }
 
+func TestResourceGitCredentialCreate_AzureDevOpsAad(t *testing.T) {
+	t.Setenv("AZDO_PERSONAL_ACCESS_TOKEN", "from-env")
+	resp := workspace.CreateCredentialsResponse{
+		CredentialId: 121232342,
+		GitProvider:  "azureDevOpsServicesAad",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/git-credentials",
+				ExpectedRequest: workspace.CreateCredentials{
+					GitProvider: "azureDevOpsServicesAad",
+				},
+				Response: resp,
+			},
+			{
+				Method:   "GET",
+				Resource: fmt.Sprintf("/api/2.0/git-credentials/%d?", resp.CredentialId),
+				Response: resp,
+			},
+		},
+		Resource: ResourceGitCredential(),
+		HCL:      `git_provider = "azureDevOpsServicesAad"`,
+		Create:   true,
+	}.ApplyAndExpectData(t, map[string]any{"id": fmt.Sprintf("%d", resp.CredentialId), "git_provider": "azureDevOpsServicesAad"})
+}
+
 func TestResourceGitCredentialCreate_Error(t *testing.T) {
 	provider := "gitHub"
 	user := "test"
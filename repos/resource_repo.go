@@ -135,19 +135,46 @@ var (
 		"bitbucket.org": "bitbucketCloud",
 	}
 	awsCodeCommitRegex = regexp.MustCompile(`^git-codecommit\.[^.]+\.amazonaws\.com$`)
+	// legacy Azure DevOps URLs, like https://org.visualstudio.com/project/_git/repo
+	azureDevOpsLegacyRegex = regexp.MustCompile(`^[^.]+\.visualstudio\.com$`)
+	// self-hosted Git servers commonly have the product name as one of the host name labels,
+	// like https://gitlab.example.com/group/subgroup/repo.git
+	selfHostedGitProviders = map[string]string{
+		"github":    "gitHubEnterprise",
+		"gitlab":    "gitLabEnterpriseEdition",
+		"bitbucket": "bitbucketServer",
+	}
 )
 
+// GetGitProviderFromUrl infers Git provider from the host name of repository URL. The path
+// isn't taken into account, so GitLab subgroups and Azure DevOps projects are supported.
 func GetGitProviderFromUrl(uri string) string {
-	provider := ""
 	u, err := url.Parse(uri)
-	if err == nil {
-		lhost := strings.ToLower(u.Host)
-		provider = gitProvidersMap[lhost]
-		if provider == "" && awsCodeCommitRegex.FindStringSubmatch(lhost) != nil {
-			provider = "awsCodeCommit"
+	if err != nil {
+		return ""
+	}
+	lhost := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if provider, ok := gitProvidersMap[lhost]; ok {
+		return provider
+	}
+	if awsCodeCommitRegex.MatchString(lhost) {
+		return "awsCodeCommit"
+	}
+	if azureDevOpsLegacyRegex.MatchString(lhost) {
+		return "azureDevOpsServices"
+	}
+	for saasHost := range gitProvidersMap {
+		if strings.HasSuffix(lhost, "."+saasHost) {
+			// other hosts of SaaS providers aren't self-hosted servers, so don't guess
+			return ""
+		}
+	}
+	for _, label := range strings.Split(lhost, ".") {
+		if provider, ok := selfHostedGitProviders[label]; ok {
+			return provider
 		}
 	}
-	return provider
+	return ""
 }
 
 func validatePath(i interface{}, k string) (_ []string, errors []error) {
@@ -259,5 +286,6 @@ func ResourceRepo() common.Resource {
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewReposAPI(ctx, c).Delete(d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -20,6 +20,18 @@ func TestGetGitProviderFromUrl(t *testing.T) {
 	assert.Equal(t, "", GetGitProviderFromUrl("https://abc/user/repo.git"))
 	assert.Equal(t, "", GetGitProviderFromUrl("ewfgwergfwe"))
 	assert.Equal(t, "awsCodeCommit", GetGitProviderFromUrl("https://git-codecommit.us-east-2.amazonaws.com/v1/repos/MyDemoRepo"))
+	assert.Equal(t, "gitLab", GetGitProviderFromUrl("https://gitlab.com/group/subgroup/nested/repo.git"))
+	assert.Equal(t, "gitLabEnterpriseEdition", GetGitProviderFromUrl("https://gitlab.example.com:8443/group/subgroup/repo.git"))
+	assert.Equal(t, "gitHubEnterprise", GetGitProviderFromUrl("https://github.example.com/org/repo.git"))
+	assert.Equal(t, "bitbucketServer", GetGitProviderFromUrl("https://bitbucket.corp.example.com/scm/project/repo.git"))
+	assert.Equal(t, "azureDevOpsServices", GetGitProviderFromUrl("https://org.visualstudio.com/project/_git/repo"))
+	assert.Equal(t, "", GetGitProviderFromUrl("https://git.example.com/mygitlab/repo.git"))
+	assert.Equal(t, "gitHub", GetGitProviderFromUrl("https://www.github.com/user/repo.git"))
+	assert.Equal(t, "bitbucketCloud", GetGitProviderFromUrl("https://www.bitbucket.org/user/repo.git"))
+	assert.Equal(t, "gitLab", GetGitProviderFromUrl("https://WWW.GitLab.com/group/repo.git"))
+	assert.Equal(t, "", GetGitProviderFromUrl("https://gist.github.com/user/repo.git"))
+	assert.Equal(t, "", GetGitProviderFromUrl("https://mygithub.io/org/repo.git"))
+	assert.Equal(t, "gitHubEnterprise", GetGitProviderFromUrl("https://github.mycorp.io/org/repo.git"))
 }
 
 func TestResourceRepoRead(t *testing.T) {
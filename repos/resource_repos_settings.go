@@ -0,0 +1,108 @@
+package repos
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Workspace configuration keys of Repos settings from the admin console
+const (
+	enableGitProxyKey          = "enableGitProxy"
+	enableProjectsAllowListKey = "enableProjectsAllowList"
+	projectsAllowListKey       = "projectsAllowList"
+)
+
+// projectsAllowListDivider separates URL prefixes in the value of projectsAllowList
+const projectsAllowListDivider = ","
+
+type reposSettings struct {
+	EnableGitProxy bool     `json:"enable_git_proxy,omitempty"`
+	URLAllowList   []string `json:"url_allow_list,omitempty"`
+}
+
+// toWorkspaceConf returns all keys, so that settings removed from the configuration are disabled
+func (rs reposSettings) toWorkspaceConf() settings.WorkspaceConf {
+	return settings.WorkspaceConf{
+		enableGitProxyKey:          strconv.FormatBool(rs.EnableGitProxy),
+		enableProjectsAllowListKey: strconv.FormatBool(len(rs.URLAllowList) > 0),
+		projectsAllowListKey:       strings.Join(rs.URLAllowList, projectsAllowListDivider),
+	}
+}
+
+// parseAllowList splits comma-separated URL prefixes of the allow list
+func parseAllowList(v string) []string {
+	prefixes := []string{}
+	for _, prefix := range strings.Split(v, projectsAllowListDivider) {
+		prefix = strings.TrimSpace(prefix)
+		if prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// ResourceReposSettings manages connectivity of Repos to private Git servers through the Git server proxy
+// and restricts Git URLs, that Repos can work with
+func ResourceReposSettings() common.Resource {
+	s := common.StructToSchema(reposSettings{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		// URL prefixes are stored as a comma-separated list
+		m["url_allow_list"].Elem.(*schema.Schema).ValidateFunc = validation.All(
+			validation.IsURLWithHTTPS, validation.StringDoesNotContainAny(projectsAllowListDivider))
+		return m
+	})
+	set := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		var rs reposSettings
+		common.DataToStructPointer(d, s, &rs)
+		return w.WorkspaceConf.SetStatus(ctx, rs.toWorkspaceConf())
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			err := set(ctx, d, c)
+			if err != nil {
+				return err
+			}
+			d.SetId("_")
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			remote, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+				Keys: strings.Join([]string{enableGitProxyKey, enableProjectsAllowListKey, projectsAllowListKey}, ","),
+			})
+			if err != nil {
+				return err
+			}
+			rs := reposSettings{
+				// settings that were never changed are returned as empty strings and are disabled
+				EnableGitProxy: (*remote)[enableGitProxyKey] == "true",
+			}
+			if (*remote)[enableProjectsAllowListKey] == "true" {
+				rs.URLAllowList = parseAllowList((*remote)[projectsAllowListKey])
+			}
+			return common.StructToData(rs, s, d)
+		},
+		Update: set,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.WorkspaceConf.SetStatus(ctx, reposSettings{}.toWorkspaceConf())
+		},
+		WorkspaceLevel: true,
+	}
+}
@@ -0,0 +1,130 @@
+package repos
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+const reposSettingsResource = "/api/2.0/workspace-conf?keys=enableGitProxy%2CenableProjectsAllowList%2CprojectsAllowList"
+
+func TestResourceReposSettingsCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableGitProxy":          "true",
+					"enableProjectsAllowList": "true",
+					"projectsAllowList":       "https://git.example.com/data/,https://github.com/example/",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: reposSettingsResource,
+				Response: map[string]string{
+					"enableGitProxy":          "true",
+					"enableProjectsAllowList": "true",
+					"projectsAllowList":       "https://git.example.com/data/, https://github.com/example/",
+				},
+			},
+		},
+		Resource: ResourceReposSettings(),
+		HCL: `
+		enable_git_proxy = true
+		url_allow_list = ["https://git.example.com/data/", "https://github.com/example/"]
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "_", d.Id())
+	assert.Equal(t, true, d.Get("enable_git_proxy"))
+	assert.Equal(t, []any{"https://git.example.com/data/", "https://github.com/example/"}, d.Get("url_allow_list"))
+}
+
+func TestResourceReposSettingsRead_Defaults(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: reposSettingsResource,
+				Response: map[string]string{
+					"enableGitProxy":          "",
+					"enableProjectsAllowList": "false",
+					"projectsAllowList":       "https://github.com/example/",
+				},
+			},
+		},
+		Resource: ResourceReposSettings(),
+		Read:     true,
+		New:      true,
+		ID:       "_",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, false, d.Get("enable_git_proxy"))
+	assert.Equal(t, 0, d.Get("url_allow_list.#"))
+}
+
+func TestResourceReposSettingsUpdate_RemovesAllowList(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableGitProxy":          "true",
+					"enableProjectsAllowList": "false",
+					"projectsAllowList":       "",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: reposSettingsResource,
+				Response: map[string]string{
+					"enableGitProxy": "true",
+				},
+			},
+		},
+		Resource: ResourceReposSettings(),
+		InstanceState: map[string]string{
+			"enable_git_proxy": "true",
+			"url_allow_list.#": "1",
+			"url_allow_list.0": "https://github.com/example/",
+		},
+		HCL:    `enable_git_proxy = true`,
+		Update: true,
+		ID:     "_",
+	}.ApplyNoError(t)
+}
+
+func TestResourceReposSettingsDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableGitProxy":          "false",
+					"enableProjectsAllowList": "false",
+					"projectsAllowList":       "",
+				},
+			},
+		},
+		Resource: ResourceReposSettings(),
+		Delete:   true,
+		ID:       "_",
+		HCL:      `enable_git_proxy = true`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceReposSettings_CommaInURL(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceReposSettings(),
+		Create:   true,
+		HCL:      `url_allow_list = ["https://github.com/a,https://github.com/b"]`,
+	}.ExpectError(t, "invalid config supplied. [url_allow_list.#] expected value of url_allow_list.0 "+
+		"to not contain any of ,, got https://github.com/a,https://github.com/b")
+}
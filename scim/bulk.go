@@ -0,0 +1,78 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// maximum number of operations sent in a single bulk request
+const maxBulkOperations = 100
+
+// Details at https://datatracker.ietf.org/doc/html/rfc7644#section-3.7
+type bulkOperation struct {
+	Method   string       `json:"method"`
+	BulkID   string       `json:"bulkId,omitempty"`
+	Path     string       `json:"path,omitempty"`
+	Location string       `json:"location,omitempty"`
+	Data     patchRequest `json:"data,omitempty"`
+	Status   string       `json:"status,omitempty"`
+	Response any          `json:"response,omitempty"`
+}
+
+type bulkRequest struct {
+	Schemas      []URN           `json:"schemas"`
+	FailOnErrors int             `json:"failOnErrors,omitempty"`
+	Operations   []bulkOperation `json:"Operations"`
+}
+
+type bulkResponse struct {
+	Operations []bulkOperation `json:"Operations,omitempty"`
+}
+
+// UserPatch is a patch request for a single user, that is applied as a part of bulk request
+type UserPatch struct {
+	UserID  string
+	Request patchRequest
+}
+
+// BulkPatch applies patches to multiple users with as few requests as possible
+func (a UsersAPI) BulkPatch(patches []UserPatch) error {
+	for start := 0; start < len(patches); start += maxBulkOperations {
+		end := start + maxBulkOperations
+		if end > len(patches) {
+			end = len(patches)
+		}
+		request := bulkRequest{
+			Schemas: []URN{BulkRequestSchema},
+		}
+		users := map[string]string{}
+		for i, patch := range patches[start:end] {
+			bulkID := strconv.Itoa(start + i)
+			users[bulkID] = patch.UserID
+			request.Operations = append(request.Operations, bulkOperation{
+				Method: http.MethodPatch,
+				BulkID: bulkID,
+				Path:   fmt.Sprintf("/Users/%s", patch.UserID),
+				Data:   patch.Request,
+			})
+		}
+		var response bulkResponse
+		err := a.client.Scim(a.context, http.MethodPost, "/preview/scim/v2/Bulk", request, &response)
+		if err != nil {
+			return err
+		}
+		var failures []string
+		for _, op := range response.Operations {
+			status, err := strconv.Atoi(op.Status)
+			if err != nil || status >= 400 {
+				failures = append(failures, fmt.Sprintf("user %s: status %s %v", users[op.BulkID], op.Status, op.Response))
+			}
+		}
+		if len(failures) > 0 {
+			return fmt.Errorf("bulk operations failed: %s", strings.Join(failures, "; "))
+		}
+	}
+	return nil
+}
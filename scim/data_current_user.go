@@ -71,5 +71,6 @@ func DataSourceCurrentUser() common.Resource {
 			d.SetId(me.Id)
 			return nil
 		},
+		WorkspaceLevel: true,
 	}
 }
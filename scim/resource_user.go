@@ -22,6 +22,52 @@ const (
 	userAttributes = "userName,displayName,active,externalId,entitlements"
 )
 
+var enterpriseAttributes = []string{"department", "employee_number", "manager_id"}
+
+// enterpriseExtensionFromData applies changed enterprise attributes to the current ones. Attributes removed
+// from the configuration are sent as empty values, so that they are cleared.
+func enterpriseExtensionFromData(d *schema.ResourceData, current *EnterpriseUserExtension) *EnterpriseUserExtension {
+	ext := EnterpriseUserExtension{}
+	if current != nil {
+		ext = *current
+	}
+	if d.HasChange("department") {
+		ext.Department = d.Get("department").(string)
+	}
+	if d.HasChange("employee_number") {
+		ext.EmployeeNumber = d.Get("employee_number").(string)
+	}
+	if d.HasChange("manager_id") {
+		ext.Manager = nil
+		if managerID := d.Get("manager_id").(string); managerID != "" {
+			ext.Manager = &ComplexValue{Value: managerID}
+		}
+	}
+	return &ext
+}
+
+// readEnterpriseExtensionIntoData refreshes only the enterprise attributes, that are managed by Terraform,
+// so that values provisioned by the identity provider don't show up as a diff
+func readEnterpriseExtensionIntoData(ext *EnterpriseUserExtension, d *schema.ResourceData) {
+	if ext == nil {
+		ext = &EnterpriseUserExtension{}
+	}
+	managerID := ""
+	if ext.Manager != nil {
+		managerID = ext.Manager.Value
+	}
+	values := map[string]string{
+		"department":      ext.Department,
+		"employee_number": ext.EmployeeNumber,
+		"manager_id":      managerID,
+	}
+	for _, attr := range enterpriseAttributes {
+		if d.Get(attr).(string) != "" {
+			d.Set(attr, values[attr])
+		}
+	}
+}
+
 // ResourceUser manages users within workspace
 func ResourceUser() common.Resource {
 	type entity struct {
@@ -29,6 +75,11 @@ func ResourceUser() common.Resource {
 		DisplayName string `json:"display_name,omitempty" tf:"computed"`
 		Active      bool   `json:"active,omitempty"`
 		ExternalID  string `json:"external_id,omitempty" tf:"suppress_diff"`
+		// Attributes of the enterprise extension are managed only when configured,
+		// otherwise values provisioned from the identity provider are kept as is.
+		Department     string `json:"department,omitempty"`
+		EmployeeNumber string `json:"employee_number,omitempty"`
+		ManagerID      string `json:"manager_id,omitempty"`
 	}
 	userSchema := common.StructToSchema(entity{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -72,13 +123,14 @@ func ResourceUser() common.Resource {
 	scimUserFromData := func(d *schema.ResourceData) (user User, err error) {
 		var u entity
 		common.DataToStructPointer(d, userSchema, &u)
-		return User{
+		user = User{
 			UserName:     u.UserName,
 			DisplayName:  u.DisplayName,
 			Active:       u.Active,
 			Entitlements: readEntitlementsFromData(d),
 			ExternalID:   u.ExternalID,
-		}, nil
+		}
+		return user, nil
 	}
 	return common.Resource{
 		Schema: userSchema,
@@ -87,6 +139,10 @@ func ResourceUser() common.Resource {
 			if err != nil {
 				return err
 			}
+			u.EnterpriseExtension = enterpriseExtensionFromData(d, nil)
+			if *u.EnterpriseExtension == (EnterpriseUserExtension{}) {
+				u.EnterpriseExtension = nil
+			}
 			usersAPI := NewUsersAPI(ctx, c)
 			user, err := usersAPI.Create(u)
 			if err != nil {
@@ -96,10 +152,11 @@ func ResourceUser() common.Resource {
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			user, err := NewUsersAPI(ctx, c).Read(d.Id(), userAttributes)
+			user, err := NewUsersAPI(ctx, c).Read(d.Id(), userAttributes+","+string(EnterpriseUserSchema))
 			if err != nil {
 				return err
 			}
+			readEnterpriseExtensionIntoData(user.EnterpriseExtension, d)
 			d.Set("user_name", user.UserName)
 			d.Set("display_name", user.DisplayName)
 			d.Set("active", user.Active)
@@ -114,7 +171,16 @@ func ResourceUser() common.Resource {
 			if err != nil {
 				return err
 			}
-			return NewUsersAPI(ctx, c).Update(d.Id(), u)
+			usersAPI := NewUsersAPI(ctx, c)
+			if d.HasChanges(enterpriseAttributes...) {
+				// attributes, that aren't configured, keep the values from the identity provider
+				current, err := usersAPI.Read(d.Id(), string(EnterpriseUserSchema))
+				if err != nil {
+					return err
+				}
+				u.EnterpriseExtension = enterpriseExtensionFromData(d, current.EnterpriseExtension)
+			}
+			return usersAPI.Update(d.Id(), u)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			user := NewUsersAPI(ctx, c)
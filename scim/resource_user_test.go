@@ -19,7 +19,7 @@ func TestResourceUserRead(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Response: User{
 					ID:          "abc",
 					DisplayName: "Example user",
@@ -57,7 +57,7 @@ func TestResourceUserRead_NotFound(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Status:   404,
 			},
 		},
@@ -74,7 +74,7 @@ func TestResourceUserRead_Error(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Status:   400,
 				Response: apierr.APIErrorBody{
 					ScimDetail: "Something",
@@ -114,7 +114,7 @@ func TestResourceUserCreate(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Response: User{
 					DisplayName: "Example user",
 					Active:      true,
@@ -178,7 +178,7 @@ func TestResourceUserCreateInactive(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Response: User{
 					DisplayName: "Example user",
 					Active:      false,
@@ -272,7 +272,7 @@ func TestResourceUserUpdate(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Response: User{
 					DisplayName: "Example user",
 					Active:      true,
@@ -310,7 +310,7 @@ func TestResourceUserUpdate(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Response: newUser,
 			},
 		},
@@ -336,12 +336,201 @@ func TestResourceUserUpdate(t *testing.T) {
 	assert.Equal(t, true, d.Get("allow_instance_pool_create"))
 }
 
+func TestResourceUserCreate_EnterpriseExtension(t *testing.T) {
+	enterprise := &EnterpriseUserExtension{
+		Department:     "Finance",
+		EmployeeNumber: "1234",
+		Manager: &ComplexValue{
+			Value: "def",
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Users",
+				ExpectedRequest: User{
+					Schemas:  []URN{UserSchema, EnterpriseUserSchema},
+					UserName: "me@example.com",
+					Active:   true,
+					Entitlements: entitlements{
+						{
+							Value: "allow-cluster-create",
+						},
+					},
+					EnterpriseExtension: enterprise,
+				},
+				Response: User{
+					ID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
+				Response: User{
+					ID:                  "abc",
+					UserName:            "me@example.com",
+					Active:              true,
+					EnterpriseExtension: enterprise,
+				},
+			},
+		},
+		Resource: ResourceUser(),
+		Create:   true,
+		HCL: `
+		user_name       = "me@example.com"
+		allow_cluster_create = true
+		department      = "Finance"
+		employee_number = "1234"
+		manager_id      = "def"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "abc",
+		"department":      "Finance",
+		"employee_number": "1234",
+		"manager_id":      "def",
+	})
+}
+
+func TestResourceUserUpdate_KeepsEnterpriseExtensionFromIdP(t *testing.T) {
+	enterprise := &EnterpriseUserExtension{
+		Department: "Finance",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
+				Response: User{
+					ID:                  "abc",
+					EnterpriseExtension: enterprise,
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				ExpectedRequest: User{
+					Schemas:     []URN{UserSchema, EnterpriseUserSchema},
+					UserName:    "me@example.com",
+					DisplayName: "Changed Name",
+					Active:      true,
+					Entitlements: entitlements{
+						{
+							Value: "allow-cluster-create",
+						},
+					},
+					EnterpriseExtension: enterprise,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
+				Response: User{
+					ID:                  "abc",
+					UserName:            "me@example.com",
+					DisplayName:         "Changed Name",
+					Active:              true,
+					EnterpriseExtension: enterprise,
+				},
+			},
+		},
+		Resource: ResourceUser(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"user_name":    "me@example.com",
+			"display_name": "Old Name",
+		},
+		HCL: `
+		user_name    = "me@example.com"
+		display_name = "Changed Name"
+		allow_cluster_create = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"display_name": "Changed Name",
+		// not configured, so it isn't managed by Terraform
+		"department": "",
+		"manager_id": "",
+	})
+}
+
+func TestResourceUserUpdate_ClearsRemovedEnterpriseAttribute(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
+				Response: User{
+					ID: "abc",
+					EnterpriseExtension: &EnterpriseUserExtension{
+						Department:     "Finance",
+						EmployeeNumber: "1234",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
+				Response: User{
+					ID: "abc",
+					EnterpriseExtension: &EnterpriseUserExtension{
+						Department:     "Finance",
+						EmployeeNumber: "1234",
+					},
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				ExpectedRequest: map[string]any{
+					"schemas":  []URN{UserSchema, EnterpriseUserSchema},
+					"userName": "me@example.com",
+					"active":   true,
+					"entitlements": []map[string]any{
+						{"value": "allow-cluster-create"},
+					},
+					"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": map[string]any{
+						"department":     "",
+						"employeeNumber": "1234",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
+				Response: User{
+					ID:       "abc",
+					UserName: "me@example.com",
+					Active:   true,
+					EnterpriseExtension: &EnterpriseUserExtension{
+						EmployeeNumber: "1234",
+					},
+				},
+			},
+		},
+		Resource: ResourceUser(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"user_name":  "me@example.com",
+			"department": "Finance",
+		},
+		HCL: `
+		user_name = "me@example.com"
+		allow_cluster_create = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"department":      "",
+		"employee_number": "",
+	})
+}
+
 func TestResourceUserUpdate_Error(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Status:   400,
 			},
 		},
@@ -363,7 +552,7 @@ func TestResourceUserUpdate_ErrorPut(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 				Response: User{
 					DisplayName: "Example user",
 					Active:      true,
@@ -686,7 +875,7 @@ func TestCreateForceOverwriteFindsAndSetsID(t *testing.T) {
 		},
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles",
+			Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 			Response: User{
 				ID: "abc",
 			},
@@ -728,7 +917,7 @@ func TestCreateForceOverwriteFindsAndSetsAccID(t *testing.T) {
 		},
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles",
+			Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles,urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
 			Response: User{
 				ID: "abc",
 			},
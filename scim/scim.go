@@ -12,8 +12,10 @@ const (
 	UserSchema             URN = "urn:ietf:params:scim:schemas:core:2.0:User"
 	ServicePrincipalSchema URN = "urn:ietf:params:scim:schemas:core:2.0:ServicePrincipal"
 	WorkspaceUserSchema    URN = "urn:ietf:params:scim:schemas:extension:workspace:2.0:User"
+	EnterpriseUserSchema   URN = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
 	PatchOp                URN = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
 	GroupSchema            URN = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	BulkRequestSchema      URN = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
 )
 
 // Generalisation of most common complex values from SCIM protocol
@@ -153,6 +155,17 @@ type User struct {
 	Roles         []ComplexValue    `json:"roles,omitempty"`
 	Entitlements  entitlements      `json:"entitlements,omitempty"`
 	ExternalID    string            `json:"externalId,omitempty"`
+
+	EnterpriseExtension *EnterpriseUserExtension `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+}
+
+// EnterpriseUserExtension contains attributes of the enterprise SCIM schema extension, that are
+// usually provisioned from the identity provider.
+// Details at https://datatracker.ietf.org/doc/html/rfc7643#section-4.3
+type EnterpriseUserExtension struct {
+	EmployeeNumber string        `json:"employeeNumber"`
+	Department     string        `json:"department"`
+	Manager        *ComplexValue `json:"manager,omitempty"`
 }
 
 // UserList contains a list of Users fetched from a list api call from SCIM api
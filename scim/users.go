@@ -25,12 +25,20 @@ type UsersAPI struct {
 // Create user in the backend
 func (a UsersAPI) Create(ru User) (user User, err error) {
 	if ru.Schemas == nil {
-		ru.Schemas = []URN{UserSchema}
+		ru.Schemas = userSchemas(ru)
 	}
 	err = a.client.Scim(a.context, http.MethodPost, "/preview/scim/v2/Users", ru, &user)
 	return user, err
 }
 
+// userSchemas returns schema URNs for the user, including extensions that are used
+func userSchemas(u User) []URN {
+	if u.EnterpriseExtension != nil {
+		return []URN{UserSchema, EnterpriseUserSchema}
+	}
+	return []URN{UserSchema}
+}
+
 // Filter retrieves users by filter
 func (a UsersAPI) Filter(filter string, excludeRoles bool) (u []User, err error) {
 	var users UserList
@@ -67,14 +75,18 @@ func (a UsersAPI) readByPath(userPath string) (user User, err error) {
 
 // Update replaces user information for given ID
 func (a UsersAPI) Update(userID string, updateRequest User) error {
-	user, err := a.Read(userID, "groups,roles")
+	user, err := a.Read(userID, "groups,roles,"+string(EnterpriseUserSchema))
 	if err != nil {
 		return err
 	}
 	updateRequest.Groups = user.Groups
 	updateRequest.Roles = user.Roles
+	if updateRequest.EnterpriseExtension == nil {
+		// keep attributes provisioned by the identity provider, as PUT would reset them
+		updateRequest.EnterpriseExtension = user.EnterpriseExtension
+	}
 	if updateRequest.Schemas == nil {
-		updateRequest.Schemas = []URN{UserSchema}
+		updateRequest.Schemas = userSchemas(updateRequest)
 	}
 	return a.client.Scim(a.context, http.MethodPut,
 		fmt.Sprintf("/preview/scim/v2/Users/%v", userID),
@@ -173,5 +173,6 @@ func ResourceSecret() common.Resource {
 			}
 			return NewSecretsAPI(ctx, c).Delete(scope, key)
 		},
+		WorkspaceLevel: true,
 	}
 }
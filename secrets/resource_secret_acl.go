@@ -105,5 +105,6 @@ func ResourceSecretACL() common.Resource {
 			}
 			return NewSecretAclsAPI(ctx, c).Delete(scope, principal)
 		},
+		WorkspaceLevel: true,
 	}
 }
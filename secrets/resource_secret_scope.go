@@ -107,6 +107,11 @@ var validScope = validation.StringMatch(regexp.MustCompile(`^[\w\.@_/-]{1,128}$`
 	"Must consist of alphanumeric characters, dashes, underscores, and periods, "+
 		"and may not exceed 128 characters.")
 
+// suppressAfterCreation ignores changes of attributes, that are only used when the resource is created
+func suppressAfterCreation(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
 // ResourceSecretScope manages secret scopes
 func ResourceSecretScope() common.Resource {
 	s := common.StructToSchema(SecretScope{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
@@ -114,6 +119,29 @@ func ResourceSecretScope() common.Resource {
 		// nolint
 		s["name"].ValidateFunc = validScope
 
+		// Secrets created together with the scope. Changes after creation are ignored,
+		// use databricks_secret to manage secrets over time.
+		s["initial_secret"] = &schema.Schema{
+			Type:             schema.TypeSet,
+			Optional:         true,
+			ConflictsWith:    []string{"keyvault_metadata"},
+			DiffSuppressFunc: suppressAfterCreation,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validScope,
+					},
+					"string_value": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		}
 		return s
 	})
 	return common.Resource{
@@ -123,9 +151,22 @@ func ResourceSecretScope() common.Resource {
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var scope SecretScope
 			common.DataToStructPointer(d, s, &scope)
-			if err := NewSecretScopesAPI(ctx, c).Create(scope); err != nil {
+			scopesAPI := NewSecretScopesAPI(ctx, c)
+			if err := scopesAPI.Create(scope); err != nil {
 				return err
 			}
+			secretsAPI := NewSecretsAPI(ctx, c)
+			for _, v := range d.Get("initial_secret").(*schema.Set).List() {
+				secret := v.(map[string]any)
+				err := secretsAPI.Create(secret["string_value"].(string), scope.Name, secret["key"].(string))
+				if err != nil {
+					// don't leave a scope without mandatory secrets behind
+					if derr := scopesAPI.Delete(scope.Name); derr != nil {
+						return fmt.Errorf("%w; failed to delete secret scope %s: %w", err, scope.Name, derr)
+					}
+					return err
+				}
+			}
 			d.SetId(scope.Name)
 			return nil
 		},
@@ -139,5 +180,6 @@ func ResourceSecretScope() common.Resource {
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewSecretScopesAPI(ctx, c).Delete(d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -153,6 +153,98 @@ func TestResourceSecretScopeCreate(t *testing.T) {
 	assert.Equal(t, "Boom", d.Id())
 }
 
+func TestResourceSecretScopeCreate_InitialSecrets(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/scopes/create",
+				ExpectedRequest: map[string]string{
+					"scope":              "Boom",
+					"scope_backend_type": "DATABRICKS",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				ExpectedRequest: SecretsRequest{
+					StringValue: "secret",
+					Scope:       "Boom",
+					Key:         "token",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/secrets/scopes/list",
+				Response: SecretScopeList{
+					Scopes: []SecretScope{
+						{
+							Name:        "Boom",
+							BackendType: "DATABRICKS",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		HCL: `
+		name = "Boom"
+		initial_secret {
+			key = "token"
+			string_value = "secret"
+		}
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "Boom", d.Id())
+}
+
+func TestResourceSecretScopeCreate_InitialSecretsErrorDeletesScope(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/scopes/create",
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Secret value is too large",
+				},
+				Status: 400,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/scopes/delete",
+				ExpectedRequest: map[string]string{
+					"scope": "Boom",
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		HCL: `
+		name = "Boom"
+		initial_secret {
+			key = "token"
+			string_value = "secret"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "Secret value is too large")
+}
+
+func TestResourceSecretScopeInitialSecretsIgnoredAfterCreation(t *testing.T) {
+	r := ResourceSecretScope().ToResource()
+	d := r.TestResourceData()
+	suppress := r.Schema["initial_secret"].DiffSuppressFunc
+	assert.False(t, suppress("initial_secret.#", "0", "1", d))
+	d.SetId("Boom")
+	assert.True(t, suppress("initial_secret.#", "0", "1", d))
+}
+
 func TestResourceSecretScopeCreate_KeyVault(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
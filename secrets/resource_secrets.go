@@ -0,0 +1,277 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultSecretsParallelism = 10
+
+// secretOperation is a single write to a secret scope, like putting a secret or an ACL
+type secretOperation struct {
+	name string
+	do   func(ctx context.Context) error
+}
+
+// runSecretOperations runs operations with at most `parallelism` of them in flight. Requests rejected
+// with 429 Too Many Requests are already retried by the Go SDK. Errors of all failed operations are
+// returned together.
+func runSecretOperations(ctx context.Context, parallelism int, ops []secretOperation) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed []string
+	)
+	slots := make(chan struct{}, parallelism)
+	for _, op := range ops {
+		op := op
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if err := op.do(ctx); err != nil {
+				mu.Lock()
+				failed = append(failed, fmt.Sprintf("%s: %s", op.name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return errors.New(strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+func stringMap(v any) map[string]string {
+	m := map[string]string{}
+	for k, v := range v.(map[string]any) {
+		m[k] = v.(string)
+	}
+	return m
+}
+
+// secretsDiff returns operations to turn old secrets and ACLs of the scope into new ones
+func secretsDiff(w scopeWriter, oldSecrets, newSecrets, oldACLs, newACLs map[string]string) (ops []secretOperation) {
+	for key, value := range newSecrets {
+		if old, ok := oldSecrets[key]; ok && old == value {
+			continue
+		}
+		key, value := key, value
+		ops = append(ops, secretOperation{"secret " + key, func(ctx context.Context) error {
+			return w.putSecret(ctx, key, value)
+		}})
+	}
+	for key := range oldSecrets {
+		if _, ok := newSecrets[key]; ok {
+			continue
+		}
+		key := key
+		ops = append(ops, secretOperation{"secret " + key, func(ctx context.Context) error {
+			return w.deleteSecret(ctx, key)
+		}})
+	}
+	for principal, permission := range newACLs {
+		if old, ok := oldACLs[principal]; ok && old == permission {
+			continue
+		}
+		principal, permission := principal, permission
+		ops = append(ops, secretOperation{"acl " + principal, func(ctx context.Context) error {
+			return w.putACL(ctx, principal, permission)
+		}})
+	}
+	for principal := range oldACLs {
+		if _, ok := newACLs[principal]; ok {
+			continue
+		}
+		principal := principal
+		ops = append(ops, secretOperation{"acl " + principal, func(ctx context.Context) error {
+			return w.deleteACL(ctx, principal)
+		}})
+	}
+	return ops
+}
+
+// scopeWriter writes secrets and ACLs of a single scope, ignoring already deleted ones
+type scopeWriter struct {
+	secrets workspace.SecretsInterface
+	scope   string
+}
+
+func (s scopeWriter) putSecret(ctx context.Context, key, value string) error {
+	return s.secrets.PutSecret(ctx, workspace.PutSecret{
+		Scope:       s.scope,
+		Key:         key,
+		StringValue: value,
+	})
+}
+
+func (s scopeWriter) deleteSecret(ctx context.Context, key string) error {
+	err := s.secrets.DeleteSecret(ctx, workspace.DeleteSecret{
+		Scope: s.scope,
+		Key:   key,
+	})
+	if apierr.IsMissing(err) {
+		return nil
+	}
+	return err
+}
+
+func (s scopeWriter) putACL(ctx context.Context, principal, permission string) error {
+	return s.secrets.PutAcl(ctx, workspace.PutAcl{
+		Scope:      s.scope,
+		Principal:  principal,
+		Permission: workspace.AclPermission(permission),
+	})
+}
+
+func (s scopeWriter) deleteACL(ctx context.Context, principal string) error {
+	err := s.secrets.DeleteAcl(ctx, workspace.DeleteAcl{
+		Scope:     s.scope,
+		Principal: principal,
+	})
+	if apierr.IsMissing(err) {
+		return nil
+	}
+	return err
+}
+
+func writeSecrets(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient,
+	oldSecrets, oldACLs map[string]string) error {
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	ops := secretsDiff(scopeWriter{w.Secrets, d.Get("scope").(string)}, oldSecrets,
+		stringMap(d.Get("secrets")), oldACLs, stringMap(d.Get("acls")))
+	return runSecretOperations(ctx, d.Get("parallelism").(int), ops)
+}
+
+// ResourceSecrets manages many secrets and ACLs of a secret scope at once
+func ResourceSecrets() common.Resource {
+	return common.Resource{
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				ValidateFunc: validScope,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"secrets": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"acls": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(ACLPermissionRead),
+						string(ACLPermissionWrite),
+						string(ACLPermissionManage),
+					}, false),
+				},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultSecretsParallelism,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"config_references": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// the resource is tainted if some writes fail, so that written secrets are deleted on replacement
+			d.SetId(d.Get("scope").(string))
+			return writeSecrets(ctx, d, c, map[string]string{}, map[string]string{})
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			scope := d.Id()
+			existing, err := w.Secrets.ListSecretsAll(ctx, workspace.ListSecretsRequest{Scope: scope})
+			if err != nil {
+				return err
+			}
+			keys := map[string]bool{}
+			for _, v := range existing {
+				keys[v.Key] = true
+			}
+			// values can't be read back, so only secrets deleted outside of Terraform are detected
+			secrets := map[string]string{}
+			references := map[string]string{}
+			for key, value := range stringMap(d.Get("secrets")) {
+				if keys[key] {
+					secrets[key] = value
+					references[key] = fmt.Sprintf("{{secrets/%s/%s}}", scope, key)
+				}
+			}
+			acls, err := w.Secrets.ListAclsAll(ctx, workspace.ListAclsRequest{Scope: scope})
+			if err != nil {
+				return err
+			}
+			configured := stringMap(d.Get("acls"))
+			permissions := map[string]string{}
+			for _, v := range acls {
+				if _, ok := configured[v.Principal]; ok {
+					permissions[v.Principal] = string(v.Permission)
+				}
+			}
+			d.Set("scope", scope)
+			d.Set("secrets", secrets)
+			d.Set("acls", permissions)
+			return d.Set("config_references", references)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			oldSecrets, _ := d.GetChange("secrets")
+			oldACLs, _ := d.GetChange("acls")
+			err := writeSecrets(ctx, d, c, stringMap(oldSecrets), stringMap(oldACLs))
+			if err != nil {
+				// keep the previous state, so that failed writes are retried on the next apply
+				d.Partial(true)
+				return err
+			}
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			ops := secretsDiff(scopeWriter{w.Secrets, d.Id()}, stringMap(d.Get("secrets")),
+				map[string]string{}, stringMap(d.Get("acls")), map[string]string{})
+			return runSecretOperations(ctx, d.Get("parallelism").(int), ops)
+		},
+		WorkspaceLevel: true,
+	}
+}
@@ -0,0 +1,277 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResourceSecretsCreate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockSecretsAPI().EXPECT()
+			e.PutSecret(mock.Anything, workspace.PutSecret{
+				Scope:       "app",
+				Key:         "user",
+				StringValue: "admin",
+			}).Return(nil)
+			e.PutSecret(mock.Anything, workspace.PutSecret{
+				Scope:       "app",
+				Key:         "password",
+				StringValue: "s3cr3t",
+			}).Return(nil)
+			e.PutAcl(mock.Anything, workspace.PutAcl{
+				Scope:      "app",
+				Principal:  "data-engineers",
+				Permission: workspace.AclPermissionRead,
+			}).Return(nil)
+			e.ListSecretsAll(mock.Anything, workspace.ListSecretsRequest{Scope: "app"}).Return(
+				[]workspace.SecretMetadata{{Key: "user"}, {Key: "password"}}, nil)
+			e.ListAclsAll(mock.Anything, workspace.ListAclsRequest{Scope: "app"}).Return(
+				[]workspace.AclItem{
+					{Principal: "admin@example.com", Permission: workspace.AclPermissionManage},
+					{Principal: "data-engineers", Permission: workspace.AclPermissionRead},
+				}, nil)
+		},
+		Resource: ResourceSecrets(),
+		Create:   true,
+		HCL: `
+		scope = "app"
+		secrets = {
+			user = "admin"
+			password = "s3cr3t"
+		}
+		acls = {
+			data-engineers = "READ"
+		}
+		parallelism = 2
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "app",
+		"secrets.%":                  "2",
+		"acls.%":                     "1",
+		"acls.data-engineers":        "READ",
+		"config_references.password": "{{secrets/app/password}}",
+	})
+}
+
+func TestResourceSecretsCreate_Error(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockSecretsAPI().EXPECT()
+			e.PutSecret(mock.Anything, workspace.PutSecret{
+				Scope:       "app",
+				Key:         "a",
+				StringValue: "x",
+			}).Return(fmt.Errorf("nope"))
+			e.PutSecret(mock.Anything, workspace.PutSecret{
+				Scope:       "app",
+				Key:         "b",
+				StringValue: "y",
+			}).Return(nil)
+			e.PutAcl(mock.Anything, workspace.PutAcl{
+				Scope:      "app",
+				Principal:  "users",
+				Permission: workspace.AclPermissionWrite,
+			}).Return(fmt.Errorf("no such principal"))
+		},
+		Resource: ResourceSecrets(),
+		Create:   true,
+		HCL: `
+		scope = "app"
+		secrets = {
+			a = "x"
+			b = "y"
+		}
+		acls = {
+			users = "WRITE"
+		}
+		`,
+	}.Apply(t)
+	assert.EqualError(t, err, "acl users: no such principal; secret a: nope")
+	assert.Equal(t, "app", d.Id(), "written secrets must be tracked")
+}
+
+func TestResourceSecretsRead_Missing(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockSecretsAPI().EXPECT()
+			e.ListSecretsAll(mock.Anything, workspace.ListSecretsRequest{Scope: "app"}).Return(
+				[]workspace.SecretMetadata{{Key: "user"}, {Key: "unmanaged"}}, nil)
+			e.ListAclsAll(mock.Anything, workspace.ListAclsRequest{Scope: "app"}).Return(
+				[]workspace.AclItem{
+					{Principal: "data-engineers", Permission: workspace.AclPermissionWrite},
+				}, nil)
+		},
+		Resource: ResourceSecrets(),
+		Read:     true,
+		New:      true,
+		ID:       "app",
+		State: map[string]any{
+			"scope": "app",
+			"secrets": map[string]any{
+				"user":     "admin",
+				"password": "s3cr3t",
+			},
+			"acls": map[string]any{
+				"data-engineers": "READ",
+				"analysts":       "READ",
+			},
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"secrets.%":           "1",
+		"secrets.user":        "admin",
+		"acls.%":              "1",
+		"acls.data-engineers": "WRITE",
+	})
+}
+
+func TestResourceSecretsUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockSecretsAPI().EXPECT()
+			e.PutSecret(mock.Anything, workspace.PutSecret{
+				Scope:       "app",
+				Key:         "password",
+				StringValue: "new",
+			}).Return(nil)
+			e.DeleteSecret(mock.Anything, workspace.DeleteSecret{
+				Scope: "app",
+				Key:   "user",
+			}).Return(nil)
+			e.DeleteAcl(mock.Anything, workspace.DeleteAcl{
+				Scope:     "app",
+				Principal: "data-engineers",
+			}).Return(nil)
+			e.ListSecretsAll(mock.Anything, workspace.ListSecretsRequest{Scope: "app"}).Return(
+				[]workspace.SecretMetadata{{Key: "password"}}, nil)
+			e.ListAclsAll(mock.Anything, workspace.ListAclsRequest{Scope: "app"}).Return(nil, nil)
+		},
+		Resource: ResourceSecrets(),
+		Update:   true,
+		ID:       "app",
+		InstanceState: map[string]string{
+			"scope":               "app",
+			"parallelism":         "10",
+			"secrets.%":           "2",
+			"secrets.user":        "admin",
+			"secrets.password":    "old",
+			"acls.%":              "1",
+			"acls.data-engineers": "READ",
+		},
+		HCL: `
+		scope = "app"
+		secrets = {
+			password = "new"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"secrets.%":        "1",
+		"secrets.password": "new",
+	})
+}
+
+func TestResourceSecretsUpdate_PartialError(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockSecretsAPI().EXPECT()
+			e.PutSecret(mock.Anything, workspace.PutSecret{
+				Scope:       "app",
+				Key:         "password",
+				StringValue: "new",
+			}).Return(fmt.Errorf("nope"))
+			e.DeleteSecret(mock.Anything, workspace.DeleteSecret{
+				Scope: "app",
+				Key:   "user",
+			}).Return(nil)
+		},
+		Resource: ResourceSecrets(),
+		Update:   true,
+		ID:       "app",
+		InstanceState: map[string]string{
+			"scope":            "app",
+			"parallelism":      "10",
+			"secrets.%":        "2",
+			"secrets.user":     "admin",
+			"secrets.password": "old",
+		},
+		HCL: `
+		scope = "app"
+		secrets = {
+			password = "new"
+		}
+		`,
+	}.Apply(t)
+	assert.EqualError(t, err, "secret password: nope")
+	assert.Equal(t, "old", d.State().Attributes["secrets.password"])
+}
+
+func TestResourceSecretsDelete(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockSecretsAPI().EXPECT()
+			e.DeleteSecret(mock.Anything, workspace.DeleteSecret{
+				Scope: "app",
+				Key:   "user",
+			}).Return(&apierr.APIError{
+				ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+				StatusCode: 404,
+				Message:    "not found",
+			})
+			e.DeleteAcl(mock.Anything, workspace.DeleteAcl{
+				Scope:     "app",
+				Principal: "data-engineers",
+			}).Return(nil)
+		},
+		Resource: ResourceSecrets(),
+		Delete:   true,
+		ID:       "app",
+		State: map[string]any{
+			"scope":       "app",
+			"parallelism": 10,
+			"secrets": map[string]any{
+				"user": "admin",
+			},
+			"acls": map[string]any{
+				"data-engineers": "READ",
+			},
+		},
+	}.ApplyNoError(t)
+}
+
+func TestRunSecretOperationsIsBounded(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		maxSeen  int
+	)
+	ops := []secretOperation{}
+	for i := 0; i < 20; i++ {
+		ops = append(ops, secretOperation{fmt.Sprintf("secret %d", i), func(ctx context.Context) error {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxSeen {
+				maxSeen = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		}})
+	}
+	err := runSecretOperations(context.Background(), 3, ops)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, maxSeen, 3)
+	assert.Greater(t, maxSeen, 1)
+}
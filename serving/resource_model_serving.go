@@ -134,6 +134,7 @@ func ResourceModelServing() common.Resource {
 			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
 			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
 		},
+		WorkspaceLevel: true,
 	}
 }
 
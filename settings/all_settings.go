@@ -15,7 +15,16 @@ import (
 //  3. Add a new entry to the AllSettingsResources map below. The final resource name will be "databricks_<SETTING_NAME>_setting".
 func AllSettingsResources() map[string]common.Resource {
 	return map[string]common.Resource{
-		"default_namespace":         makeSettingResource[settings.DefaultNamespaceSetting, *databricks.WorkspaceClient](defaultNamespaceSetting),
-		"restrict_workspace_admins": makeSettingResource[settings.RestrictWorkspaceAdminsSetting, *databricks.WorkspaceClient](restrictWsAdminsSetting),
+		"default_namespace":           makeSettingResource[settings.DefaultNamespaceSetting, *databricks.WorkspaceClient](defaultNamespaceSetting),
+		"restrict_workspace_admins":   makeSettingResource[settings.RestrictWorkspaceAdminsSetting, *databricks.WorkspaceClient](restrictWsAdminsSetting),
+		"partner_powered_ai_features": makeSettingResource[PartnerPoweredAiFeaturesSetting, *databricks.WorkspaceClient](partnerPoweredAiFeaturesSetting),
+	}
+}
+
+// AllSettingsDataSources returns data sources reporting the current value of settings. The final
+// data source name will be "databricks_<SETTING_NAME>_setting".
+func AllSettingsDataSources() map[string]common.Resource {
+	return map[string]common.Resource{
+		"restrict_workspace_admins": DataSourceRestrictWorkspaceAdminsSetting(),
 	}
 }
@@ -0,0 +1,152 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const databricksSqlAccessEntitlement = "databricks-sql-access"
+
+func hasEntitlement(entitlements []iam.ComplexValue, entitlement string) bool {
+	for _, e := range entitlements {
+		if e.Value == entitlement {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDatabricksSqlAccess checks entitlements of the current user, including the ones
+// inherited from the groups that the user is a member of.
+func hasDatabricksSqlAccess(ctx context.Context, w *databricks.WorkspaceClient) (bool, error) {
+	me, err := w.CurrentUser.Me(ctx)
+	if err != nil {
+		return false, err
+	}
+	if hasEntitlement(me.Entitlements, databricksSqlAccessEntitlement) {
+		return true, nil
+	}
+	if len(me.Groups) == 0 {
+		return false, nil
+	}
+	// entitlements of all groups are fetched with a single request
+	filters := make([]string, 0, len(me.Groups))
+	for _, g := range me.Groups {
+		filters = append(filters, fmt.Sprintf(`id eq "%s"`, g.Value))
+	}
+	groups, err := w.Groups.ListAll(ctx, iam.ListGroupsRequest{
+		Attributes: "id,entitlements",
+		Filter:     strings.Join(filters, " or "),
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, group := range groups {
+		if hasEntitlement(group.Entitlements, databricksSqlAccessEntitlement) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type preconditionData struct {
+	UnityCatalogEnabled  bool              `json:"unity_catalog_enabled,omitempty"`
+	ServerlessSqlEnabled bool              `json:"serverless_sql_enabled,omitempty"`
+	DatabricksSqlAccess  bool              `json:"databricks_sql_access,omitempty"`
+	WorkspaceConf        map[string]string `json:"workspace_conf,omitempty"`
+	MetastoreID          string            `json:"metastore_id,omitempty" tf:"computed"`
+}
+
+func DataSourcePrecondition() common.Resource {
+	s := common.StructToSchema(preconditionData{}, common.NoCustomize)
+	return common.Resource{
+		Schema:         s,
+		WorkspaceLevel: true,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var data preconditionData
+			common.DataToStructPointer(d, s, &data)
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			unmet, err := checkPreconditions(ctx, &data, w, c)
+			if err != nil {
+				return err
+			}
+			if len(unmet) > 0 {
+				return fmt.Errorf("workspace preconditions are not met: %s", strings.Join(unmet, "; "))
+			}
+			d.SetId("_")
+			return common.StructToData(data, s, d)
+		},
+	}
+}
+
+// checkPreconditions returns descriptions of the preconditions, that aren't met
+func checkPreconditions(ctx context.Context, data *preconditionData, w *databricks.WorkspaceClient,
+	c *common.DatabricksClient) ([]string, error) {
+	var unmet []string
+	if data.UnityCatalogEnabled {
+		assignment, err := w.Metastores.Current(ctx)
+		switch {
+		case apierr.IsMissing(err):
+			unmet = append(unmet, "Unity Catalog is not enabled: no metastore is assigned to the workspace")
+		case err != nil:
+			return nil, err
+		default:
+			data.MetastoreID = assignment.MetastoreId
+		}
+	}
+	if data.ServerlessSqlEnabled {
+		// the warehouses configuration of Go SDK doesn't have enable_serverless_compute yet
+		conf, err := sql.NewSqlGlobalConfigAPI(ctx, c).Get()
+		if err != nil {
+			return nil, err
+		}
+		if !conf.EnableServerlessCompute {
+			unmet = append(unmet, "serverless SQL warehouses are not enabled in the workspace")
+		}
+	}
+	if data.DatabricksSqlAccess {
+		ok, err := hasDatabricksSqlAccess(ctx, w)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			unmet = append(unmet, fmt.Sprintf("current user doesn't have the %s entitlement",
+				databricksSqlAccessEntitlement))
+		}
+	}
+	if len(data.WorkspaceConf) > 0 {
+		keys := make([]string, 0, len(data.WorkspaceConf))
+		for k := range data.WorkspaceConf {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		conf, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+			Keys: strings.Join(keys, ","),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			expected := data.WorkspaceConf[k]
+			actual := (*conf)[k]
+			if !strings.EqualFold(actual, expected) {
+				unmet = append(unmet, fmt.Sprintf("workspace configuration %s is %q, but %q is expected",
+					k, actual, expected))
+			}
+		}
+	}
+	return unmet, nil
+}
@@ -0,0 +1,146 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPreconditionData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockMetastoresAPI().EXPECT().Current(mock.Anything).Return(&catalog.MetastoreAssignment{
+				MetastoreId: "abc",
+			}, nil)
+			w.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{
+				Groups: []iam.ComplexValue{
+					{
+						Display: "users",
+						Value:   "123",
+					},
+					{
+						Display: "analysts",
+						Value:   "456",
+					},
+				},
+			}, nil)
+			w.GetMockGroupsAPI().EXPECT().ListAll(mock.Anything, iam.ListGroupsRequest{
+				Attributes: "id,entitlements",
+				Filter:     `id eq "123" or id eq "456"`,
+			}).Return([]iam.Group{
+				{
+					Id: "123",
+				},
+				{
+					Id: "456",
+					Entitlements: []iam.ComplexValue{
+						{
+							Value: "databricks-sql-access",
+						},
+					},
+				},
+			}, nil)
+			w.GetMockWorkspaceConfAPI().EXPECT().GetStatus(mock.Anything, settings.GetStatusRequest{
+				Keys: "enableIpAccessLists,enableTokensConfig",
+			}).Return(&map[string]string{
+				"enableIpAccessLists": "true",
+				"enableTokensConfig":  "TRUE",
+			}, nil)
+		},
+		Resource: DataSourcePrecondition(),
+		HCL: `
+		unity_catalog_enabled = true
+		databricks_sql_access = true
+		workspace_conf = {
+			enableIpAccessLists = "true"
+			enableTokensConfig = "true"
+		}
+		`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"metastore_id": "abc",
+	})
+}
+
+func TestPreconditionData_NotMet(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockMetastoresAPI().EXPECT().Current(mock.Anything).
+				Return(nil, apierr.NotFound("No metastore assigned for the current workspace."))
+			w.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{}, nil)
+			w.GetMockWorkspaceConfAPI().EXPECT().GetStatus(mock.Anything, settings.GetStatusRequest{
+				Keys: "enableIpAccessLists",
+			}).Return(&map[string]string{
+				"enableIpAccessLists": "false",
+			}, nil)
+		},
+		Resource: DataSourcePrecondition(),
+		HCL: `
+		unity_catalog_enabled = true
+		databricks_sql_access = true
+		workspace_conf = {
+			enableIpAccessLists = "true"
+		}
+		`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "workspace preconditions are not met: "+
+		"Unity Catalog is not enabled: no metastore is assigned to the workspace; "+
+		"current user doesn't have the databricks-sql-access entitlement; "+
+		"workspace configuration enableIpAccessLists is \"false\", but \"true\" is expected")
+}
+
+func TestPreconditionData_ServerlessSql(t *testing.T) {
+	for enabled, expectedErr := range map[bool]string{
+		true:  "",
+		false: "workspace preconditions are not met: serverless SQL warehouses are not enabled in the workspace",
+	} {
+		_, err := qa.ResourceFixture{
+			Fixtures: []qa.HTTPFixture{
+				{
+					Method:   "GET",
+					Resource: "/api/2.0/sql/config/warehouses",
+					Response: map[string]any{
+						"security_policy":           "DATA_ACCESS_CONTROL",
+						"enable_serverless_compute": enabled,
+					},
+				},
+			},
+			Resource: DataSourcePrecondition(),
+			HCL: `
+			serverless_sql_enabled = true
+			`,
+			Read:        true,
+			NonWritable: true,
+			ID:          "_",
+		}.Apply(t)
+		if expectedErr == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, expectedErr)
+		}
+	}
+}
+
+func TestPreconditionData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: qa.HTTPFailures,
+		Resource: DataSourcePrecondition(),
+		HCL: `
+		unity_catalog_enabled = true
+		`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
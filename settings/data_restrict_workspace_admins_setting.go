@@ -0,0 +1,29 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataSourceRestrictWorkspaceAdminsSetting reports the current status of the setting, so that
+// compliance of workspaces could be audited without managing the setting itself
+func DataSourceRestrictWorkspaceAdminsSetting() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Status     string `json:"status,omitempty" tf:"computed"`
+		Restricted bool   `json:"restricted,omitempty" tf:"computed"`
+		Etag       string `json:"etag,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		setting, err := restrictWsAdminsSetting.Read(ctx, w, "")
+		if err != nil {
+			return err
+		}
+		data.Status = string(setting.RestrictWorkspaceAdmins.Status)
+		data.Restricted = setting.RestrictWorkspaceAdmins.Status ==
+			settings.RestrictWorkspaceAdminsMessageStatusRestrictTokensAndJobRunAs
+		data.Etag = setting.Etag
+		return nil
+	})
+}
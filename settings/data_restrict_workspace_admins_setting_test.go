@@ -0,0 +1,72 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataSourceRestrictWorkspaceAdminsSetting(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockRestrictWorkspaceAdminsAPI().EXPECT().Get(mock.Anything, settings.GetRestrictWorkspaceAdminRequest{}).
+				Return(&settings.RestrictWorkspaceAdminsSetting{
+					Etag: "etag1",
+					RestrictWorkspaceAdmins: settings.RestrictWorkspaceAdminsMessage{
+						Status: "RESTRICT_TOKENS_AND_JOB_RUN_AS",
+					},
+					SettingName: "default",
+				}, nil)
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceRestrictWorkspaceAdminsSetting(),
+		ID:          ".",
+	}.ApplyAndExpectData(t, map[string]any{
+		"status":     "RESTRICT_TOKENS_AND_JOB_RUN_AS",
+		"restricted": true,
+		"etag":       "etag1",
+	})
+}
+
+func TestDataSourceRestrictWorkspaceAdminsSettingAllowAll(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockRestrictWorkspaceAdminsAPI().EXPECT().Get(mock.Anything, settings.GetRestrictWorkspaceAdminRequest{}).
+				Return(&settings.RestrictWorkspaceAdminsSetting{
+					Etag: "etag1",
+					RestrictWorkspaceAdmins: settings.RestrictWorkspaceAdminsMessage{
+						Status: "ALLOW_ALL",
+					},
+				}, nil)
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceRestrictWorkspaceAdminsSetting(),
+		ID:          ".",
+	}.ApplyAndExpectData(t, map[string]any{
+		"status":     "ALLOW_ALL",
+		"restricted": false,
+	})
+}
+
+func TestDataSourceRestrictWorkspaceAdminsSettingError(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockRestrictWorkspaceAdminsAPI().EXPECT().Get(mock.Anything, settings.GetRestrictWorkspaceAdminRequest{}).
+				Return(nil, &apierr.APIError{
+					ErrorCode:  "PERMISSION_DENIED",
+					StatusCode: 403,
+					Message:    "Only admins can access the setting",
+				})
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceRestrictWorkspaceAdminsSetting(),
+		ID:          ".",
+	}.ExpectError(t, "Only admins can access the setting")
+}
@@ -253,6 +253,7 @@ func makeSettingResource[T, U any](defn genericSettingDefinition[T, U]) common.R
 		return nil
 	}
 
+	_, isAccountSetting := defn.(accountSettingDefinition[T])
 	return common.Resource{
 		Schema: resourceSchema,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -339,5 +340,7 @@ func makeSettingResource[T, U any](defn genericSettingDefinition[T, U]) common.R
 			d.Set(etagAttrName, etag)
 			return nil
 		},
+		AccountLevel:   isAccountSetting,
+		WorkspaceLevel: !isAccountSetting,
 	}
 }
@@ -0,0 +1,168 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NotificationDestination is a target for notifications of alerts and jobs
+type NotificationDestination struct {
+	ID              string                         `json:"id,omitempty" tf:"computed"`
+	DisplayName     string                         `json:"display_name"`
+	DestinationType string                         `json:"destination_type,omitempty" tf:"computed"`
+	Config          *NotificationDestinationConfig `json:"config"`
+}
+
+// NotificationDestinationConfig holds exactly one of the destination types
+type NotificationDestinationConfig struct {
+	Email          *EmailConfig          `json:"email,omitempty"`
+	Slack          *SlackConfig          `json:"slack,omitempty"`
+	MicrosoftTeams *MicrosoftTeamsConfig `json:"microsoft_teams,omitempty"`
+	PagerDuty      *PagerDutyConfig      `json:"pagerduty,omitempty"`
+	GenericWebhook *GenericWebhookConfig `json:"generic_webhook,omitempty"`
+}
+
+type EmailConfig struct {
+	Addresses []string `json:"addresses"`
+}
+
+type SlackConfig struct {
+	URL string `json:"url" tf:"sensitive"`
+}
+
+type MicrosoftTeamsConfig struct {
+	URL string `json:"url" tf:"sensitive"`
+}
+
+type PagerDutyConfig struct {
+	IntegrationKey string `json:"integration_key" tf:"sensitive"`
+}
+
+type GenericWebhookConfig struct {
+	URL      string `json:"url" tf:"sensitive"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty" tf:"sensitive"`
+}
+
+var notificationDestinationTypes = []string{"email", "slack", "microsoft_teams", "pagerduty", "generic_webhook"}
+
+// Platform never returns URLs, keys and passwords of destinations, so we keep them from the state
+func (c *NotificationDestinationConfig) preserveSecrets(old *NotificationDestinationConfig) {
+	if c == nil || old == nil {
+		return
+	}
+	if c.Slack != nil && old.Slack != nil {
+		c.Slack.URL = old.Slack.URL
+	}
+	if c.MicrosoftTeams != nil && old.MicrosoftTeams != nil {
+		c.MicrosoftTeams.URL = old.MicrosoftTeams.URL
+	}
+	if c.PagerDuty != nil && old.PagerDuty != nil {
+		c.PagerDuty.IntegrationKey = old.PagerDuty.IntegrationKey
+	}
+	if c.GenericWebhook != nil && old.GenericWebhook != nil {
+		c.GenericWebhook.URL = old.GenericWebhook.URL
+		c.GenericWebhook.Password = old.GenericWebhook.Password
+	}
+}
+
+// NewNotificationDestinationsAPI creates NotificationDestinationsAPI instance from provider meta
+func NewNotificationDestinationsAPI(ctx context.Context, m any) NotificationDestinationsAPI {
+	return NotificationDestinationsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// NotificationDestinationsAPI exposes the Notification Destinations API
+type NotificationDestinationsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Create creates a notification destination
+func (a NotificationDestinationsAPI) Create(nd NotificationDestination) (res NotificationDestination, err error) {
+	err = a.client.Post(a.context, "/notification-destinations", nd, &res)
+	return
+}
+
+// Read returns a notification destination by its id
+func (a NotificationDestinationsAPI) Read(id string) (res NotificationDestination, err error) {
+	err = a.client.Get(a.context, fmt.Sprintf("/notification-destinations/%s", id), nil, &res)
+	return
+}
+
+// Update changes display name or configuration of a notification destination
+func (a NotificationDestinationsAPI) Update(id string, nd NotificationDestination) error {
+	nd.ID = ""
+	nd.DestinationType = ""
+	return a.client.Patch(a.context, fmt.Sprintf("/notification-destinations/%s", id), nd)
+}
+
+// Delete removes a notification destination
+func (a NotificationDestinationsAPI) Delete(id string) error {
+	return a.client.Delete(a.context, fmt.Sprintf("/notification-destinations/%s", id), nil)
+}
+
+// ResourceNotificationDestination manages notification destinations for alerts and jobs
+func ResourceNotificationDestination() common.Resource {
+	s := common.StructToSchema(NotificationDestination{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		config := m["config"].Elem.(*schema.Resource)
+		exactlyOneOf := []string{}
+		for _, t := range notificationDestinationTypes {
+			exactlyOneOf = append(exactlyOneOf, "config.0."+t)
+		}
+		for _, t := range notificationDestinationTypes {
+			config.Schema[t].ExactlyOneOf = exactlyOneOf
+		}
+		common.MustSchemaPath(m, "config", "email", "addresses").MinItems = 1
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			// type of the destination cannot be changed after it's created
+			for _, t := range notificationDestinationTypes {
+				key := "config.0." + t
+				if !d.HasChange(key) {
+					continue
+				}
+				old, new := d.GetChange(key)
+				// on creation there is no previous type, so nothing could be removed
+				if len(old.([]any)) > 0 && len(new.([]any)) == 0 {
+					return d.ForceNew(key)
+				}
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var nd NotificationDestination
+			common.DataToStructPointer(d, s, &nd)
+			created, err := NewNotificationDestinationsAPI(ctx, c).Create(nd)
+			if err != nil {
+				return err
+			}
+			d.SetId(created.ID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var old NotificationDestination
+			common.DataToStructPointer(d, s, &old)
+			nd, err := NewNotificationDestinationsAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			nd.Config.preserveSecrets(old.Config)
+			return common.StructToData(nd, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var nd NotificationDestination
+			common.DataToStructPointer(d, s, &nd)
+			return NewNotificationDestinationsAPI(ctx, c).Update(d.Id(), nd)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewNotificationDestinationsAPI(ctx, c).Delete(d.Id())
+		},
+		WorkspaceLevel: true,
+	}
+}
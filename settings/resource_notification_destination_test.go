@@ -0,0 +1,247 @@
+package settings
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationDestinationCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceNotificationDestination())
+}
+
+func TestNotificationDestinationCreate_Slack(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/notification-destinations",
+				ExpectedRequest: NotificationDestination{
+					DisplayName: "alerts",
+					Config: &NotificationDestinationConfig{
+						Slack: &SlackConfig{
+							URL: "https://hooks.slack.com/services/x",
+						},
+					},
+				},
+				Response: NotificationDestination{
+					ID: "abc",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: NotificationDestination{
+					ID:              "abc",
+					DisplayName:     "alerts",
+					DestinationType: "SLACK",
+					Config: &NotificationDestinationConfig{
+						Slack: &SlackConfig{},
+					},
+				},
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Create:   true,
+		HCL: `
+		display_name = "alerts"
+		config {
+			slack {
+				url = "https://hooks.slack.com/services/x"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                   "abc",
+		"destination_type":     "SLACK",
+		"config.0.slack.0.url": "https://hooks.slack.com/services/x",
+		"config.0.email.#":     0,
+		"config.0.pagerduty.#": 0,
+		"config.0.slack.#":     1,
+	})
+}
+
+func TestNotificationDestinationCreate_MultipleTypes(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceNotificationDestination(),
+		Create:   true,
+		HCL: `
+		display_name = "alerts"
+		config {
+			slack {
+				url = "https://hooks.slack.com/services/x"
+			}
+			email {
+				addresses = ["a@example.com"]
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[config.#.email] Invalid combination of arguments. "+
+		"[config.#.generic_webhook] Invalid combination of arguments. "+
+		"[config.#.microsoft_teams] Invalid combination of arguments. "+
+		"[config.#.pagerduty] Invalid combination of arguments. "+
+		"[config.#.slack] Invalid combination of arguments")
+}
+
+func TestNotificationDestinationRead_Email(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: NotificationDestination{
+					ID:              "abc",
+					DisplayName:     "oncall",
+					DestinationType: "EMAIL",
+					Config: &NotificationDestinationConfig{
+						Email: &EmailConfig{
+							Addresses: []string{"oncall@example.com"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"display_name":               "oncall",
+		"destination_type":           "EMAIL",
+		"config.0.email.0.addresses": []any{"oncall@example.com"},
+	})
+}
+
+func TestNotificationDestinationRead_PreservesSecrets(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: NotificationDestination{
+					ID:              "abc",
+					DisplayName:     "webhook",
+					DestinationType: "WEBHOOK",
+					Config: &NotificationDestinationConfig{
+						GenericWebhook: &GenericWebhookConfig{
+							Username: "bot",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Read:     true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name":                        "webhook",
+			"config.#":                            "1",
+			"config.0.generic_webhook.#":          "1",
+			"config.0.generic_webhook.0.url":      "https://example.com/hook",
+			"config.0.generic_webhook.0.username": "bot",
+			"config.0.generic_webhook.0.password": "secret",
+		},
+		HCL: `
+		display_name = "webhook"
+		config {
+			generic_webhook {
+				url      = "https://example.com/hook"
+				username = "bot"
+				password = "secret"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"config.0.generic_webhook.0.url":      "https://example.com/hook",
+		"config.0.generic_webhook.0.username": "bot",
+		"config.0.generic_webhook.0.password": "secret",
+	})
+}
+
+func TestNotificationDestinationUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/notification-destinations/abc",
+				ExpectedRequest: NotificationDestination{
+					DisplayName: "pd",
+					Config: &NotificationDestinationConfig{
+						PagerDuty: &PagerDutyConfig{
+							IntegrationKey: "new",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: NotificationDestination{
+					ID:              "abc",
+					DisplayName:     "pd",
+					DestinationType: "PAGERDUTY",
+					Config: &NotificationDestinationConfig{
+						PagerDuty: &PagerDutyConfig{},
+					},
+				},
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name":                         "pd",
+			"destination_type":                     "PAGERDUTY",
+			"config.#":                             "1",
+			"config.0.pagerduty.#":                 "1",
+			"config.0.pagerduty.0.integration_key": "old",
+		},
+		HCL: `
+		display_name = "pd"
+		config {
+			pagerduty {
+				integration_key = "new"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"config.0.pagerduty.0.integration_key": "new",
+	})
+}
+
+func TestNotificationDestinationUpdate_TypeChangeForcesNew(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceNotificationDestination(),
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name":                 "oncall",
+			"destination_type":             "EMAIL",
+			"config.#":                     "1",
+			"config.0.email.#":             "1",
+			"config.0.email.0.addresses.#": "1",
+			"config.0.email.0.addresses.0": "oncall@example.com",
+		},
+		HCL: `
+		display_name = "oncall"
+		config {
+			microsoft_teams {
+				url = "https://example.webhook.office.com/x"
+			}
+		}`,
+		Update: true,
+	}.Apply(t)
+	assert.ErrorContains(t, err, "changes require new")
+}
+
+func TestNotificationDestinationDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.0/notification-destinations/abc",
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Delete:   true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
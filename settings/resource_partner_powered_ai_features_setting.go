@@ -0,0 +1,76 @@
+package settings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/client"
+)
+
+// PartnerPoweredAiFeaturesSetting controls whether Databricks Assistant and other AI features of the
+// workspace may use models hosted by partners, like Azure OpenAI, in addition to Databricks-hosted models.
+// The Go SDK doesn't have this setting yet, so it's called through the generic settings API.
+type PartnerPoweredAiFeaturesSetting struct {
+	BooleanVal  BooleanMessage `json:"boolean_val"`
+	Etag        string         `json:"etag,omitempty"`
+	SettingName string         `json:"setting_name,omitempty"`
+}
+
+type BooleanMessage struct {
+	Value bool `json:"value"`
+}
+
+const partnerPoweredAiFeaturesPath = "/api/2.0/settings/types/llm_proxy_partner_powered_workspace/names/default"
+
+// Partner Powered AI Features Setting
+var partnerPoweredAiFeaturesSetting = workspaceSetting[PartnerPoweredAiFeaturesSetting]{
+	settingStruct: PartnerPoweredAiFeaturesSetting{},
+	readFunc: func(ctx context.Context, w *databricks.WorkspaceClient, etag string) (*PartnerPoweredAiFeaturesSetting, error) {
+		api, err := client.New(w.Config)
+		if err != nil {
+			return nil, err
+		}
+		var res PartnerPoweredAiFeaturesSetting
+		err = api.Do(ctx, http.MethodGet, partnerPoweredAiFeaturesPath, nil, map[string]string{
+			"etag": etag,
+		}, &res)
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
+	},
+	updateFunc: func(ctx context.Context, w *databricks.WorkspaceClient, t PartnerPoweredAiFeaturesSetting) (string, error) {
+		api, err := client.New(w.Config)
+		if err != nil {
+			return "", err
+		}
+		t.SettingName = "default"
+		var res PartnerPoweredAiFeaturesSetting
+		err = api.Do(ctx, http.MethodPatch, partnerPoweredAiFeaturesPath, nil, map[string]any{
+			"allow_missing": true,
+			"setting":       t,
+			"field_mask":    "boolean_val.value",
+		}, &res)
+		if err != nil {
+			return "", err
+		}
+		return res.Etag, nil
+	},
+	deleteFunc: func(ctx context.Context, w *databricks.WorkspaceClient, etag string) (string, error) {
+		api, err := client.New(w.Config)
+		if err != nil {
+			return "", err
+		}
+		var res struct {
+			Etag string `json:"etag"`
+		}
+		err = api.Do(ctx, http.MethodDelete, partnerPoweredAiFeaturesPath, nil, map[string]string{
+			"etag": etag,
+		}, &res)
+		if err != nil {
+			return "", err
+		}
+		return res.Etag, nil
+	},
+}
@@ -0,0 +1,130 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPartnerPoweredAiFeaturesSetting = AllSettingsResources()["partner_powered_ai_features"]
+
+func TestCreatePartnerPoweredAiFeaturesSetting(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: partnerPoweredAiFeaturesPath,
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask":    "boolean_val.value",
+					"setting": PartnerPoweredAiFeaturesSetting{
+						BooleanVal:  BooleanMessage{Value: false},
+						SettingName: "default",
+					},
+				},
+				Status: 404,
+				Response: map[string]any{
+					"error_code": "NOT_FOUND",
+					"message":    "SomeMessage",
+					"details": []map[string]any{
+						{
+							"@type":    "type.googleapis.com/google.rpc.ErrorInfo",
+							"metadata": map[string]string{etagAttrName: "etag1"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: partnerPoweredAiFeaturesPath,
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask":    "boolean_val.value",
+					"setting": PartnerPoweredAiFeaturesSetting{
+						BooleanVal:  BooleanMessage{Value: false},
+						Etag:        "etag1",
+						SettingName: "default",
+					},
+				},
+				Response: PartnerPoweredAiFeaturesSetting{
+					BooleanVal:  BooleanMessage{Value: false},
+					Etag:        "etag2",
+					SettingName: "default",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: partnerPoweredAiFeaturesPath + "?etag=etag2",
+				Response: PartnerPoweredAiFeaturesSetting{
+					BooleanVal:  BooleanMessage{Value: false},
+					Etag:        "etag2",
+					SettingName: "default",
+				},
+			},
+		},
+		Resource: testPartnerPoweredAiFeaturesSetting,
+		Create:   true,
+		HCL: `
+			boolean_val {
+				value = false
+			}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultSettingId, d.Id())
+	assert.Equal(t, "etag2", d.Get(etagAttrName))
+	assert.Equal(t, false, d.Get("boolean_val.0.value"))
+}
+
+func TestReadPartnerPoweredAiFeaturesSetting(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: partnerPoweredAiFeaturesPath + "?etag=etag1",
+				Response: PartnerPoweredAiFeaturesSetting{
+					BooleanVal:  BooleanMessage{Value: true},
+					Etag:        "etag2",
+					SettingName: "default",
+				},
+			},
+		},
+		Resource: testPartnerPoweredAiFeaturesSetting,
+		Read:     true,
+		HCL: `
+			boolean_val {
+				value = true
+			}
+			etag = "etag1"
+		`,
+		ID: defaultSettingId,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "etag2", d.Get(etagAttrName))
+	assert.Equal(t, true, d.Get("boolean_val.0.value"))
+}
+
+func TestDeletePartnerPoweredAiFeaturesSetting(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: partnerPoweredAiFeaturesPath + "?etag=etag1",
+				Response: map[string]string{
+					"etag": "etag2",
+				},
+			},
+		},
+		Resource: testPartnerPoweredAiFeaturesSetting,
+		Delete:   true,
+		ID:       defaultSettingId,
+		InstanceState: map[string]string{
+			etagAttrName:          "etag1",
+			"boolean_val.#":       "1",
+			"boolean_val.0.value": "false",
+		},
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "etag2", d.Get(etagAttrName))
+}
@@ -114,5 +114,6 @@ func ResourceRecipient() common.Resource {
 			}
 			return w.Recipients.DeleteByName(ctx, d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
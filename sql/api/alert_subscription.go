@@ -0,0 +1,20 @@
+package api
+
+// AlertSubscription ...
+type AlertSubscription struct {
+	// Alert subscriptions have integer IDs.
+	ID stringOrInt `json:"id,omitempty"`
+
+	AlertID       string `json:"alert_id,omitempty"`
+	DestinationID string `json:"destination_id,omitempty"`
+
+	// Subscriptions of users don't have a destination.
+	Destination *AlertSubscriptionDestination `json:"destination,omitempty"`
+}
+
+// AlertSubscriptionDestination ...
+type AlertSubscriptionDestination struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
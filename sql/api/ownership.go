@@ -0,0 +1,14 @@
+package api
+
+// Object types, which ownership can be transferred
+const (
+	OwnableObjectTypeAlert     = "alert"
+	OwnableObjectTypeDashboard = "dashboard"
+	OwnableObjectTypeQuery     = "query"
+)
+
+// TransferOwnership ...
+type TransferOwnership struct {
+	// Email of the new owner, who must exist in the workspace
+	NewOwner string `json:"new_owner"`
+}
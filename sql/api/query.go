@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 )
 
 // Query ...
@@ -21,6 +22,12 @@ type Query struct {
 	Parent         string            `json:"parent,omitempty"`
 	CreatedAt      string            `json:"created_at,omitempty"`
 	UpdatedAt      string            `json:"updated_at,omitempty"`
+	User           *User             `json:"user,omitempty"`
+}
+
+// User is the owner of the SQL object
+type User struct {
+	Email string `json:"email,omitempty"`
 }
 
 // QuerySchedule ...
@@ -47,6 +54,8 @@ type QuerySchedule struct {
 type QueryOptions struct {
 	Parameters    []any             `json:"-"`
 	RawParameters []json.RawMessage `json:"parameters,omitempty"`
+	Catalog       string            `json:"catalog,omitempty"`
+	Schema        string            `json:"schema,omitempty"`
 }
 
 // MarshalJSON ...
@@ -108,7 +117,14 @@ func (o *QueryOptions) UnmarshalJSON(b []byte) error {
 		case queryParameterDateTimeSecRangeTypeName:
 			i = &QueryParameterDateTimeSecRange{}
 		default:
-			panic("don't know what to do...")
+			// Keep parameters of types unknown to this version of the provider as-is,
+			// so that they can be sent back unchanged.
+			log.Printf("[WARN] Unsupported type %#v of query parameter %#v, keeping it unchanged", qp.Type, qp.Name)
+			o.Parameters = append(o.Parameters, &QueryParameterUnknown{
+				QueryParameter: qp,
+				Raw:            rp,
+			})
+			continue
 		}
 
 		// Unmarshal into correct parameter type.
@@ -144,6 +160,21 @@ const (
 	queryParameterDateTimeSecRangeTypeName = "datetime-range-with-seconds"
 )
 
+// QueryParameterUnknown holds a parameter of a type that isn't supported by the provider.
+type QueryParameterUnknown struct {
+	QueryParameter
+
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON returns the parameter exactly as it was received from the API.
+func (p QueryParameterUnknown) MarshalJSON() ([]byte, error) {
+	if p.Raw == nil {
+		return json.Marshal(p.QueryParameter)
+	}
+	return p.Raw, nil
+}
+
 // QueryParameterText ...
 type QueryParameterText struct {
 	QueryParameter
@@ -409,25 +440,65 @@ type DateTimeRange struct {
 	End   string `json:"end"`
 }
 
+// DynamicDateRanges are keywords of relative date ranges, that are resolved at the query execution time.
+var DynamicDateRanges = []string{
+	"d_today",
+	"d_yesterday",
+	"d_this_week",
+	"d_this_month",
+	"d_this_year",
+	"d_last_week",
+	"d_last_month",
+	"d_last_year",
+	"d_last_7_days",
+	"d_last_14_days",
+	"d_last_30_days",
+	"d_last_60_days",
+	"d_last_90_days",
+	"d_last_12_months",
+}
+
+// IsDynamicDateRange returns true if the value is one of the dynamic date range keywords
+func IsDynamicDateRange(value string) bool {
+	for _, v := range DynamicDateRanges {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 type QueryParameterRangeBase struct {
 	QueryParameter
 
-	Value       any            `json:"value"`
-	StringValue string         `json:"-"`
-	RangeValue  *DateTimeRange `json:"-"`
+	Value        any            `json:"value"`
+	StringValue  string         `json:"-"`
+	RangeValue   *DateTimeRange `json:"-"`
+	DynamicValue string         `json:"-"`
 }
 
 func (p *QueryParameterRangeBase) toParameterObject() {
-	if p.RangeValue != nil {
+	switch {
+	case p.DynamicValue != "":
+		p.Value = p.DynamicValue
+	case p.RangeValue != nil:
 		p.Value = p.RangeValue
-	} else {
+	default:
 		p.Value = p.StringValue
 	}
 }
 
 func (p *QueryParameterRangeBase) decodeQueryParameter() {
 	if v, ok := p.Value.(map[string]any); ok {
-		p.RangeValue = &DateTimeRange{Start: v["start"].(string), End: v["end"].(string)}
+		p.RangeValue = &DateTimeRange{}
+		if start, ok := v["start"].(string); ok {
+			p.RangeValue.Start = start
+		}
+		if end, ok := v["end"].(string); ok {
+			p.RangeValue.End = end
+		}
+	} else if v, ok := p.Value.(string); ok && IsDynamicDateRange(v) {
+		p.DynamicValue = v
 	} else {
 		p.StringValue = fmt.Sprintf("%v", p.Value)
 	}
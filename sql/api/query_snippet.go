@@ -0,0 +1,11 @@
+package api
+
+// QuerySnippet ...
+type QuerySnippet struct {
+	// Query snippets have integer IDs.
+	ID stringOrInt `json:"id,omitempty"`
+
+	Trigger     string `json:"trigger"`
+	Description string `json:"description"`
+	Snippet     string `json:"snippet"`
+}
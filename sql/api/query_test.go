@@ -181,3 +181,65 @@ func TestQueryMarshalUnmarshalRanges(t *testing.T) {
 
 	assert.Equal(t, q, qp)
 }
+
+func TestQueryMarshalUnmarshalDynamicRanges(t *testing.T) {
+	q := Query{
+		ID:           "id",
+		DataSourceID: "data_source_id",
+		Name:         "name",
+		Query:        "SELECT 1",
+		Options: &QueryOptions{
+			Parameters: []any{
+				&QueryParameterDateRange{
+					QueryParameterRangeBase: QueryParameterRangeBase{
+						QueryParameter: QueryParameter{
+							Name: "n14",
+						},
+						DynamicValue: "d_last_week",
+					},
+				},
+				&QueryParameterDateTimeRange{
+					QueryParameterRangeBase: QueryParameterRangeBase{
+						QueryParameter: QueryParameter{
+							Name: "n15",
+						},
+						DynamicValue: "d_this_month",
+					},
+				},
+			},
+		},
+	}
+
+	out, err := json.Marshal(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, string(out), `"value":"d_last_week"`)
+	assert.Contains(t, string(out), `"value":"d_this_month"`)
+
+	var qp Query
+	if err := json.Unmarshal(out, &qp); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, q, qp)
+}
+
+func TestQueryUnmarshalUnknownParameterType(t *testing.T) {
+	raw := `{"options":{"parameters":[{"name":"p","title":"P","type":"new-type","value":{"x":1}},{"name":"t","type":"text","value":"v"}]}}`
+	var q Query
+	err := json.Unmarshal([]byte(raw), &q)
+	assert.NoError(t, err)
+	assert.Len(t, q.Options.Parameters, 2)
+
+	unknown, ok := q.Options.Parameters[0].(*QueryParameterUnknown)
+	assert.True(t, ok)
+	assert.Equal(t, "p", unknown.Name)
+	assert.Equal(t, "new-type", unknown.Type)
+
+	// Unknown parameters are sent back unchanged.
+	b, err := json.Marshal(q.Options)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"parameters":[{"name":"p","title":"P","type":"new-type","value":{"x":1}},{"name":"t","type":"text","value":"v"}]}`, string(b))
+}
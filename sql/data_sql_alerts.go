@@ -0,0 +1,48 @@
+package sql
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type alertEntry struct {
+	ID              string `json:"id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	State           string `json:"state,omitempty"`
+	QueryID         string `json:"query_id,omitempty"`
+	Parent          string `json:"parent,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	LastTriggeredAt string `json:"last_triggered_at,omitempty"`
+}
+
+func DataSourceAlerts() common.Resource {
+	type alertsData struct {
+		Alerts []alertEntry `json:"alerts,omitempty" tf:"computed"`
+	}
+	return common.WorkspaceData(func(ctx context.Context, data *alertsData, w *databricks.WorkspaceClient) error {
+		alerts, err := w.Alerts.List(ctx)
+		if err != nil {
+			return err
+		}
+		data.Alerts = nil
+		for _, a := range alerts {
+			entry := alertEntry{
+				ID:              a.Id,
+				Name:            a.Name,
+				State:           string(a.State),
+				Parent:          a.Parent,
+				LastTriggeredAt: a.LastTriggeredAt,
+			}
+			if a.Query != nil {
+				entry.QueryID = a.Query.Id
+			}
+			if a.User != nil {
+				entry.Owner = a.User.Email
+			}
+			data.Alerts = append(data.Alerts, entry)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,61 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAlertsData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockAlertsAPI().EXPECT().List(mock.Anything).Return([]sql.Alert{
+				{
+					Id:              "a",
+					Name:            "Revenue dropped",
+					State:           sql.AlertStateTriggered,
+					LastTriggeredAt: "2024-01-01T00:00:00Z",
+					Query: &sql.AlertQuery{
+						Id: "q1",
+					},
+					User: &sql.User{
+						Email: "me@example.com",
+					},
+				},
+				{
+					Id:    "b",
+					Name:  "Without query",
+					State: sql.AlertStateUnknown,
+				},
+			}, nil)
+		},
+		Resource:    DataSourceAlerts(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"alerts.#":                   2,
+		"alerts.0.id":                "a",
+		"alerts.0.name":              "Revenue dropped",
+		"alerts.0.state":             "triggered",
+		"alerts.0.query_id":          "q1",
+		"alerts.0.owner":             "me@example.com",
+		"alerts.0.last_triggered_at": "2024-01-01T00:00:00Z",
+		"alerts.1.id":                "b",
+		"alerts.1.state":             "unknown",
+		"alerts.1.query_id":          "",
+	})
+}
+
+func TestAlertsData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceAlerts(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,58 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// number of queries fetched per page when listing all queries in the workspace
+const queriesListPageSize = 100
+
+func DataSourceQueries() common.Resource {
+	type queriesData struct {
+		Search string            `json:"search,omitempty"`
+		Tags   []string          `json:"tags,omitempty"`
+		Owner  string            `json:"owner,omitempty"`
+		Ids    map[string]string `json:"ids,omitempty" tf:"computed"`
+	}
+	return common.WorkspaceData(func(ctx context.Context, data *queriesData, w *databricks.WorkspaceClient) error {
+		// the iterator takes care of pagination and of deduplication of results between pages
+		it := w.Queries.List(ctx, sql.ListQueriesRequest{
+			Q:        data.Search,
+			PageSize: queriesListPageSize,
+		})
+		data.Ids = map[string]string{}
+		for it.HasNext(ctx) {
+			q, err := it.Next(ctx)
+			if err != nil {
+				return err
+			}
+			if data.Owner != "" && (q.User == nil || q.User.Email != data.Owner) {
+				continue
+			}
+			if !hasAllTags(q.Tags, data.Tags) {
+				continue
+			}
+			if id, duplicateName := data.Ids[q.Name]; duplicateName {
+				return fmt.Errorf("duplicate query name detected: %s (ids: %s, %s). "+
+					"Use search, tags or owner to narrow down the queries", q.Name, id, q.Id)
+			}
+			data.Ids[q.Name] = q.Id
+		}
+		return nil
+	})
+}
+
+func hasAllTags(tags []string, required []string) bool {
+	for _, tag := range required {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQueriesData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			it := listing.SliceIterator[sql.Query]([]sql.Query{
+				{
+					Id:   "a",
+					Name: "First",
+					Tags: []string{"finance", "daily"},
+					User: &sql.User{Email: "me@example.com"},
+				},
+				{
+					Id:   "b",
+					Name: "Second",
+					Tags: []string{"finance"},
+					User: &sql.User{Email: "me@example.com"},
+				},
+				{
+					Id:   "c",
+					Name: "Third",
+					Tags: []string{"finance", "daily"},
+					User: &sql.User{Email: "other@example.com"},
+				},
+			})
+			w.GetMockQueriesAPI().EXPECT().
+				List(mock.Anything, sql.ListQueriesRequest{
+					Q:        "revenue",
+					PageSize: queriesListPageSize,
+				}).
+				Return(&it)
+		},
+		Resource: DataSourceQueries(),
+		HCL: `
+		search = "revenue"
+		tags = ["daily"]
+		owner = "me@example.com"
+		`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"First": "a",
+		},
+	})
+}
+
+func TestQueriesData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceQueries(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
+
+func TestQueriesData_DuplicateName(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			it := listing.SliceIterator[sql.Query]([]sql.Query{
+				{
+					Id:   "a",
+					Name: "Revenue",
+				},
+				{
+					Id:   "b",
+					Name: "Revenue",
+				},
+			})
+			w.GetMockQueriesAPI().EXPECT().
+				List(mock.Anything, sql.ListQueriesRequest{
+					PageSize: queriesListPageSize,
+				}).
+				Return(&it)
+		},
+		Resource:    DataSourceQueries(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "duplicate query name detected: Revenue (ids: a, b). "+
+		"Use search, tags or owner to narrow down the queries")
+}
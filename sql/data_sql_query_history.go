@@ -0,0 +1,83 @@
+package sql
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// by default we return only the most recent page of queries
+const defaultQueryHistoryMaxResults = 100
+
+type queryHistoryEntry struct {
+	QueryID          string `json:"query_id,omitempty"`
+	QueryText        string `json:"query_text,omitempty"`
+	Status           string `json:"status,omitempty"`
+	StatementType    string `json:"statement_type,omitempty"`
+	WarehouseID      string `json:"warehouse_id,omitempty"`
+	UserID           int    `json:"user_id,omitempty"`
+	UserName         string `json:"user_name,omitempty"`
+	QueryStartTimeMs int    `json:"query_start_time_ms,omitempty"`
+	QueryEndTimeMs   int    `json:"query_end_time_ms,omitempty"`
+	Duration         int    `json:"duration,omitempty"`
+	RowsProduced     int    `json:"rows_produced,omitempty"`
+	ErrorMessage     string `json:"error_message,omitempty"`
+}
+
+func DataSourceQueryHistory() common.Resource {
+	type queryHistoryData struct {
+		WarehouseIDs []string            `json:"warehouse_ids,omitempty"`
+		UserIDs      []int               `json:"user_ids,omitempty"`
+		Statuses     []string            `json:"statuses,omitempty"`
+		StartTimeMs  int                 `json:"start_time_ms,omitempty"`
+		EndTimeMs    int                 `json:"end_time_ms,omitempty"`
+		MaxResults   int                 `json:"max_results,omitempty"`
+		Queries      []queryHistoryEntry `json:"queries,omitempty" tf:"computed"`
+	}
+	return common.WorkspaceData(func(ctx context.Context, data *queryHistoryData, w *databricks.WorkspaceClient) error {
+		filter := &sql.QueryFilter{
+			WarehouseIds: data.WarehouseIDs,
+			UserIds:      data.UserIDs,
+		}
+		for _, status := range data.Statuses {
+			filter.Statuses = append(filter.Statuses, sql.QueryStatus(status))
+		}
+		if data.StartTimeMs != 0 || data.EndTimeMs != 0 {
+			filter.QueryStartTimeRange = &sql.TimeRange{
+				StartTimeMs: data.StartTimeMs,
+				EndTimeMs:   data.EndTimeMs,
+			}
+		}
+		maxResults := data.MaxResults
+		if maxResults == 0 {
+			maxResults = defaultQueryHistoryMaxResults
+		}
+		queries, err := listing.ToSliceN(ctx, w.QueryHistory.List(ctx, sql.ListQueryHistoryRequest{
+			FilterBy: filter,
+		}), maxResults)
+		if err != nil {
+			return err
+		}
+		data.Queries = nil
+		for _, q := range queries {
+			data.Queries = append(data.Queries, queryHistoryEntry{
+				QueryID:          q.QueryId,
+				QueryText:        q.QueryText,
+				Status:           string(q.Status),
+				StatementType:    string(q.StatementType),
+				WarehouseID:      q.WarehouseId,
+				UserID:           q.UserId,
+				UserName:         q.UserName,
+				QueryStartTimeMs: q.QueryStartTimeMs,
+				QueryEndTimeMs:   q.QueryEndTimeMs,
+				Duration:         q.Duration,
+				RowsProduced:     q.RowsProduced,
+				ErrorMessage:     q.ErrorMessage,
+			})
+		}
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQueryHistoryData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			it := listing.SliceIterator[sql.QueryInfo]([]sql.QueryInfo{
+				{
+					QueryId:          "a",
+					QueryText:        "SELECT 1",
+					Status:           sql.QueryStatusFailed,
+					WarehouseId:      "abc",
+					UserId:           123,
+					UserName:         "me@example.com",
+					QueryStartTimeMs: 1700000000000,
+					ErrorMessage:     "boom",
+				},
+				{
+					QueryId: "b",
+					Status:  sql.QueryStatusFailed,
+				},
+			})
+			w.GetMockQueryHistoryAPI().EXPECT().
+				List(mock.Anything, sql.ListQueryHistoryRequest{
+					FilterBy: &sql.QueryFilter{
+						WarehouseIds: []string{"abc"},
+						UserIds:      []int{123},
+						Statuses:     []sql.QueryStatus{sql.QueryStatusFailed},
+						QueryStartTimeRange: &sql.TimeRange{
+							StartTimeMs: 1700000000000,
+						},
+					},
+				}).
+				Return(&it)
+		},
+		Resource: DataSourceQueryHistory(),
+		HCL: `
+		warehouse_ids = ["abc"]
+		user_ids = [123]
+		statuses = ["FAILED"]
+		start_time_ms = 1700000000000
+		max_results = 1`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"queries.#":                     1,
+		"queries.0.query_id":            "a",
+		"queries.0.status":              "FAILED",
+		"queries.0.user_name":           "me@example.com",
+		"queries.0.query_start_time_ms": 1700000000000,
+		"queries.0.error_message":       "boom",
+	})
+}
+
+func TestQueryHistoryData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceQueryHistory(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -3,6 +3,7 @@ package sql
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/sql"
@@ -43,7 +44,12 @@ func DataSourceWarehouse() common.Resource {
 		}
 		if len(selected) > 1 {
 			if data.Name != "" {
-				return nil, fmt.Errorf("there are multiple SQL warehouses with the name '%s'", data.Name)
+				ids := []string{}
+				for _, source := range selected {
+					ids = append(ids, source.WarehouseId)
+				}
+				return nil, fmt.Errorf("there are multiple SQL warehouses with the name '%s': %s. Please specify 'id' instead",
+					data.Name, strings.Join(ids, ", "))
 			} else {
 				return nil, fmt.Errorf("there are multiple SQL warehouses with the ID '%s'", data.Id)
 			}
@@ -106,7 +106,7 @@ func TestWarehouseDataByName_DuplicatesError(t *testing.T) {
 		NonWritable: true,
 		HCL:         `name = "abc"`,
 		ID:          "_",
-	}.ExpectError(t, "there are multiple SQL warehouses with the name 'abc'")
+	}.ExpectError(t, "there are multiple SQL warehouses with the name 'abc': def, abc. Please specify 'id' instead")
 }
 
 func TestWarehouseDataByName(t *testing.T) {
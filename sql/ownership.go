@@ -0,0 +1,27 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/sql/api"
+)
+
+// NewOwnershipAPI ...
+func NewOwnershipAPI(ctx context.Context, m any) OwnershipAPI {
+	return OwnershipAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// OwnershipAPI changes owners of alerts, dashboards and queries. The Go SDK can't be used for it,
+// because it doesn't send the object ID properly.
+type OwnershipAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Transfer makes the user with the given email the owner of the object
+func (a OwnershipAPI) Transfer(objectType, objectID, newOwner string) error {
+	return a.client.Post(a.context, fmt.Sprintf("/preview/sql/permissions/%s/%s/transfer", objectType, objectID),
+		api.TransferOwnership{NewOwner: newOwner}, nil)
+}
@@ -0,0 +1,106 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/sql/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// QuerySnippetEntity defines the parameters that can be set in the resource.
+type QuerySnippetEntity struct {
+	Trigger     string `json:"trigger"`
+	Description string `json:"description,omitempty"`
+	Snippet     string `json:"snippet"`
+}
+
+// NewQuerySnippetsAPI ...
+func NewQuerySnippetsAPI(ctx context.Context, m any) QuerySnippetsAPI {
+	return QuerySnippetsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// QuerySnippetsAPI ...
+type QuerySnippetsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Create ...
+func (a QuerySnippetsAPI) Create(qs *api.QuerySnippet) error {
+	return a.client.Post(a.context, "/preview/sql/query_snippets", qs, qs)
+}
+
+// Read ...
+func (a QuerySnippetsAPI) Read(snippetID string) (*api.QuerySnippet, error) {
+	var qs api.QuerySnippet
+	err := a.client.Get(a.context, fmt.Sprintf("/preview/sql/query_snippets/%s", snippetID), nil, &qs)
+	if err != nil {
+		return nil, err
+	}
+	return &qs, nil
+}
+
+// Update ...
+func (a QuerySnippetsAPI) Update(snippetID string, qs *api.QuerySnippet) error {
+	return a.client.Post(a.context, fmt.Sprintf("/preview/sql/query_snippets/%s", snippetID), qs, nil)
+}
+
+// Delete ...
+func (a QuerySnippetsAPI) Delete(snippetID string) error {
+	return a.client.Delete(a.context, fmt.Sprintf("/preview/sql/query_snippets/%s", snippetID), nil)
+}
+
+func ResourceQuerySnippet() common.Resource {
+	s := common.StructToSchema(QuerySnippetEntity{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		// the SQL editor inserts the snippet when the trigger is typed as a single word
+		m["trigger"].ValidateFunc = validation.StringMatch(regexp.MustCompile(`^\S+$`),
+			"must be a single word without whitespace")
+		m["snippet"].ValidateFunc = validation.StringIsNotWhiteSpace
+		return m
+	})
+	toAPIObject := func(d *schema.ResourceData) *api.QuerySnippet {
+		var qs QuerySnippetEntity
+		common.DataToStructPointer(d, s, &qs)
+		return &api.QuerySnippet{
+			Trigger:     qs.Trigger,
+			Description: qs.Description,
+			Snippet:     qs.Snippet,
+		}
+	}
+	return common.Resource{
+		WorkspaceLevel: true,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			qs := toAPIObject(d)
+			err := NewQuerySnippetsAPI(ctx, c).Create(qs)
+			if err != nil {
+				return err
+			}
+			d.SetId(qs.ID.String())
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			qs, err := NewQuerySnippetsAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			// description is set explicitly, so that its removal outside of Terraform is detected
+			d.Set("description", qs.Description)
+			return common.StructToData(QuerySnippetEntity{
+				Trigger:     qs.Trigger,
+				Description: qs.Description,
+				Snippet:     qs.Snippet,
+			}, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewQuerySnippetsAPI(ctx, c).Update(d.Id(), toAPIObject(d))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewQuerySnippetsAPI(ctx, c).Delete(d.Id())
+		},
+		Schema: s,
+	}
+}
@@ -0,0 +1,161 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/sql/api"
+)
+
+func TestQuerySnippetCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/query_snippets",
+				ExpectedRequest: api.QuerySnippet{
+					Trigger:     "gdpr",
+					Description: "GDPR filter",
+					Snippet:     "WHERE consent = true",
+				},
+				Response: api.QuerySnippet{
+					ID:          api.NewStringOrInt("12"),
+					Trigger:     "gdpr",
+					Description: "GDPR filter",
+					Snippet:     "WHERE consent = true",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/query_snippets/12",
+				Response: map[string]any{
+					"id":          12,
+					"trigger":     "gdpr",
+					"description": "GDPR filter",
+					"snippet":     "WHERE consent = true",
+				},
+			},
+		},
+		Resource: ResourceQuerySnippet(),
+		Create:   true,
+		HCL: `
+		trigger     = "gdpr"
+		description = "GDPR filter"
+		snippet     = "WHERE consent = true"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":          "12",
+		"trigger":     "gdpr",
+		"description": "GDPR filter",
+		"snippet":     "WHERE consent = true",
+	})
+}
+
+func TestQuerySnippetRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/query_snippets/12",
+				Response: api.QuerySnippet{
+					ID:      api.NewStringOrInt("12"),
+					Trigger: "gdpr",
+					Snippet: "WHERE consent = true",
+				},
+			},
+		},
+		Resource: ResourceQuerySnippet(),
+		Read:     true,
+		New:      true,
+		ID:       "12",
+		InstanceState: map[string]string{
+			"trigger":     "gdpr",
+			"description": "GDPR filter",
+			"snippet":     "WHERE consent = true",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"trigger":     "gdpr",
+		"description": "",
+		"snippet":     "WHERE consent = true",
+	})
+}
+
+func TestQuerySnippetUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/query_snippets/12",
+				ExpectedRequest: api.QuerySnippet{
+					Trigger: "gdpr",
+					Snippet: "WHERE consent = true AND region = 'EU'",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/query_snippets/12",
+				Response: api.QuerySnippet{
+					ID:      api.NewStringOrInt("12"),
+					Trigger: "gdpr",
+					Snippet: "WHERE consent = true AND region = 'EU'",
+				},
+			},
+		},
+		Resource: ResourceQuerySnippet(),
+		Update:   true,
+		ID:       "12",
+		InstanceState: map[string]string{
+			"trigger":     "gdpr",
+			"description": "GDPR filter",
+			"snippet":     "WHERE consent = true",
+		},
+		HCL: `
+		trigger = "gdpr"
+		snippet = "WHERE consent = true AND region = 'EU'"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"description": "",
+		"snippet":     "WHERE consent = true AND region = 'EU'",
+	})
+}
+
+func TestQuerySnippetDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/preview/sql/query_snippets/12",
+			},
+		},
+		Resource: ResourceQuerySnippet(),
+		Delete:   true,
+		ID:       "12",
+	}.ApplyNoError(t)
+}
+
+func TestQuerySnippetCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceQuerySnippet())
+}
+
+func TestQuerySnippetCreate_TriggerWithWhitespace(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceQuerySnippet(),
+		Create:   true,
+		HCL: `
+		trigger = "gdpr filter"
+		snippet = "WHERE consent = true"
+		`,
+	}.ExpectError(t, "invalid config supplied. [trigger] invalid value for trigger "+
+		"(must be a single word without whitespace)")
+}
+
+func TestQuerySnippetCreate_EmptySnippet(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceQuerySnippet(),
+		Create:   true,
+		HCL: `
+		trigger = "gdpr"
+		snippet = " "
+		`,
+	}.ExpectError(t, "invalid config supplied. [snippet] expected snippet to not be an empty string or whitespace")
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/sql/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -30,6 +31,94 @@ type AlertEntity struct {
 	Parent    string        `json:"parent,omitempty" tf:"suppress_diff,force_new"`
 	CreatedAt string        `json:"created_at,omitempty" tf:"computed"`
 	UpdatedAt string        `json:"updated_at,omitempty" tf:"computed"`
+	Owner     string        `json:"owner,omitempty" tf:"computed"`
+
+	DestinationIds []string `json:"destination_ids,omitempty" tf:"slice_set"`
+}
+
+// NewAlertSubscriptionsAPI ...
+func NewAlertSubscriptionsAPI(ctx context.Context, m any) AlertSubscriptionsAPI {
+	return AlertSubscriptionsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// AlertSubscriptionsAPI manages notification destinations subscribed to an alert.
+// Subscriptions aren't covered by the Alerts API of Go SDK.
+type AlertSubscriptionsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// List ...
+func (a AlertSubscriptionsAPI) List(alertID string) (subscriptions []api.AlertSubscription, err error) {
+	err = a.client.Get(a.context, fmt.Sprintf("/preview/sql/alerts/%s/subscriptions", alertID), nil, &subscriptions)
+	return
+}
+
+// Subscribe ...
+func (a AlertSubscriptionsAPI) Subscribe(alertID, destinationID string) error {
+	return a.client.Post(a.context, fmt.Sprintf("/preview/sql/alerts/%s/subscriptions", alertID), api.AlertSubscription{
+		AlertID:       alertID,
+		DestinationID: destinationID,
+	}, nil)
+}
+
+// Unsubscribe ...
+func (a AlertSubscriptionsAPI) Unsubscribe(alertID, subscriptionID string) error {
+	return a.client.Delete(a.context, fmt.Sprintf("/preview/sql/alerts/%s/subscriptions/%s", alertID, subscriptionID), nil)
+}
+
+// DestinationIds returns IDs of notification destinations subscribed to the alert, ignoring subscribed users
+func (a AlertSubscriptionsAPI) DestinationIds(alertID string) ([]string, error) {
+	subscriptions, err := a.List(alertID)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, subscription := range subscriptions {
+		if subscription.Destination != nil {
+			ids = append(ids, subscription.Destination.ID)
+		}
+	}
+	return ids, nil
+}
+
+// Sync subscribes the given notification destinations to the alert and unsubscribes all other destinations.
+// Subscriptions of users are kept as is.
+func (a AlertSubscriptionsAPI) Sync(alertID string, destinationIDs []string) error {
+	subscriptions, err := a.List(alertID)
+	if err != nil {
+		return err
+	}
+	wanted := map[string]bool{}
+	for _, id := range destinationIDs {
+		// removed set elements may be read back as empty strings
+		if id != "" {
+			wanted[id] = true
+		}
+	}
+	for _, subscription := range subscriptions {
+		if subscription.Destination == nil {
+			continue
+		}
+		if wanted[subscription.Destination.ID] {
+			delete(wanted, subscription.Destination.ID)
+			continue
+		}
+		err = a.Unsubscribe(alertID, subscription.ID.String())
+		if err != nil {
+			return fmt.Errorf("cannot unsubscribe %s: %w", subscription.Destination.ID, err)
+		}
+	}
+	for _, id := range destinationIDs {
+		if !wanted[id] {
+			continue
+		}
+		err = a.Subscribe(alertID, id)
+		if err != nil {
+			return fmt.Errorf("cannot subscribe %s: %w", id, err)
+		}
+	}
+	return nil
 }
 
 func (a *AlertEntity) toCreateAlertApiObject(s map[string]*schema.Schema, data *schema.ResourceData) (sql.CreateAlert, error) {
@@ -92,6 +181,9 @@ func (a *AlertEntity) fromAPIObject(apiAlert *sql.Alert, s map[string]*schema.Sc
 	a.Rearm = apiAlert.Rearm
 	a.CreatedAt = apiAlert.CreatedAt
 	a.UpdatedAt = apiAlert.UpdatedAt
+	if apiAlert.User != nil {
+		a.Owner = apiAlert.User.Email
+	}
 
 	if apiAlert.Options != nil {
 		a.Options = &AlertOptions{
@@ -130,6 +222,7 @@ func ResourceSqlAlert() common.Resource {
 	})
 
 	return common.Resource{
+		WorkspaceLevel: true,
 		Create: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -145,6 +238,15 @@ func ResourceSqlAlert() common.Resource {
 				return err
 			}
 			data.SetId(apiAlert.Id)
+			if len(a.DestinationIds) > 0 {
+				err = NewAlertSubscriptionsAPI(ctx, c).Sync(apiAlert.Id, a.DestinationIds)
+				if err != nil {
+					return err
+				}
+			}
+			if a.Owner != "" {
+				return NewOwnershipAPI(ctx, c).Transfer(api.OwnableObjectTypeAlert, apiAlert.Id, a.Owner)
+			}
 			return nil
 		},
 		Read: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
@@ -157,8 +259,28 @@ func ResourceSqlAlert() common.Resource {
 				log.Printf("[WARN] error getting alert by ID: %v", err)
 				return err
 			}
+			// subscriptions are only read back when they are managed by Terraform,
+			// so that alerts without destination_ids don't need an extra request
+			manageSubscriptions := false
+			for _, id := range data.Get("destination_ids").(*schema.Set).List() {
+				// removed set elements may be read back as empty strings
+				if id.(string) != "" {
+					manageSubscriptions = true
+				}
+			}
 			var a AlertEntity
-			return a.fromAPIObject(apiAlert, s, data)
+			err = a.fromAPIObject(apiAlert, s, data)
+			if err != nil {
+				return err
+			}
+			if !manageSubscriptions {
+				return nil
+			}
+			destinationIds, err := NewAlertSubscriptionsAPI(ctx, c).DestinationIds(data.Id())
+			if err != nil {
+				return err
+			}
+			return data.Set("destination_ids", destinationIds)
 		},
 		Update: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -170,7 +292,20 @@ func ResourceSqlAlert() common.Resource {
 			if err != nil {
 				return err
 			}
-			return w.Alerts.Update(ctx, ca)
+			err = w.Alerts.Update(ctx, ca)
+			if err != nil {
+				return err
+			}
+			if data.HasChange("destination_ids") {
+				err = NewAlertSubscriptionsAPI(ctx, c).Sync(data.Id(), a.DestinationIds)
+				if err != nil {
+					return err
+				}
+			}
+			if data.HasChange("owner") && a.Owner != "" {
+				return NewOwnershipAPI(ctx, c).Transfer(api.OwnableObjectTypeAlert, data.Id(), a.Owner)
+			}
+			return nil
 		},
 		Delete: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
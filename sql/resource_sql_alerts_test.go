@@ -5,6 +5,7 @@ import (
 
 	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/sql/api"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -100,3 +101,349 @@ func TestSqlAlertReadBoolValue(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "xyz", d.Id(), "Resource ID should not be empty")
 }
+
+func TestSqlAlertCreateWithDestinations(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/alerts",
+				ExpectedRequest: sql.CreateAlert{
+					Name:    "Alert name",
+					QueryId: "abc",
+					Rearm:   60,
+					Options: sql.AlertOptions{
+						Column:        "col1",
+						Op:            ">",
+						Value:         "10",
+						CustomSubject: "{{ALERT_NAME}} changed status",
+						CustomBody:    "Value is {{QUERY_RESULT_VALUE}}",
+					},
+				},
+				Response: sql.Alert{
+					Id: "xyz",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []any{},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				ExpectedRequest: api.AlertSubscription{
+					AlertID:       "xyz",
+					DestinationID: "dest1",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz?",
+				Response: sql.Alert{
+					Id:    "xyz",
+					Name:  "Alert name",
+					Rearm: 60,
+					Query: &sql.AlertQuery{
+						Id: "abc",
+					},
+					Options: &sql.AlertOptions{
+						Column:        "col1",
+						Op:            ">",
+						Value:         "10",
+						CustomSubject: "{{ALERT_NAME}} changed status",
+						CustomBody:    "Value is {{QUERY_RESULT_VALUE}}",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []api.AlertSubscription{
+					{
+						ID: api.NewStringOrInt("1"),
+						Destination: &api.AlertSubscriptionDestination{
+							ID: "dest1",
+						},
+					},
+					{
+						// subscribed user
+						ID: api.NewStringOrInt("2"),
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlAlert(),
+		Create:   true,
+		HCL: `
+		name     = "Alert name"
+		query_id = "abc"
+		rearm    = 60
+		options {
+			column         = "col1"
+			op             = ">"
+			value          = "10"
+			custom_subject = "{{ALERT_NAME}} changed status"
+			custom_body    = "Value is {{QUERY_RESULT_VALUE}}"
+		}
+		destination_ids = ["dest1"]
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "xyz", d.Id())
+	assert.Equal(t, 1, d.Get("destination_ids.#"))
+}
+
+func TestSqlAlertUpdateDestinations(t *testing.T) {
+	subscriptions := []api.AlertSubscription{
+		{
+			ID: api.NewStringOrInt("1"),
+			Destination: &api.AlertSubscriptionDestination{
+				ID: "dest1",
+			},
+		},
+		{
+			ID: api.NewStringOrInt("2"),
+			Destination: &api.AlertSubscriptionDestination{
+				ID: "dest2",
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/sql/alerts/xyz",
+				ExpectedRequest: sql.EditAlert{
+					AlertId: "xyz",
+					Name:    "Alert name",
+					QueryId: "abc",
+					Options: sql.AlertOptions{
+						Column: "col1",
+						Op:     ">",
+						Value:  "10",
+						Muted:  true,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: subscriptions,
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions/1",
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				ExpectedRequest: api.AlertSubscription{
+					AlertID:       "xyz",
+					DestinationID: "dest3",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz?",
+				Response: sql.Alert{
+					Id:   "xyz",
+					Name: "Alert name",
+					Query: &sql.AlertQuery{
+						Id: "abc",
+					},
+					Options: &sql.AlertOptions{
+						Column: "col1",
+						Op:     ">",
+						Value:  "10",
+						Muted:  true,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []api.AlertSubscription{
+					subscriptions[1],
+					{
+						ID: api.NewStringOrInt("3"),
+						Destination: &api.AlertSubscriptionDestination{
+							ID: "dest3",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlAlert(),
+		Update:   true,
+		ID:       "xyz",
+		InstanceState: map[string]string{
+			"name":                       "Alert name",
+			"query_id":                   "abc",
+			"options.#":                  "1",
+			"options.0.column":           "col1",
+			"options.0.op":               ">",
+			"options.0.value":            "10",
+			"destination_ids.#":          "2",
+			"destination_ids.3931261792": "dest1",
+			"destination_ids.1935350490": "dest2",
+		},
+		HCL: `
+		name     = "Alert name"
+		query_id = "abc"
+		options {
+			column = "col1"
+			op     = ">"
+			value  = "10"
+			muted  = true
+		}
+		destination_ids = ["dest2", "dest3"]
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestSqlAlertUpdateRemoveDestinations(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/sql/alerts/xyz",
+				ExpectedRequest: sql.EditAlert{
+					AlertId: "xyz",
+					Name:    "Alert name",
+					QueryId: "abc",
+					Options: sql.AlertOptions{
+						Column: "col1",
+						Op:     ">",
+						Value:  "10",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []api.AlertSubscription{
+					{
+						ID: api.NewStringOrInt("1"),
+						Destination: &api.AlertSubscriptionDestination{
+							ID: "dest1",
+						},
+					},
+					{
+						// subscribed user
+						ID: api.NewStringOrInt("2"),
+					},
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions/1",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz?",
+				Response: sql.Alert{
+					Id:   "xyz",
+					Name: "Alert name",
+					Query: &sql.AlertQuery{
+						Id: "abc",
+					},
+					Options: &sql.AlertOptions{
+						Column: "col1",
+						Op:     ">",
+						Value:  "10",
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlAlert(),
+		Update:   true,
+		ID:       "xyz",
+		InstanceState: map[string]string{
+			"name":                       "Alert name",
+			"query_id":                   "abc",
+			"options.#":                  "1",
+			"options.0.column":           "col1",
+			"options.0.op":               ">",
+			"options.0.value":            "10",
+			"destination_ids.#":          "1",
+			"destination_ids.3931261792": "dest1",
+		},
+		HCL: `
+		name     = "Alert name"
+		query_id = "abc"
+		options {
+			column = "col1"
+			op     = ">"
+			value  = "10"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"destination_ids.#": 0,
+	})
+}
+
+func TestSqlAlertCreateWithOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/alerts",
+				ExpectedRequest: sql.CreateAlert{
+					Name:    "Alert name",
+					QueryId: "abc",
+					Options: sql.AlertOptions{
+						Column: "col1",
+						Op:     ">",
+						Value:  "10",
+					},
+				},
+				Response: sql.Alert{
+					Id: "xyz",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/permissions/alert/xyz/transfer",
+				ExpectedRequest: api.TransferOwnership{
+					NewOwner: "analyst@example.com",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz?",
+				Response: sql.Alert{
+					Id:   "xyz",
+					Name: "Alert name",
+					Query: &sql.AlertQuery{
+						Id: "abc",
+					},
+					Options: &sql.AlertOptions{
+						Column: "col1",
+						Op:     ">",
+						Value:  "10",
+					},
+					User: &sql.User{
+						Email: "analyst@example.com",
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlAlert(),
+		Create:   true,
+		HCL: `
+		name = "Alert name"
+		query_id = "abc"
+		owner = "analyst@example.com"
+		options {
+			column = "col1"
+			op = ">"
+			value = "10"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":    "xyz",
+		"owner": "analyst@example.com",
+	})
+}
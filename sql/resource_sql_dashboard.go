@@ -102,6 +102,7 @@ func ResourceSqlDashboard() common.Resource {
 		common.NoCustomize)
 
 	return common.Resource{
+		WorkspaceLevel: true,
 		Create: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
 			var d DashboardEntity
 			ad, err := d.toAPIObject(s, data)
@@ -0,0 +1,109 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DashboardMigration is a Lakeview dashboard converted from a legacy Databricks SQL dashboard.
+type DashboardMigration struct {
+	SourceDashboardID     string `json:"source_dashboard_id" tf:"force_new"`
+	DisplayName           string `json:"display_name,omitempty" tf:"force_new,suppress_diff,computed"`
+	ParentPath            string `json:"parent_path,omitempty" tf:"force_new,suppress_diff"`
+	UpdateParameterSyntax bool   `json:"update_parameter_syntax,omitempty" tf:"force_new"`
+	DashboardID           string `json:"dashboard_id,omitempty" tf:"computed"`
+	Path                  string `json:"path,omitempty" tf:"computed"`
+	WarehouseID           string `json:"warehouse_id,omitempty" tf:"computed"`
+	LifecycleState        string `json:"lifecycle_state,omitempty" tf:"computed"`
+}
+
+type lakeviewDashboard struct {
+	DashboardID    string `json:"dashboard_id,omitempty"`
+	DisplayName    string `json:"display_name,omitempty"`
+	Path           string `json:"path,omitempty"`
+	ParentPath     string `json:"parent_path,omitempty"`
+	WarehouseID    string `json:"warehouse_id,omitempty"`
+	LifecycleState string `json:"lifecycle_state,omitempty"`
+}
+
+type migrateDashboardRequest struct {
+	SourceDashboardID     string `json:"source_dashboard_id"`
+	DisplayName           string `json:"display_name,omitempty"`
+	ParentPath            string `json:"parent_path,omitempty"`
+	UpdateParameterSyntax bool   `json:"update_parameter_syntax,omitempty"`
+}
+
+// NewLakeviewDashboardsAPI ...
+func NewLakeviewDashboardsAPI(ctx context.Context, m any) LakeviewDashboardsAPI {
+	return LakeviewDashboardsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// LakeviewDashboardsAPI ...
+type LakeviewDashboardsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Migrate converts a legacy dashboard into a Lakeview dashboard
+func (a LakeviewDashboardsAPI) Migrate(r migrateDashboardRequest) (d lakeviewDashboard, err error) {
+	err = a.client.Post(a.context, "/lakeview/dashboards/migrate", r, &d)
+	return
+}
+
+// Read ...
+func (a LakeviewDashboardsAPI) Read(dashboardID string) (d lakeviewDashboard, err error) {
+	err = a.client.Get(a.context, fmt.Sprintf("/lakeview/dashboards/%s", dashboardID), nil, &d)
+	return
+}
+
+// Trash moves the dashboard to trash
+func (a LakeviewDashboardsAPI) Trash(dashboardID string) error {
+	return a.client.Delete(a.context, fmt.Sprintf("/lakeview/dashboards/%s", dashboardID), nil)
+}
+
+func ResourceSqlDashboardMigration() common.Resource {
+	s := common.StructToSchema(DashboardMigration{}, nil)
+	return common.Resource{
+		WorkspaceLevel: true,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var m DashboardMigration
+			common.DataToStructPointer(d, s, &m)
+			dashboard, err := NewLakeviewDashboardsAPI(ctx, c).Migrate(migrateDashboardRequest{
+				SourceDashboardID:     m.SourceDashboardID,
+				DisplayName:           m.DisplayName,
+				ParentPath:            m.ParentPath,
+				UpdateParameterSyntax: m.UpdateParameterSyntax,
+			})
+			if err != nil {
+				return err
+			}
+			d.SetId(dashboard.DashboardID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			dashboard, err := NewLakeviewDashboardsAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			if dashboard.LifecycleState == "TRASHED" {
+				return apierr.NotFound(fmt.Sprintf("dashboard %s is in trash", d.Id()))
+			}
+			var m DashboardMigration
+			common.DataToStructPointer(d, s, &m)
+			m.DashboardID = dashboard.DashboardID
+			m.DisplayName = dashboard.DisplayName
+			m.Path = dashboard.Path
+			m.WarehouseID = dashboard.WarehouseID
+			m.LifecycleState = dashboard.LifecycleState
+			return common.StructToData(m, s, d)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewLakeviewDashboardsAPI(ctx, c).Trash(d.Id())
+		},
+		Schema: s,
+	}
+}
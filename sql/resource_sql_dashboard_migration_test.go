@@ -0,0 +1,88 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDashboardMigrationCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards/migrate",
+				ExpectedRequest: migrateDashboardRequest{
+					SourceDashboardID:     "legacy",
+					ParentPath:            "/Shared/Dashboards",
+					UpdateParameterSyntax: true,
+				},
+				Response: lakeviewDashboard{
+					DashboardID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/abc",
+				Response: lakeviewDashboard{
+					DashboardID:    "abc",
+					DisplayName:    "Revenue",
+					Path:           "/Shared/Dashboards/Revenue.lvdash.json",
+					WarehouseID:    "xyz",
+					LifecycleState: "ACTIVE",
+				},
+			},
+		},
+		Resource: ResourceSqlDashboardMigration(),
+		Create:   true,
+		HCL: `
+		source_dashboard_id = "legacy"
+		parent_path = "/Shared/Dashboards"
+		update_parameter_syntax = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "abc",
+		"dashboard_id":    "abc",
+		"display_name":    "Revenue",
+		"path":            "/Shared/Dashboards/Revenue.lvdash.json",
+		"warehouse_id":    "xyz",
+		"lifecycle_state": "ACTIVE",
+	})
+}
+
+func TestDashboardMigrationRead_Trashed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/abc",
+				Response: lakeviewDashboard{
+					DashboardID:    "abc",
+					LifecycleState: "TRASHED",
+				},
+			},
+		},
+		Resource: ResourceSqlDashboardMigration(),
+		Read:     true,
+		Removed:  true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestDashboardMigrationDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/lakeview/dashboards/abc",
+			},
+		},
+		Resource: ResourceSqlDashboardMigration(),
+		Delete:   true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestDashboardMigrationCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceSqlDashboardMigration())
+}
@@ -27,6 +27,14 @@ type SqlWarehouse struct {
 	DataSourceId string `json:"data_source_id,omitempty" tf:"computed"`
 }
 
+// sqlWarehouseResource has arguments of the resource, that aren't exported by the data source
+type sqlWarehouseResource struct {
+	SqlWarehouse
+
+	// Only used by Terraform to replace the warehouse on resize, not sent to the API.
+	ReplaceOnResize bool `json:"replace_on_resize,omitempty"`
+}
+
 func getSqlWarehouse(ctx context.Context, w *databricks.WorkspaceClient, id string) (*SqlWarehouse, error) {
 	se, err := w.Warehouses.GetById(ctx, id)
 	if err != nil {
@@ -51,8 +59,57 @@ func resolveDataSourceID(ctx context.Context, w *databricks.WorkspaceClient, war
 	return "", fmt.Errorf("no data source found for endpoint %s", warehouseId)
 }
 
+// removeIgnoredTags removes custom tags, that aren't managed by Terraform, from the warehouse returned by the API
+func removeIgnoredTags(warehouse *SqlWarehouse, d *schema.ResourceData, c *common.DatabricksClient) {
+	if warehouse.Tags == nil {
+		return
+	}
+	configured := map[string]any{}
+	for _, v := range d.Get("tags.0.custom_tags").([]any) {
+		tag, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		configured[tag["key"].(string)] = tag["value"]
+	}
+	managed := []sql.EndpointTagPair{}
+	for _, tag := range warehouse.Tags.CustomTags {
+		if !c.IsIgnoredTag(tag.Key, configured) {
+			managed = append(managed, tag)
+		}
+	}
+	warehouse.Tags.CustomTags = managed
+	if len(managed) == 0 && d.Get("tags.#").(int) == 0 {
+		warehouse.Tags = nil
+	}
+}
+
+// keepIgnoredTags adds custom tags of the warehouse, that aren't managed by Terraform, to the tags of an edit
+// or create request, as they replace all custom tags
+func keepIgnoredTags(tags *sql.EndpointTags, warehouse *sql.GetWarehouseResponse, c *common.DatabricksClient) *sql.EndpointTags {
+	if warehouse.Tags == nil {
+		return tags
+	}
+	configured := map[string]any{}
+	if tags != nil {
+		for _, tag := range tags.CustomTags {
+			configured[tag.Key] = tag.Value
+		}
+	}
+	for _, tag := range warehouse.Tags.CustomTags {
+		if !c.IsKeptTag(tag.Key, configured) {
+			continue
+		}
+		if tags == nil {
+			tags = &sql.EndpointTags{}
+		}
+		tags.CustomTags = append(tags.CustomTags, tag)
+	}
+	return tags
+}
+
 func ResourceSqlEndpoint() common.Resource {
-	s := common.StructToSchema(SqlWarehouse{}, func(
+	s := common.StructToSchema(sqlWarehouseResource{}, func(
 		m map[string]*schema.Schema) map[string]*schema.Schema {
 		m["id"].Computed = true
 		common.SetDefault(m["auto_stop_mins"], 120)
@@ -120,7 +177,11 @@ func ResourceSqlEndpoint() common.Resource {
 			if err != nil {
 				return err
 			}
-			return common.StructToData(warehouse, s, d)
+			removeIgnoredTags(warehouse, d, c)
+			return common.StructToData(sqlWarehouseResource{
+				SqlWarehouse:    *warehouse,
+				ReplaceOnResize: d.Get("replace_on_resize").(bool),
+			}, s, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -131,6 +192,13 @@ func ResourceSqlEndpoint() common.Resource {
 			common.DataToStructPointer(d, s, &se)
 			common.SetForceSendFields(&se, d, []string{"enable_serverless_compute", "enable_photon"})
 			se.Id = d.Id()
+			if len(c.IgnoreTags) > 0 {
+				warehouse, err := w.Warehouses.GetById(ctx, d.Id())
+				if err != nil {
+					return err
+				}
+				se.Tags = keepIgnoredTags(se.Tags, warehouse, c)
+			}
 			_, err = w.Warehouses.Edit(ctx, se)
 			if err != nil {
 				return err
@@ -146,7 +214,16 @@ func ResourceSqlEndpoint() common.Resource {
 		},
 		Schema: s,
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			old, _ := d.GetChange("cluster_size")
+			if d.Get("replace_on_resize").(bool) && d.HasChange("cluster_size") && old.(string) != "" {
+				// combined with create_before_destroy, the new warehouse is running before the old one is deleted
+				err := d.ForceNew("cluster_size")
+				if err != nil {
+					return err
+				}
+			}
 			return d.Clear("health")
 		},
+		WorkspaceLevel: true,
 	}
 }
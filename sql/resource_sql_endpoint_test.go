@@ -211,6 +211,86 @@ func TestResourceSQLEndpointRead(t *testing.T) {
 	assert.Equal(t, "d7c9d05c-7496-4c69-b089-48823edad40c", d.Get("data_source_id"))
 }
 
+func TestResourceSQLEndpointRead_IgnoreTags(t *testing.T) {
+	response := getResponse
+	response.Tags = &sql.EndpointTags{
+		CustomTags: []sql.EndpointTagPair{
+			{Key: "owner", Value: "finance"},
+			{Key: "CostCenter", Value: "1234"},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(mwc *mocks.MockWorkspaceClient) {
+			api := mwc.GetMockWarehousesAPI()
+			api.EXPECT().GetById(mock.Anything, "abc").Return(&response, nil)
+			addDataSourceListHttpFixture(mwc)
+		},
+		Resource:   ResourceSqlEndpoint(),
+		IgnoreTags: []string{"owner"},
+		ID:         "abc",
+		Read:       true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		tags {
+			custom_tags {
+				key   = "CostCenter"
+				value = "1234"
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, 1, d.Get("tags.0.custom_tags.#"))
+	assert.Equal(t, "CostCenter", d.Get("tags.0.custom_tags.0.key"))
+}
+
+func TestResourceSQLEndpointUpdate_KeepsIgnoredTags(t *testing.T) {
+	response := getResponse
+	response.Tags = &sql.EndpointTags{
+		CustomTags: []sql.EndpointTagPair{
+			{Key: "owner", Value: "finance"},
+			{Key: "CostCenter", Value: "1234"},
+		},
+	}
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(mwc *mocks.MockWorkspaceClient) {
+			api := mwc.GetMockWarehousesAPI()
+			api.EXPECT().GetById(mock.Anything, "abc").Return(&response, nil)
+			api.EXPECT().Edit(mock.Anything, sql.EditWarehouseRequest{
+				Id:                 "abc",
+				Name:               "foo",
+				ClusterSize:        "Small",
+				AutoStopMins:       120,
+				MaxNumClusters:     1,
+				EnablePhoton:       true,
+				SpotInstancePolicy: "COST_OPTIMIZED",
+				Tags: &sql.EndpointTags{
+					CustomTags: []sql.EndpointTagPair{
+						{Key: "CostCenter", Value: "5678"},
+						{Key: "owner", Value: "finance"},
+					},
+				},
+			}).Return(&sql.WaitGetWarehouseRunning[struct{}]{Poll: poll.Simple(getResponse)}, nil)
+			addDataSourceListHttpFixture(mwc)
+		},
+		Resource:   ResourceSqlEndpoint(),
+		IgnoreTags: []string{"owner"},
+		ID:         "abc",
+		Update:     true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		tags {
+			custom_tags {
+				key   = "CostCenter"
+				value = "5678"
+			}
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
 func TestResourceSQLEndpointUpdate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(mwc *mocks.MockWorkspaceClient) {
@@ -240,6 +320,108 @@ func TestResourceSQLEndpointUpdate(t *testing.T) {
 	assert.Equal(t, "d7c9d05c-7496-4c69-b089-48823edad40c", d.Get("data_source_id"))
 }
 
+func TestResourceSQLEndpointUpdate_ReplaceOnResize(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSqlEndpoint(),
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"id":                "abc",
+			"name":              "foo",
+			"cluster_size":      "X-Small",
+			"replace_on_resize": "true",
+		},
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		replace_on_resize = true
+		`,
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"cluster_size":              {Old: "X-Small", New: "Small", NewComputed: false, NewRemoved: false, RequiresNew: true, Sensitive: false},
+			"auto_stop_mins":            {Old: "", New: "120", NewComputed: false, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"enable_photon":             {Old: "", New: "true", NewComputed: false, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"max_num_clusters":          {Old: "", New: "1", NewComputed: false, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"spot_instance_policy":      {Old: "", New: "COST_OPTIMIZED", NewComputed: false, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"name":                      {Old: "foo", New: "foo", NewComputed: false, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"replace_on_resize":         {Old: "true", New: "true", NewComputed: false, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"id":                        {Old: "abc", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"state":                     {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"odbc_params.#":             {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"num_clusters":              {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"num_active_sessions":       {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"jdbc_url":                  {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"enable_serverless_compute": {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"data_source_id":            {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"creator_name":              {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceSQLEndpointUpdateTagsAndChannel(t *testing.T) {
+	response := sql.GetWarehouseResponse{
+		Name:           "foo",
+		ClusterSize:    "Small",
+		Id:             "abc",
+		State:          "RUNNING",
+		MaxNumClusters: 1,
+		NumClusters:    1,
+		Tags: &sql.EndpointTags{
+			CustomTags: []sql.EndpointTagPair{
+				{Key: "CostCenter", Value: "1234"},
+			},
+		},
+		Channel: &sql.Channel{
+			Name: sql.ChannelNameChannelNamePreview,
+		},
+		WarehouseType: sql.GetWarehouseResponseWarehouseTypePro,
+	}
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(mwc *mocks.MockWorkspaceClient) {
+			api := mwc.GetMockWarehousesAPI()
+			api.EXPECT().Edit(mock.Anything, sql.EditWarehouseRequest{
+				Id:                 "abc",
+				Name:               "foo",
+				ClusterSize:        "Small",
+				AutoStopMins:       120,
+				MaxNumClusters:     1,
+				EnablePhoton:       true,
+				SpotInstancePolicy: "COST_OPTIMIZED",
+				Tags: &sql.EndpointTags{
+					CustomTags: []sql.EndpointTagPair{
+						{Key: "CostCenter", Value: "1234"},
+					},
+				},
+				Channel: &sql.Channel{
+					Name: sql.ChannelNameChannelNamePreview,
+				},
+				WarehouseType: sql.EditWarehouseRequestWarehouseTypePro,
+			}).Return(&sql.WaitGetWarehouseRunning[struct{}]{Poll: poll.Simple(response)}, nil)
+			api.EXPECT().GetById(mock.Anything, "abc").Return(&response, nil)
+			addDataSourceListHttpFixture(mwc)
+		},
+		Resource: ResourceSqlEndpoint(),
+		ID:       "abc",
+		Update:   true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		warehouse_type = "PRO"
+		channel {
+			name = "CHANNEL_NAME_PREVIEW"
+		}
+		tags {
+			custom_tags {
+				key   = "CostCenter"
+				value = "1234"
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "CHANNEL_NAME_PREVIEW", d.Get("channel.0.name"))
+	assert.Equal(t, "PRO", d.Get("warehouse_type"))
+	assert.Equal(t, "1234", d.Get("tags.0.custom_tags.0.value"))
+}
+
 // Testing the customizeDiff on clearing "health" diff is working as expected.
 func TestResourceSQLEndpointUpdateHealthNoDiff(t *testing.T) {
 	qa.ResourceFixture{
@@ -139,6 +139,7 @@ func ResourceSqlGlobalConfig() common.Resource {
 		return nil
 	}
 	return common.Resource{
+		WorkspaceLevel: true,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			// enable_serverless_compute is an optional boolean parameter which may be specified as `false`.
 			if _, ok := d.GetOkExists("enable_serverless_compute"); !ok {
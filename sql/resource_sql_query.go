@@ -24,10 +24,13 @@ type QueryEntity struct {
 	Schedule  *QuerySchedule   `json:"schedule,omitempty"`
 	Tags      []string         `json:"tags,omitempty"`
 	Parameter []QueryParameter `json:"parameter,omitempty"`
+	Catalog   string           `json:"catalog,omitempty"`
+	Schema    string           `json:"schema,omitempty"`
 	RunAsRole string           `json:"run_as_role,omitempty" tf:"suppress_diff"`
 	Parent    string           `json:"parent,omitempty" tf:"suppress_diff,force_new"`
 	CreatedAt string           `json:"created_at,omitempty" tf:"computed"`
 	UpdatedAt string           `json:"updated_at,omitempty" tf:"computed"`
+	Owner     string           `json:"owner,omitempty" tf:"computed"`
 }
 
 // QuerySchedule ...
@@ -120,8 +123,34 @@ type QueryParameterDateLike struct {
 
 // QueryParameterDateRangeLike ...
 type QueryParameterDateRangeLike struct {
-	Value string             `json:"value,omitempty"`
-	Range *api.DateTimeRange `json:"range,omitempty"`
+	Value   string             `json:"value,omitempty"`
+	Range   *api.DateTimeRange `json:"range,omitempty"`
+	Dynamic string             `json:"dynamic,omitempty"`
+}
+
+func (p *QueryParameterDateRangeLike) toAPIObject(ap api.QueryParameter) api.QueryParameterRangeBase {
+	return api.QueryParameterRangeBase{
+		QueryParameter: ap,
+		StringValue:    p.Value,
+		RangeValue:     p.Range,
+		DynamicValue:   p.Dynamic,
+	}
+}
+
+// newQueryParameterDateRangeLike keeps dynamic date range in the `value` field,
+// if it was configured there before `dynamic` field was introduced. Otherwise
+// we'd get a permanent diff.
+func newQueryParameterDateRangeLike(apv *api.QueryParameterRangeBase, prior *QueryParameterDateRangeLike) *QueryParameterDateRangeLike {
+	p := &QueryParameterDateRangeLike{
+		Value:   apv.StringValue,
+		Range:   apv.RangeValue,
+		Dynamic: apv.DynamicValue,
+	}
+	if p.Dynamic != "" && prior != nil && prior.Value == p.Dynamic {
+		p.Value = p.Dynamic
+		p.Dynamic = ""
+	}
+	return p
 }
 
 // QueryParameterAllowMultiple ...
@@ -197,8 +226,11 @@ func (q *QueryEntity) toAPIObject(schema map[string]*schema.Schema, data *schema
 		}
 	}
 
-	if len(q.Parameter) > 0 {
-		aq.Options = &api.QueryOptions{}
+	if len(q.Parameter) > 0 || q.Catalog != "" || q.Schema != "" {
+		aq.Options = &api.QueryOptions{
+			Catalog: q.Catalog,
+			Schema:  q.Schema,
+		}
 		for _, p := range q.Parameter {
 			ap := api.QueryParameter{
 				Name:  p.Name,
@@ -259,30 +291,18 @@ func (q *QueryEntity) toAPIObject(schema map[string]*schema.Schema, data *schema
 				}
 			case p.DateRange != nil:
 				iface = api.QueryParameterDateRange{
-					QueryParameterRangeBase: api.QueryParameterRangeBase{
-						QueryParameter: ap,
-						StringValue:    p.DateRange.Value,
-						RangeValue:     p.DateRange.Range,
-					},
+					QueryParameterRangeBase: p.DateRange.toAPIObject(ap),
 				}
 			case p.DateTimeRange != nil:
 				iface = api.QueryParameterDateTimeRange{
-					QueryParameterRangeBase: api.QueryParameterRangeBase{
-						QueryParameter: ap,
-						StringValue:    p.DateTimeRange.Value,
-						RangeValue:     p.DateTimeRange.Range,
-					},
+					QueryParameterRangeBase: p.DateTimeRange.toAPIObject(ap),
 				}
 			case p.DateTimeSecRange != nil:
 				iface = api.QueryParameterDateTimeSecRange{
-					QueryParameterRangeBase: api.QueryParameterRangeBase{
-						QueryParameter: ap,
-						StringValue:    p.DateTimeSecRange.Value,
-						RangeValue:     p.DateTimeSecRange.Range,
-					},
+					QueryParameterRangeBase: p.DateTimeSecRange.toAPIObject(ap),
 				}
 			default:
-				log.Fatalf("Don't know what to do for QueryParameter...")
+				return nil, fmt.Errorf("parameter %s must have a type specified", p.Name)
 			}
 
 			aq.Options.Parameters = append(aq.Options.Parameters, iface)
@@ -297,6 +317,14 @@ func (q *QueryEntity) toAPIObject(schema map[string]*schema.Schema, data *schema
 }
 
 func (q *QueryEntity) fromAPIObject(aq *api.Query, schema map[string]*schema.Schema, data *schema.ResourceData) error {
+	// Parameters from the current state, that are needed to avoid spurious diffs.
+	var current QueryEntity
+	common.DataToStructPointer(data, schema, &current)
+	prior := map[string]QueryParameter{}
+	for _, p := range current.Parameter {
+		prior[p.Name] = p
+	}
+
 	// Copy from API object.
 	q.DataSourceID = aq.DataSourceID
 	q.Name = aq.Name
@@ -306,6 +334,9 @@ func (q *QueryEntity) fromAPIObject(aq *api.Query, schema map[string]*schema.Sch
 	q.Parent = aq.Parent
 	q.UpdatedAt = aq.UpdatedAt
 	q.CreatedAt = aq.CreatedAt
+	if aq.User != nil {
+		q.Owner = aq.User.Email
+	}
 
 	if s := aq.Schedule; s != nil {
 		// Set `schedule` to non-empty value to ensure it's picked up by `StructToSchema`.
@@ -360,6 +391,8 @@ func (q *QueryEntity) fromAPIObject(aq *api.Query, schema map[string]*schema.Sch
 
 	if aq.Options != nil {
 		q.Parameter = nil
+		q.Catalog = aq.Options.Catalog
+		q.Schema = aq.Options.Schema
 
 		for _, ap := range aq.Options.Parameters {
 			var p QueryParameter
@@ -421,26 +454,22 @@ func (q *QueryEntity) fromAPIObject(aq *api.Query, schema map[string]*schema.Sch
 			case *api.QueryParameterDateRange:
 				p.Name = apv.Name
 				p.Title = apv.Title
-				p.DateRange = &QueryParameterDateRangeLike{
-					Value: apv.StringValue,
-					Range: apv.RangeValue,
-				}
+				p.DateRange = newQueryParameterDateRangeLike(&apv.QueryParameterRangeBase, prior[apv.Name].DateRange)
 			case *api.QueryParameterDateTimeRange:
 				p.Name = apv.Name
 				p.Title = apv.Title
-				p.DateTimeRange = &QueryParameterDateRangeLike{
-					Value: apv.StringValue,
-					Range: apv.RangeValue,
-				}
+				p.DateTimeRange = newQueryParameterDateRangeLike(&apv.QueryParameterRangeBase, prior[apv.Name].DateTimeRange)
 			case *api.QueryParameterDateTimeSecRange:
 				p.Name = apv.Name
 				p.Title = apv.Title
-				p.DateTimeSecRange = &QueryParameterDateRangeLike{
-					Value: apv.StringValue,
-					Range: apv.RangeValue,
-				}
+				p.DateTimeSecRange = newQueryParameterDateRangeLike(&apv.QueryParameterRangeBase, prior[apv.Name].DateTimeSecRange)
+			case *api.QueryParameterUnknown:
+				// Not representable in the configuration, preserved on update instead.
+				log.Printf("[WARN] Query %s has parameter %s of unsupported type %s, it's not managed by Terraform",
+					aq.ID, apv.Name, apv.Type)
+				continue
 			default:
-				log.Fatalf("Don't know what to do for type: %#v", reflect.TypeOf(apv).String())
+				return fmt.Errorf("don't know what to do for type: %s", reflect.TypeOf(apv).String())
 			}
 
 			q.Parameter = append(q.Parameter, p)
@@ -450,7 +479,38 @@ func (q *QueryEntity) fromAPIObject(aq *api.Query, schema map[string]*schema.Sch
 	}
 
 	// Transform to ResourceData.
-	return common.StructToData(*q, schema, data)
+	if err := common.StructToData(*q, schema, data); err != nil {
+		return err
+	}
+
+	// Overwrite `catalog` and `schema` to detect changes made outside of Terraform.
+	// These would have been skipped by `common.StructToData` if not configured or empty.
+	if err := data.Set("catalog", q.Catalog); err != nil {
+		return err
+	}
+	return data.Set("schema", q.Schema)
+}
+
+// preserveUnknownParameters appends parameters of types that aren't supported by the provider
+// from the existing query, so that they aren't removed by an update.
+func (q *QueryEntity) preserveUnknownParameters(aq *api.Query, existing *api.Query) {
+	if existing.Options == nil {
+		return
+	}
+	configured := map[string]bool{}
+	for _, p := range q.Parameter {
+		configured[p.Name] = true
+	}
+	for _, ap := range existing.Options.Parameters {
+		unknown, ok := ap.(*api.QueryParameterUnknown)
+		if !ok || configured[unknown.Name] {
+			continue
+		}
+		if aq.Options == nil {
+			aq.Options = &api.QueryOptions{}
+		}
+		aq.Options.Parameters = append(aq.Options.Parameters, unknown)
+	}
 }
 
 // NewQueryAPI ...
@@ -500,9 +560,53 @@ func (a QueryAPI) Read(queryID string) (*api.Query, error) {
 	return &q, nil
 }
 
+// FindByName returns the ID of the only query with the given name
+func (a QueryAPI) FindByName(name string) (string, error) {
+	var resp struct {
+		Count   int         `json:"count"`
+		Results []api.Query `json:"results"`
+	}
+	req := map[string]any{
+		"q":         name,
+		"page_size": queriesListPageSize,
+		"page":      1,
+	}
+	ids := []string{}
+	for page := 1; ; page++ {
+		req["page"] = page
+		resp.Results = nil
+		err := a.client.Get(a.context, "/preview/sql/queries", req, &resp)
+		if err != nil {
+			return "", err
+		}
+		// search is fuzzy, so only exact matches are taken into account
+		for _, q := range resp.Results {
+			if q.Name == name {
+				ids = append(ids, q.ID)
+			}
+		}
+		if len(resp.Results) == 0 || page*queriesListPageSize >= resp.Count {
+			break
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("can't find SQL query with the name '%s'", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("there are multiple SQL queries with the name '%s': %s. Please specify 'query_id' instead",
+			name, strings.Join(ids, ", "))
+	}
+}
+
 // Update ...
 func (a QueryAPI) Update(queryID string, q *api.Query) error {
-	return a.client.Post(a.context, fmt.Sprintf("/preview/sql/queries/%s", queryID), q, nil)
+	// Visualizations are managed with databricks_sql_visualization or in the UI, so they are never
+	// sent with the query, otherwise widgets of dashboards could break after the query text is changed.
+	update := *q
+	update.Visualizations = nil
+	return a.client.Post(a.context, fmt.Sprintf("/preview/sql/queries/%s", queryID), update, nil)
 }
 
 // Delete ...
@@ -543,12 +647,20 @@ func ResourceSqlQuery() common.Resource {
 				"Saturday",
 			}, false)
 
+			// Validate dynamic date range keywords.
+			parameter := m["parameter"].Elem.(*schema.Resource)
+			for _, n := range []string{"date_range", "datetime_range", "datetimesec_range"} {
+				dateRange := parameter.Schema[n].Elem.(*schema.Resource)
+				dateRange.Schema["dynamic"].ValidateFunc = validation.StringInSlice(api.DynamicDateRanges, false)
+			}
+
 			m["run_as_role"].ValidateFunc = validation.StringInSlice([]string{"viewer", "owner"}, false)
 			m["query"].DiffSuppressFunc = common.SuppressDiffWhitespaceChange
 			return m
 		})
 
 	return common.Resource{
+		WorkspaceLevel: true,
 		Create: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
 			var q QueryEntity
 			aq, err := q.toAPIObject(s, data)
@@ -564,6 +676,9 @@ func ResourceSqlQuery() common.Resource {
 			// No need to set anything because the resource is going to be
 			// read immediately after being created.
 			data.SetId(aq.ID)
+			if q.Owner != "" {
+				return NewOwnershipAPI(ctx, c).Transfer(api.OwnableObjectTypeQuery, aq.ID, q.Owner)
+			}
 			return nil
 		},
 		Read: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
@@ -582,7 +697,20 @@ func ResourceSqlQuery() common.Resource {
 				return err
 			}
 
-			return NewQueryAPI(ctx, c).Update(data.Id(), aq)
+			queryAPI := NewQueryAPI(ctx, c)
+			existing, err := queryAPI.Read(data.Id())
+			if err != nil {
+				return err
+			}
+			q.preserveUnknownParameters(aq, existing)
+			err = queryAPI.Update(data.Id(), aq)
+			if err != nil {
+				return err
+			}
+			if data.HasChange("owner") && q.Owner != "" {
+				return NewOwnershipAPI(ctx, c).Transfer(api.OwnableObjectTypeQuery, data.Id(), q.Owner)
+			}
+			return nil
 		},
 		Delete: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewQueryAPI(ctx, c).Delete(data.Id())
@@ -1,9 +1,11 @@
 package sql
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/databricks/terraform-provider-databricks/sql/api"
 	"github.com/stretchr/testify/assert"
@@ -338,6 +340,88 @@ func TestQueryCreateDeletesDefaultVisualization(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestQueryCreateWithCatalogAndSchema(t *testing.T) {
+	body := api.Query{
+		ID:           "foo",
+		DataSourceID: "xyz",
+		Name:         "Query name",
+		Query:        "SELECT * FROM sales",
+		Options: &api.QueryOptions{
+			Catalog: "main",
+			Schema:  "reporting",
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/queries",
+				ExpectedRequest: api.Query{
+					DataSourceID: "xyz",
+					Name:         "Query name",
+					Query:        "SELECT * FROM sales",
+					Options: &api.QueryOptions{
+						Catalog: "main",
+						Schema:  "reporting",
+					},
+				},
+				Response: body,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: body,
+			},
+		},
+		Resource: ResourceSqlQuery(),
+		Create:   true,
+		HCL: `
+			data_source_id = "xyz"
+			name = "Query name"
+			query = "SELECT * FROM sales"
+			catalog = "main"
+			schema = "reporting"
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "main", d.Get("catalog"))
+	assert.Equal(t, "reporting", d.Get("schema"))
+}
+
+func TestQueryReadDetectsCatalogDrift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: api.Query{
+					ID:           "foo",
+					DataSourceID: "xyz",
+					Name:         "Query name",
+					Query:        "SELECT * FROM sales",
+					Options: &api.QueryOptions{
+						Catalog: "other",
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlQuery(),
+		Read:     true,
+		ID:       "foo",
+		InstanceState: map[string]string{
+			"data_source_id": "xyz",
+			"name":           "Query name",
+			"query":          "SELECT * FROM sales",
+			"catalog":        "main",
+			"schema":         "reporting",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"catalog": "other",
+		"schema":  "",
+	})
+}
+
 func TestQueryRead(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -405,8 +489,9 @@ func TestQueryUpdate(t *testing.T) {
 				},
 			},
 			{
-				Method:   "GET",
-				Resource: "/api/2.0/preview/sql/queries/foo",
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/sql/queries/foo",
+				ReuseRequest: true,
 				Response: api.Query{
 					ID:           "foo",
 					DataSourceID: "xyz",
@@ -436,6 +521,74 @@ func TestQueryUpdate(t *testing.T) {
 	assert.Equal(t, "SELECT 2", d.Get("query"))
 }
 
+func TestQueryUpdateKeepsVisualizations(t *testing.T) {
+	existing := api.Query{
+		ID:           "foo",
+		DataSourceID: "xyz",
+		Name:         "Updated name",
+		Query:        "SELECT 2",
+		Visualizations: []json.RawMessage{
+			json.RawMessage(`{"id": 123, "type": "CHART", "name": "Revenue"}`),
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/sql/queries/foo",
+				ReuseRequest: true,
+				Response:     existing,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				ExpectedRequest: api.Query{
+					ID:           "foo",
+					DataSourceID: "xyz",
+					Name:         "Updated name",
+					Query:        "SELECT 2",
+				},
+				Response: existing,
+			},
+		},
+		Resource: ResourceSqlQuery(),
+		Update:   true,
+		ID:       "foo",
+		InstanceState: map[string]string{
+			"data_source_id": "xyz",
+			"name":           "Updated name",
+			"query":          "SELECT 1",
+		},
+		HCL: `
+		data_source_id = "xyz"
+		name = "Updated name"
+		query = "SELECT 2"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestQueryAPIUpdateOmitsVisualizations(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/preview/sql/queries/foo",
+			ExpectedRequest: api.Query{
+				ID:    "foo",
+				Query: "SELECT 2",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		q := &api.Query{
+			ID:             "foo",
+			Query:          "SELECT 2",
+			Visualizations: []json.RawMessage{json.RawMessage(`{"id": 123}`)},
+		}
+		err := NewQueryAPI(ctx, client).Update("foo", q)
+		assert.NoError(t, err)
+		assert.Len(t, q.Visualizations, 1)
+	})
+}
+
 func TestQueryUpdateWithParams(t *testing.T) {
 	body := api.Query{
 		ID:           "foo",
@@ -548,9 +701,10 @@ func TestQueryUpdateWithParams(t *testing.T) {
 				Response: body,
 			},
 			{
-				Method:   "GET",
-				Resource: "/api/2.0/preview/sql/queries/foo",
-				Response: body,
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/sql/queries/foo",
+				ReuseRequest: true,
+				Response:     body,
 			},
 		},
 		Resource: ResourceSqlQuery(),
@@ -679,6 +833,216 @@ func TestQueryUpdateWithParams(t *testing.T) {
 	assert.Len(t, d.Get("parameter").([]any), 12)
 }
 
+func TestQueryUpdateKeepsUnknownParameterTypes(t *testing.T) {
+	unknown := `{"name":"2","title":"Future","type":"future-type","value":{"x":1}}`
+	response := `{
+		"id": "foo",
+		"data_source_id": "xyz",
+		"name": "Query name",
+		"query": "SELECT {{1}}, {{2}}",
+		"options": {
+			"parameters": [
+				{"name": "1", "type": "text", "value": "a"},
+				` + unknown + `
+			]
+		}
+	}`
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/sql/queries/foo",
+				ReuseRequest: true,
+				Response:     response,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				ExpectedRequest: api.Query{
+					ID:           "foo",
+					DataSourceID: "xyz",
+					Name:         "Query name",
+					Query:        "SELECT {{1}}, {{2}}",
+					Options: &api.QueryOptions{
+						Parameters: []any{
+							api.QueryParameterText{
+								QueryParameter: api.QueryParameter{
+									Name: "1",
+								},
+								Value: "b",
+							},
+							api.QueryParameterUnknown{
+								Raw: json.RawMessage(unknown),
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlQuery(),
+		Update:   true,
+		ID:       "foo",
+		InstanceState: map[string]string{
+			"data_source_id":           "xyz",
+			"name":                     "Query name",
+			"query":                    "SELECT {{1}}, {{2}}",
+			"parameter.#":              "1",
+			"parameter.0.name":         "1",
+			"parameter.0.text.#":       "1",
+			"parameter.0.text.0.value": "a",
+		},
+		HCL: `
+			data_source_id = "xyz"
+			name = "Query name"
+			query = "SELECT {{1}}, {{2}}"
+
+			parameter {
+				name = "1"
+				text {
+					value = "b"
+				}
+			}
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err)
+	// parameter of unknown type isn't exposed, so it doesn't cause a diff
+	assert.Equal(t, 1, d.Get("parameter.#"))
+	assert.Equal(t, "a", d.Get("parameter.0.text.0.value"))
+}
+
+func TestQueryReadWithUnknownParameterType(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: `{
+					"id": "foo",
+					"data_source_id": "xyz",
+					"name": "Query name",
+					"query": "SELECT {{1}}",
+					"options": {
+						"parameters": [
+							{"name": "1", "type": "future-type", "value": "a"}
+						]
+					}
+				}`,
+			},
+		},
+		Resource: ResourceSqlQuery(),
+		Read:     true,
+		New:      true,
+		ID:       "foo",
+	}.Apply(t)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Query name", d.Get("name"))
+	assert.Equal(t, 0, d.Get("parameter.#"))
+}
+
+func TestQueryCreateWithDynamicDateRange(t *testing.T) {
+	response := `{
+		"id": "foo",
+		"data_source_id": "xyz",
+		"name": "Query name",
+		"query": "SELECT 1",
+		"options": {
+			"parameters": [
+				{"name": "1", "type": "date-range", "value": "d_last_week"},
+				{"name": "2", "type": "datetime-range", "value": "d_this_month"}
+			]
+		}
+	}`
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/queries",
+				ExpectedRequest: api.Query{
+					DataSourceID: "xyz",
+					Name:         "Query name",
+					Query:        "SELECT 1",
+					Options: &api.QueryOptions{
+						Parameters: []any{
+							api.QueryParameterDateRange{
+								QueryParameterRangeBase: api.QueryParameterRangeBase{
+									QueryParameter: api.QueryParameter{
+										Name: "1",
+									},
+									DynamicValue: "d_last_week",
+								},
+							},
+							api.QueryParameterDateTimeRange{
+								QueryParameterRangeBase: api.QueryParameterRangeBase{
+									QueryParameter: api.QueryParameter{
+										Name: "2",
+									},
+									StringValue: "d_this_month",
+								},
+							},
+						},
+					},
+				},
+				Response: response,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: response,
+			},
+		},
+		Resource: ResourceSqlQuery(),
+		Create:   true,
+		HCL: `
+			data_source_id = "xyz"
+			name = "Query name"
+			query = "SELECT 1"
+
+			parameter {
+				name = "1"
+				date_range {
+					dynamic = "d_last_week"
+				}
+			}
+
+			parameter {
+				name = "2"
+				datetime_range {
+					value = "d_this_month"
+				}
+			}
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "d_last_week", d.Get("parameter.0.date_range.0.dynamic"))
+	assert.Equal(t, "", d.Get("parameter.0.date_range.0.value"))
+	// preset configured through `value` must stay there, otherwise there's a permanent diff
+	assert.Equal(t, "d_this_month", d.Get("parameter.1.datetime_range.0.value"))
+	assert.Equal(t, "", d.Get("parameter.1.datetime_range.0.dynamic"))
+}
+
+func TestQueryCreateWithInvalidDynamicDateRange(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceSqlQuery(),
+		Create:   true,
+		HCL: `
+			data_source_id = "xyz"
+			name = "Query name"
+			query = "SELECT 1"
+
+			parameter {
+				name = "1"
+				date_range {
+					dynamic = "d_last_decade"
+				}
+			}
+		`,
+	}.Apply(t)
+	assert.ErrorContains(t, err, "d_last_decade")
+}
+
 func TestQueryDelete(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -699,3 +1063,119 @@ func TestQueryDelete(t *testing.T) {
 func TestResourceQueryCornerCases(t *testing.T) {
 	qa.ResourceCornerCases(t, ResourceSqlQuery())
 }
+
+func TestQueryCreateWithOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/queries",
+				ExpectedRequest: api.Query{
+					DataSourceID: "xyz",
+					Name:         "Query name",
+					Query:        "SELECT 1",
+				},
+				Response: api.Query{
+					ID:           "foo",
+					DataSourceID: "xyz",
+					Name:         "Query name",
+					Query:        "SELECT 1",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/permissions/query/foo/transfer",
+				ExpectedRequest: api.TransferOwnership{
+					NewOwner: "analyst@example.com",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: api.Query{
+					ID:           "foo",
+					DataSourceID: "xyz",
+					Name:         "Query name",
+					Query:        "SELECT 1",
+					User: &api.User{
+						Email: "analyst@example.com",
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlQuery(),
+		Create:   true,
+		HCL: `
+		data_source_id = "xyz"
+		name = "Query name"
+		query = "SELECT 1"
+		owner = "analyst@example.com"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":    "foo",
+		"owner": "analyst@example.com",
+	})
+}
+
+func TestQueryUpdateOwner(t *testing.T) {
+	query := api.Query{
+		ID:           "foo",
+		DataSourceID: "xyz",
+		Name:         "Query name",
+		Query:        "SELECT 1",
+		User: &api.User{
+			Email: "ci@example.com",
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: query,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: query,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/permissions/query/foo/transfer",
+				ExpectedRequest: api.TransferOwnership{
+					NewOwner: "analyst@example.com",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: api.Query{
+					ID:           "foo",
+					DataSourceID: "xyz",
+					Name:         "Query name",
+					Query:        "SELECT 1",
+					User: &api.User{
+						Email: "analyst@example.com",
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlQuery(),
+		Update:   true,
+		ID:       "foo",
+		InstanceState: map[string]string{
+			"data_source_id": "xyz",
+			"name":           "Query name",
+			"query":          "SELECT 1",
+			"owner":          "ci@example.com",
+		},
+		HCL: `
+		data_source_id = "xyz"
+		name = "Query name"
+		query = "SELECT 1"
+		owner = "analyst@example.com"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"owner": "analyst@example.com",
+	})
+}
@@ -16,7 +16,8 @@ import (
 
 // VisualizationEntity defines the parameters that can be set in the resource.
 type VisualizationEntity struct {
-	QueryID         string `json:"query_id" tf:"force_new"`
+	QueryID         string `json:"query_id,omitempty" tf:"computed,force_new"`
+	QueryName       string `json:"query_name,omitempty" tf:"force_new"`
 	VisualizationID string `json:"visualization_id,omitempty" tf:"computed,force_new"`
 
 	Type        string `json:"type"`
@@ -47,6 +48,7 @@ func (v *VisualizationEntity) toAPIObject(schema map[string]*schema.Schema, data
 func (v *VisualizationEntity) fromAPIObject(av *api.Visualization, schema map[string]*schema.Schema, data *schema.ResourceData) error {
 	// Copy from API object.
 	v.QueryID = av.QueryID
+	v.QueryName = data.Get("query_name").(string)
 	v.VisualizationID = av.ID.String()
 	v.Type = strings.ToLower(av.Type)
 	v.Name = av.Name
@@ -153,11 +155,22 @@ func ResourceSqlVisualization() common.Resource {
 			// We care only about logical changes to the JSON payload in `options` and `query_plan`.
 			m["options"].DiffSuppressFunc = suppressWhitespaceChangesInJSON
 			m["query_plan"].DiffSuppressFunc = suppressWhitespaceChangesInJSON
+			// Query could be referenced either by ID or by name, which is resolved into ID on creation.
+			m["query_id"].ExactlyOneOf = []string{"query_id", "query_name"}
+			m["query_name"].ExactlyOneOf = []string{"query_id", "query_name"}
 			return m
 		})
 
 	return common.Resource{
+		WorkspaceLevel: true,
 		Create: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			if queryName := data.Get("query_name").(string); queryName != "" {
+				queryID, err := NewQueryAPI(ctx, c).FindByName(queryName)
+				if err != nil {
+					return err
+				}
+				data.Set("query_id", queryID)
+			}
 			var v VisualizationEntity
 			av, err := v.toAPIObject(s, data)
 			if err != nil {
@@ -82,6 +82,131 @@ func TestVisualizationCreate(t *testing.T) {
 	assert.Less(t, 0, len(d.Get("options").(string)))
 }
 
+func TestVisualizationCreateWithQueryName(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries?page=1&page_size=100&q=My%20Query",
+				Response: map[string]any{
+					"count": 2,
+					"results": []api.Query{
+						{
+							ID:   "bar",
+							Name: "My Query Copy",
+						},
+						{
+							ID:   "foo",
+							Name: "My Query",
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/visualizations",
+				ExpectedRequest: api.Visualization{
+					QueryID: "foo",
+					Type:    "CHART",
+					Name:    "My Chart",
+					Options: json.RawMessage("{}"),
+				},
+				Response: api.Visualization{
+					ID:      "12345",
+					Type:    "CHART",
+					Name:    "My Chart",
+					Options: json.RawMessage("{}"),
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries/foo",
+				Response: api.Query{
+					ID: "foo",
+					Visualizations: []json.RawMessage{
+						json.RawMessage(`
+							{
+								"id": 12345,
+								"type": "CHART",
+								"name": "My Chart",
+								"options": {}
+							}
+						`),
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlVisualization(),
+		Create:   true,
+		HCL: `
+		query_name = "My Query"
+		type       = "chart"
+		name       = "My Chart"
+		options    = "{}"
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "foo/12345", d.Id())
+	assert.Equal(t, "foo", d.Get("query_id"))
+	assert.Equal(t, "My Query", d.Get("query_name"))
+}
+
+func TestVisualizationCreateWithAmbiguousQueryName(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries?page=1&page_size=100&q=My%20Query",
+				Response: map[string]any{
+					"count": 2,
+					"results": []api.Query{
+						{
+							ID:   "foo",
+							Name: "My Query",
+						},
+						{
+							ID:   "bar",
+							Name: "My Query",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlVisualization(),
+		Create:   true,
+		HCL: `
+		query_name = "My Query"
+		type       = "chart"
+		name       = "My Chart"
+		options    = "{}"
+		`,
+	}.ExpectError(t, "there are multiple SQL queries with the name 'My Query': foo, bar. Please specify 'query_id' instead")
+}
+
+func TestVisualizationCreateWithMissingQueryName(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/queries?page=1&page_size=100&q=My%20Query",
+				Response: map[string]any{
+					"count":   0,
+					"results": []api.Query{},
+				},
+			},
+		},
+		Resource: ResourceSqlVisualization(),
+		Create:   true,
+		HCL: `
+		query_name = "My Query"
+		type       = "chart"
+		name       = "My Chart"
+		options    = "{}"
+		`,
+	}.ExpectError(t, "can't find SQL query with the name 'My Query'")
+}
+
 func TestVisualizationRead(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
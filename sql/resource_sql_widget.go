@@ -11,6 +11,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/sql/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // WidgetEntity defines the parameters that can be set in the resource.
@@ -49,6 +50,9 @@ type WidgetParameter struct {
 	Values []string `json:"values,omitempty"`
 }
 
+// widgetParameterTypes are the types of mapping of widget parameters to parameters of the query
+var widgetParameterTypes = []string{"dashboard-level", "widget-level", "static-value"}
+
 type sortWidgetParameter []WidgetParameter
 
 func (a sortWidgetParameter) Len() int {
@@ -198,6 +202,96 @@ func (w *WidgetEntity) fromAPIObject(aw *api.Widget, schema map[string]*schema.S
 	return common.StructToData(*w, schema, data)
 }
 
+// validateParameters checks parameter mappings, that can be validated without calling the API
+func (w *WidgetEntity) validateParameters() error {
+	for _, wp := range w.Parameter {
+		if wp.Value != "" && len(wp.Values) > 0 {
+			return fmt.Errorf("parameter %s can have either value or values, not both", wp.Name)
+		}
+		if wp.Type == "static-value" && wp.Value == "" && len(wp.Values) == 0 {
+			return fmt.Errorf("parameter %s with type static-value requires value or values", wp.Name)
+		}
+	}
+	return nil
+}
+
+// queryID returns the query ID, if the visualization is referred to by the resource ID, like `<query-id>/<id>`
+func (w *WidgetEntity) queryID() string {
+	parts := strings.SplitN(w.VisualizationID, "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[0]
+}
+
+// visualizationQueryID returns the query ID from the visualization, that the API returns with the widget
+func visualizationQueryID(aw *api.Widget) (string, error) {
+	if len(aw.Visualization) == 0 {
+		return "", nil
+	}
+	var v api.Visualization
+	err := json.Unmarshal(aw.Visualization, &v)
+	if err != nil {
+		return "", err
+	}
+	if v.QueryID != "" || len(v.Query) == 0 {
+		return v.QueryID, nil
+	}
+	var q struct {
+		ID string `json:"id"`
+	}
+	err = json.Unmarshal(v.Query, &q)
+	return q.ID, err
+}
+
+// validateQueryParameters checks that mapped parameters are defined in the query of the visualization.
+func (w *WidgetEntity) validateQueryParameters(ctx context.Context, c *common.DatabricksClient, queryID string) error {
+	if len(w.Parameter) == 0 || queryID == "" {
+		return nil
+	}
+	query, err := NewQueryAPI(ctx, c).Read(queryID)
+	if err != nil {
+		return err
+	}
+	defined := map[string]bool{}
+	names := []string{}
+	if query.Options != nil {
+		for _, rp := range query.Options.RawParameters {
+			var qp api.QueryParameter
+			err = json.Unmarshal(rp, &qp)
+			if err != nil {
+				return err
+			}
+			defined[qp.Name] = true
+			names = append(names, qp.Name)
+		}
+	}
+	for _, wp := range w.Parameter {
+		if !defined[wp.Name] {
+			return fmt.Errorf("parameter %s isn't defined in query %s, defined parameters: [%s]",
+				wp.Name, queryID, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// validateCreatedWidget checks parameter mappings against the query of the visualization of the created widget
+// and deletes the widget, if they are invalid.
+func (w *WidgetEntity) validateCreatedWidget(ctx context.Context, c *common.DatabricksClient, aw *api.Widget) error {
+	queryID, err := visualizationQueryID(aw)
+	if err == nil {
+		err = w.validateQueryParameters(ctx, c, queryID)
+	}
+	if err == nil {
+		return nil
+	}
+	deleteErr := NewWidgetAPI(ctx, c).Delete(aw.ID.String())
+	if deleteErr != nil {
+		return fmt.Errorf("%w. Cannot delete widget %s: %v", err, aw.ID.String(), deleteErr)
+	}
+	return err
+}
+
 // NewWidgetAPI ...
 func NewWidgetAPI(ctx context.Context, m any) WidgetAPI {
 	return WidgetAPI{m.(*common.DatabricksClient), ctx}
@@ -257,6 +351,7 @@ func ResourceSqlWidget() common.Resource {
 		WidgetEntity{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
 			m["text"].ConflictsWith = []string{"visualization_id"}
+			common.MustSchemaPath(m, "parameter", "type").ValidateFunc = validation.StringInSlice(widgetParameterTypes, false)
 
 			// Ignore the query ID part in composite visualization ID.
 			// It is present in this field if users refer to a visualization by the native
@@ -269,17 +364,59 @@ func ResourceSqlWidget() common.Resource {
 		})
 
 	return common.Resource{
+		WorkspaceLevel: true,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			// unknown values of set elements are read as empty strings, so mappings
+			// are checked only once all of them are known
+			rawConfig := d.GetRawConfig()
+			if !rawConfig.IsNull() && !rawConfig.GetAttr("parameter").IsWhollyKnown() {
+				return nil
+			}
+			// elements of sets can't be read by index from the diff
+			w := WidgetEntity{
+				VisualizationID: d.Get("visualization_id").(string),
+			}
+			for _, v := range d.Get("parameter").(*schema.Set).List() {
+				m := v.(map[string]any)
+				var wp WidgetParameter
+				wp.Name, _ = m["name"].(string)
+				wp.Type, _ = m["type"].(string)
+				wp.Value, _ = m["value"].(string)
+				// elements of lists in sets may be read as nil, but their number is correct
+				values, _ := m["values"].([]any)
+				for _, v := range values {
+					value, _ := v.(string)
+					wp.Values = append(wp.Values, value)
+				}
+				w.Parameter = append(w.Parameter, wp)
+			}
+			if len(w.Parameter) > 0 && w.VisualizationID == "" && d.NewValueKnown("visualization_id") {
+				return fmt.Errorf("parameter can be specified only for widgets with visualization_id")
+			}
+			return w.validateParameters()
+		},
 		Create: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
 			var w WidgetEntity
 			aw, err := w.toAPIObject(s, data)
 			if err != nil {
 				return err
 			}
+			err = w.validateQueryParameters(ctx, c, w.queryID())
+			if err != nil {
+				return err
+			}
 
 			err = NewWidgetAPI(ctx, c).Create(aw)
 			if err != nil {
 				return err
 			}
+			if w.queryID() == "" {
+				// the query of a visualization referred to by its own ID is known only from the created widget
+				err = w.validateCreatedWidget(ctx, c, aw)
+				if err != nil {
+					return err
+				}
+			}
 
 			// Convert API object back to resource data.
 			// This includes setting the `widget_id`, which is
@@ -308,7 +445,7 @@ func ResourceSqlWidget() common.Resource {
 			return w.fromAPIObject(aw, s, data)
 		},
 		Update: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
-			_, widgetID, err := p.Unpack(data)
+			dashboardID, widgetID, err := p.Unpack(data)
 			if err != nil {
 				return err
 			}
@@ -318,6 +455,21 @@ func ResourceSqlWidget() common.Resource {
 			if err != nil {
 				return err
 			}
+			queryID := w.queryID()
+			if queryID == "" && w.VisualizationID != "" && len(w.Parameter) > 0 {
+				current, err := NewWidgetAPI(ctx, c).Read(dashboardID, widgetID)
+				if err != nil {
+					return err
+				}
+				queryID, err = visualizationQueryID(current)
+				if err != nil {
+					return err
+				}
+			}
+			err = w.validateQueryParameters(ctx, c, queryID)
+			if err != nil {
+				return err
+			}
 
 			return NewWidgetAPI(ctx, c).Update(widgetID, aw)
 		},
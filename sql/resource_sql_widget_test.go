@@ -664,3 +664,232 @@ func TestWidgetParameterSorter(t *testing.T) {
 	assert.Equal(t, "bar", wp[0].Name)
 	assert.Equal(t, "foo", wp[1].Name)
 }
+
+var queryWithParametersFixture = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/preview/sql/queries/q1",
+	Response: api.Query{
+		ID: "q1",
+		Options: &api.QueryOptions{
+			RawParameters: []json.RawMessage{
+				json.RawMessage(`{"name": "region", "type": "text", "value": "emea"}`),
+				json.RawMessage(`{"name": "year", "type": "number", "value": 2024}`),
+			},
+		},
+	},
+}
+
+func TestWidgetCreateWithQueryParameters(t *testing.T) {
+	i678 := api.NewStringOrInt("678")
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			queryWithParametersFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/widgets",
+				ExpectedRequest: api.Widget{
+					DashboardID:     "some-uuid",
+					VisualizationID: &i678,
+					Options: api.WidgetOptions{
+						ParameterMapping: map[string]api.WidgetParameterMapping{
+							"region": {
+								Name:  "region",
+								Type:  "dashboard-level",
+								MapTo: "dashboard_region",
+								Value: "",
+							},
+							"year": {
+								Name:  "year",
+								Type:  "static-value",
+								Value: "2024",
+							},
+						},
+					},
+				},
+				Response: api.Widget{
+					ID:          "12345",
+					DashboardID: "some-uuid",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/dashboards/some-uuid",
+				Response: api.Dashboard{
+					ID: "some-uuid",
+					Widgets: []json.RawMessage{
+						json.RawMessage(`
+							{
+								"id": "12345",
+								"visualization_id": 678
+							}
+						`),
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlWidget(),
+		Create:   true,
+		HCL: `
+			dashboard_id     = "some-uuid"
+			visualization_id = "q1/678"
+
+			parameter {
+				name   = "region"
+				type   = "dashboard-level"
+				map_to = "dashboard_region"
+			}
+
+			parameter {
+				name  = "year"
+				type  = "static-value"
+				value = "2024"
+			}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "some-uuid/12345", d.Id())
+}
+
+func TestWidgetCreateWithUndefinedQueryParameter(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			queryWithParametersFixture,
+		},
+		Resource: ResourceSqlWidget(),
+		Create:   true,
+		HCL: `
+			dashboard_id     = "some-uuid"
+			visualization_id = "q1/678"
+
+			parameter {
+				name  = "country"
+				type  = "widget-level"
+				value = "de"
+			}
+		`,
+	}.ExpectError(t, "parameter country isn't defined in query q1, defined parameters: [region, year]")
+}
+
+func TestWidgetCreateWithUndefinedParameterOfVisualization(t *testing.T) {
+	i678 := api.NewStringOrInt("678")
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/widgets",
+				ExpectedRequest: api.Widget{
+					DashboardID:     "some-uuid",
+					VisualizationID: &i678,
+					Options: api.WidgetOptions{
+						ParameterMapping: map[string]api.WidgetParameterMapping{
+							"country": {
+								Name:  "country",
+								Type:  "widget-level",
+								Value: "de",
+							},
+						},
+					},
+				},
+				Response: api.Widget{
+					ID:            "12345",
+					DashboardID:   "some-uuid",
+					Visualization: json.RawMessage(`{"id": 678, "query": {"id": "q1"}}`),
+				},
+			},
+			queryWithParametersFixture,
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/preview/sql/widgets/12345",
+			},
+		},
+		Resource: ResourceSqlWidget(),
+		Create:   true,
+		HCL: `
+			dashboard_id     = "some-uuid"
+			visualization_id = "678"
+
+			parameter {
+				name  = "country"
+				type  = "widget-level"
+				value = "de"
+			}
+		`,
+	}.ExpectError(t, "parameter country isn't defined in query q1, defined parameters: [region, year]")
+}
+
+func TestWidgetUpdateWithUndefinedParameterOfVisualization(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/dashboards/some-uuid",
+				Response: api.Dashboard{
+					ID: "some-uuid",
+					Widgets: []json.RawMessage{
+						json.RawMessage(`
+							{
+								"id": "12345",
+								"visualization_id": 678,
+								"visualization": {
+									"id": 678,
+									"query": {"id": "q1"}
+								}
+							}
+						`),
+					},
+				},
+			},
+			queryWithParametersFixture,
+		},
+		Resource: ResourceSqlWidget(),
+		Update:   true,
+		ID:       "some-uuid/12345",
+		InstanceState: map[string]string{
+			"dashboard_id":     "some-uuid",
+			"visualization_id": "678",
+		},
+		HCL: `
+			dashboard_id     = "some-uuid"
+			visualization_id = "678"
+
+			parameter {
+				name  = "country"
+				type  = "widget-level"
+				value = "de"
+			}
+		`,
+	}.ExpectError(t, "parameter country isn't defined in query q1, defined parameters: [region, year]")
+}
+
+func TestWidgetCreateWithInvalidParameters(t *testing.T) {
+	for hcl, message := range map[string]string{
+		`visualization_id = "678"
+		parameter {
+			name = "p1"
+			type = "query-level"
+		}`: "invalid config supplied. [parameter] expected parameter.0.type to be one of [dashboard-level widget-level static-value], got query-level",
+		`visualization_id = "678"
+		parameter {
+			name = "p1"
+			type = "widget-level"
+			value = "a"
+			values = ["a", "b"]
+		}`: "parameter p1 can have either value or values, not both",
+		`visualization_id = "678"
+		parameter {
+			name = "p1"
+			type = "static-value"
+		}`: "parameter p1 with type static-value requires value or values",
+		`text = "Hello"
+		parameter {
+			name = "p1"
+			type = "widget-level"
+		}`: "parameter can be specified only for widgets with visualization_id",
+	} {
+		qa.ResourceFixture{
+			Resource: ResourceSqlWidget(),
+			Create:   true,
+			HCL:      `dashboard_id = "some-uuid"` + "\n" + hcl,
+		}.ExpectError(t, message)
+	}
+}
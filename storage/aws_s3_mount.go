@@ -42,6 +42,7 @@ func ResourceAWSS3Mount() common.Resource {
 			"Please rewrite configuration using `databricks_mount` resource. More info at " +
 			"https://registry.terraform.io/providers/databricks/databricks/latest/docs/" +
 			"resources/mount#migration-from-other-mount-resources",
+		WorkspaceLevel: true,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -53,5 +53,6 @@ func DataSourceDbfsFile() common.Resource {
 				Computed: true,
 			},
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -58,5 +58,6 @@ func DataSourceDbfsFilePaths() common.Resource {
 				Set: workspace.PathListHash,
 			},
 		},
+		WorkspaceLevel: true,
 	}
 }
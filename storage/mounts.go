@@ -108,6 +108,7 @@ func commonMountResource(tpl Mount, s map[string]*schema.Schema) common.Resource
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		WorkspaceLevel: true,
 	}
 	// nolint should be a bigger context-aware refactor
 	resource.Create = mountCreate(tpl, resource)
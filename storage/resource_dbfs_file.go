@@ -57,5 +57,6 @@ func ResourceDbfsFile() common.Resource {
 				Upgrade: workspace.MigrateV0,
 			},
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -120,5 +120,6 @@ func ResourceFile() common.Resource {
 			err = w.Files.Delete(ctx, files.DeleteFileRequest{FilePath: path})
 			return err
 		},
+		WorkspaceLevel: true,
 	}
 }
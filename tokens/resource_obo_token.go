@@ -70,5 +70,6 @@ func ResourceOboToken() common.Resource {
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewTokenManagementAPI(ctx, c).Delete(d.Id())
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -118,5 +118,6 @@ func ResourceServicePrincipalSecret() common.Resource {
 			spnID := d.Get("service_principal_id").(string)
 			return api.deleteServicePrincipalSecret(spnID, d.Id())
 		},
+		AccountLevel: true,
 	}
 }
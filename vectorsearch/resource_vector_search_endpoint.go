@@ -81,5 +81,6 @@ func ResourceVectorSearchEndpoint() common.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
 		},
+		WorkspaceLevel: true,
 	}
 }
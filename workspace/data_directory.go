@@ -44,5 +44,6 @@ func DataSourceDirectory() common.Resource {
 			d.Set("workspace_path", "/Workspace"+data.Path)
 			return nil
 		},
+		WorkspaceLevel: true,
 	}
 }
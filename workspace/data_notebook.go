@@ -69,5 +69,6 @@ func DataSourceNotebook() common.Resource {
 			}
 			return nil
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -60,5 +60,6 @@ func DataSourceNotebookPaths() common.Resource {
 				Set: PathListHash,
 			},
 		},
+		WorkspaceLevel: true,
 	}
 }
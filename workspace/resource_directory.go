@@ -73,5 +73,6 @@ func ResourceDirectory() common.Resource {
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewNotebooksAPI(ctx, c).Delete(d.Id(), d.Get("delete_recursive").(bool))
 		},
+		WorkspaceLevel: true,
 	}
 }
@@ -45,6 +45,7 @@ func ResourceGlobalInitScript() common.Resource {
 	}
 	s := FileContentSchemaWithoutPath(extra)
 	return common.Resource{
+		WorkspaceLevel: true,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			content, err := ReadContent(d)
 			if err != nil {
@@ -332,6 +332,7 @@ func ResourceNotebook() common.Resource {
 			objType := d.Get("object_type")
 			return NewNotebooksAPI(ctx, c).Delete(d.Id(), !(objType == Notebook || objType == File))
 		},
+		WorkspaceLevel: true,
 	}
 }
 
@@ -7,15 +7,88 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 
 	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// governanceSettings map typed attributes of the resource to workspace configuration keys,
+// that control how data can leave the workspace through notebooks. All of them are enabled by default.
+var governanceSettings = map[string]string{
+	"enable_export_notebook":          "enableExportNotebook",
+	"enable_results_downloading":      "enableResultsDownloading",
+	"enable_notebook_table_clipboard": "enableNotebookTableClipboard",
+}
+
+// governanceSettingAttribute returns the typed attribute for a workspace configuration key of a governance setting
+func governanceSettingAttribute(key string) (string, bool) {
+	for attr, k := range governanceSettings {
+		if k == key {
+			return attr, true
+		}
+	}
+	return "", false
+}
+
+// resetValue returns the value, that the configuration key is set to, when it's no longer managed by Terraform.
+// Governance settings are enabled again, so that data could be exported, and other flags are disabled.
+func resetValue(k string, v any) string {
+	if _, ok := governanceSettingAttribute(k); ok {
+		return "true"
+	}
+	switch r := v.(type) {
+	case string:
+		_, err := strconv.ParseBool(r)
+		if err != nil {
+			return ""
+		}
+		return "false"
+	case bool:
+		return "false"
+	}
+	return ""
+}
+
+// validateCustomConfig warns about governance settings in custom_config, which were supported before
+// the typed attributes were added. They still work the same way as the typed attributes.
+func validateCustomConfig(i any, path cty.Path) (diags diag.Diagnostics) {
+	config, ok := i.(map[string]any)
+	if !ok {
+		return nil
+	}
+	for k := range config {
+		attr, ok := governanceSettingAttribute(k)
+		if !ok {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("%s in custom_config is deprecated", k),
+			Detail:        fmt.Sprintf("Use the %s argument instead.", attr),
+			AttributePath: append(path, cty.IndexStep{Key: cty.StringVal(k)}),
+		})
+	}
+	return diags
+}
+
+// managedGovernanceSettings returns typed attributes that are present in the configuration or the state
+func managedGovernanceSettings(d *schema.ResourceData) map[string]bool {
+	managed := map[string]bool{}
+	for attr := range governanceSettings {
+		if v, ok := d.GetOkExists(attr); ok {
+			managed[attr] = v.(bool)
+		}
+	}
+	return managed
+}
+
 // This function applies configuration defined in the resource data to the workspace.
 func applyWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 	o, n := d.GetChange("custom_config")
@@ -39,19 +112,12 @@ func applyWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.D
 			continue
 		}
 		log.Printf("[DEBUG] Erasing configuration of %s", k)
-		switch r := v.(type) {
-		default:
-			patch[k] = ""
-		case string:
-			_, err := strconv.ParseBool(r)
-			if err != nil {
-				patch[k] = ""
-			} else {
-				patch[k] = "false"
-			}
-		case bool:
-			patch[k] = "false"
-		}
+		patch[k] = resetValue(k, v)
+	}
+
+	// typed attributes take precedence over the same keys in custom_config
+	for attr, enabled := range managedGovernanceSettings(d) {
+		patch[governanceSettings[attr]] = strconv.FormatBool(enabled)
 	}
 
 	w, err := c.WorkspaceClient()
@@ -80,6 +146,10 @@ func updateWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.
 		// invalid workspace configuration from being persisted in the terraform state.
 		prevConf, _ := d.GetChange("custom_config")
 		d.Set("custom_config", prevConf)
+		for attr := range governanceSettings {
+			prev, _ := d.GetChange(attr)
+			d.Set(attr, prev)
+		}
 		return err
 	}
 	return nil
@@ -88,8 +158,9 @@ func updateWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.
 // ResourceWorkspaceConf maintains workspace configuration for specified keys
 func ResourceWorkspaceConf() common.Resource {
 	return common.Resource{
-		Create: applyWorkspaceConf,
-		Update: updateWorkspaceConf,
+		WorkspaceLevel: true,
+		Create:         applyWorkspaceConf,
+		Update:         updateWorkspaceConf,
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			config := d.Get("custom_config").(map[string]any)
 			log.Printf("[DEBUG] Config available in state: %v", config)
@@ -101,16 +172,35 @@ func ResourceWorkspaceConf() common.Resource {
 			for k := range config {
 				keys = append(keys, k)
 			}
+			managed := managedGovernanceSettings(d)
+			for attr := range managed {
+				keys = append(keys, governanceSettings[attr])
+			}
 			if len(keys) == 0 {
 				return nil
 			}
+			sort.Strings(keys)
 			remote, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
 				Keys: strings.Join(keys, ","),
 			})
 			if err != nil {
 				return err
 			}
+			for attr := range managed {
+				key := governanceSettings[attr]
+				if v, ok := (*remote)[key]; ok {
+					// settings that were never changed are returned as empty strings and are enabled
+					d.Set(attr, v != "false")
+				}
+			}
 			for k, v := range *remote {
+				if _, ok := config[k]; !ok {
+					continue
+				}
+				if _, ok := governanceSettingAttribute(k); ok {
+					// settings that were never changed are returned as empty strings and are enabled
+					v = strconv.FormatBool(v != "false")
+				}
 				config[k] = v
 			}
 			log.Printf("[DEBUG] Setting new config to state: %v", config)
@@ -120,19 +210,11 @@ func ResourceWorkspaceConf() common.Resource {
 			patch := settings.WorkspaceConf{}
 			config := d.Get("custom_config").(map[string]any)
 			for k, v := range config {
-				switch r := v.(type) {
-				default:
-					patch[k] = ""
-				case string:
-					_, err := strconv.ParseBool(r)
-					if err != nil {
-						patch[k] = ""
-					} else {
-						patch[k] = "false"
-					}
-				case bool:
-					patch[k] = "false"
-				}
+				patch[k] = resetValue(k, v)
+			}
+			// governance settings are restored to their defaults, so that data could be exported again
+			for attr := range managedGovernanceSettings(d) {
+				patch[governanceSettings[attr]] = "true"
 			}
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -140,11 +222,35 @@ func ResourceWorkspaceConf() common.Resource {
 			}
 			return w.WorkspaceConf.SetStatus(ctx, patch)
 		},
-		Schema: map[string]*schema.Schema{
-			"custom_config": {
-				Type:     schema.TypeMap,
-				Optional: true,
-			},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			config := d.Get("custom_config").(map[string]any)
+			for attr, key := range governanceSettings {
+				if _, ok := config[key]; !ok {
+					continue
+				}
+				if _, ok := d.GetOkExists(attr); ok {
+					return fmt.Errorf("%s can't be set in both custom_config and %s", key, attr)
+				}
+			}
+			return nil
 		},
+		Schema: workspaceConfSchema(),
+	}
+}
+
+func workspaceConfSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"custom_config": {
+			Type:             schema.TypeMap,
+			Optional:         true,
+			ValidateDiagFunc: validateCustomConfig,
+		},
+	}
+	for attr := range governanceSettings {
+		s[attr] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
 	}
+	return s
 }
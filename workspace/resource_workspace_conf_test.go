@@ -6,7 +6,10 @@ import (
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWorkspaceConfCreate(t *testing.T) {
@@ -260,3 +263,183 @@ func TestWorkspaceConfUpdateOnInvalidConf(t *testing.T) {
 		"some-valid-conf": "bar",
 	}, config)
 }
+
+func TestWorkspaceConfCreate_GovernanceSettings(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableIpAccessLists":      "true",
+					"enableExportNotebook":     "false",
+					"enableResultsDownloading": "false",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableExportNotebook%2CenableIpAccessLists%2CenableResultsDownloading",
+				Response: map[string]any{
+					"enableIpAccessLists":      "true",
+					"enableExportNotebook":     "false",
+					"enableResultsDownloading": "false",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceConf(),
+		HCL: `
+		custom_config = {
+			enableIpAccessLists = "true"
+		}
+		enable_export_notebook = false
+		enable_results_downloading = false
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "_",
+		"custom_config.%":            "1",
+		"enable_export_notebook":     false,
+		"enable_results_downloading": false,
+	})
+}
+
+func TestWorkspaceConfRead_GovernanceSettingsDrift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableExportNotebook%2CenableNotebookTableClipboard",
+				Response: map[string]any{
+					"enableExportNotebook":         "true",
+					"enableNotebookTableClipboard": nil,
+				},
+			},
+		},
+		Resource: ResourceWorkspaceConf(),
+		Read:     true,
+		ID:       "_",
+		InstanceState: map[string]string{
+			"enable_export_notebook":          "false",
+			"enable_notebook_table_clipboard": "false",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"enable_export_notebook":          true,
+		"enable_notebook_table_clipboard": true,
+	})
+}
+
+func TestWorkspaceConfDelete_GovernanceSettings(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableExportNotebook": "true",
+				},
+			},
+		},
+		HCL:      `enable_export_notebook = false`,
+		Resource: ResourceWorkspaceConf(),
+		Delete:   true,
+		ID:       "_",
+	}.ApplyNoError(t)
+}
+
+func TestWorkspaceConf_GovernanceSettingInCustomConfig(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableExportNotebook": "false",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableExportNotebook",
+				Response: map[string]any{
+					"enableExportNotebook": "false",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceConf(),
+		HCL: `custom_config = {
+			enableExportNotebook = "false"
+		}`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"custom_config.enableExportNotebook": "false",
+	})
+}
+
+func TestWorkspaceConf_GovernanceSettingInCustomConfigWarning(t *testing.T) {
+	diags := validateCustomConfig(map[string]any{
+		"enableExportNotebook": "false",
+		"enableIpAccessLists":  "true",
+	}, cty.GetAttrPath("custom_config"))
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "enableExportNotebook in custom_config is deprecated", diags[0].Summary)
+	assert.Equal(t, "Use the enable_export_notebook argument instead.", diags[0].Detail)
+}
+
+func TestWorkspaceConf_GovernanceSettingInBoth(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceWorkspaceConf(),
+		HCL: `custom_config = {
+			enableExportNotebook = "false"
+		}
+		enable_export_notebook = false`,
+		Create: true,
+	}.ExpectError(t, "enableExportNotebook can't be set in both custom_config and enable_export_notebook")
+}
+
+func TestWorkspaceConfRead_GovernanceSettingInCustomConfig(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableResultsDownloading",
+				Response: map[string]any{
+					"enableResultsDownloading": "",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceConf(),
+		Read:     true,
+		ID:       "_",
+		InstanceState: map[string]string{
+			"custom_config.%":                        "1",
+			"custom_config.enableResultsDownloading": "false",
+		},
+		HCL: `custom_config = {
+			enableResultsDownloading = "false"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"custom_config.enableResultsDownloading": "true",
+	})
+}
+
+func TestWorkspaceConfDelete_GovernanceSettingInCustomConfig(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableExportNotebook": "true",
+					"enableIpAccessLists":  "false",
+				},
+			},
+		},
+		HCL: `custom_config = {
+			enableExportNotebook = "false"
+			enableIpAccessLists = "true"
+		}`,
+		Resource: ResourceWorkspaceConf(),
+		Delete:   true,
+		ID:       "_",
+	}.ApplyNoError(t)
+}
@@ -104,5 +104,6 @@ func ResourceWorkspaceFile() common.Resource {
 			}
 			return client.Workspace.Delete(ctx, ws_api.Delete{Path: d.Id(), Recursive: false})
 		},
+		WorkspaceLevel: true,
 	}
 }